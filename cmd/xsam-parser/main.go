@@ -1,150 +1,106 @@
 package main
 
 import (
-	"encoding/xml"
+	"bytes"
+	"flag"
 	"fmt"
+	"go/format"
 	"os"
-	"regexp"
-	"strings"
 	"text/template"
+
+	"github.com/threagile/threagile/pkg/risks/xsam"
 )
 
-type RootNodes struct {
-	XMLName         xml.Name         `xml:"RootNodes"`
-	ThreatsCatalogs []ThreatsCatalog `xml:"ThreatsCatalog"`
+type ParsedRisk struct {
+	ID            string
+	Title         string
+	STRIDE        string
+	Function      string
+	Likelihood    string
+	Impact        string
+	Applicability string
 }
 
-type ThreatsCatalog struct {
-	Name          string        `xml:"name,attr"`
-	ThreatClasses []ThreatClass `xml:"ThreatClasses>ThreatClass"`
-}
+// names of the types package constants used in the generated code
+var (
+	strideConstants     = [...]string{"Spoofing", "Tampering", "Repudiation", "InformationDisclosure", "DenialOfService", "ElevationOfPrivilege"}
+	functionConstants   = [...]string{"BusinessSide", "Architecture", "Development", "Operations"}
+	likelihoodConstants = [...]string{"Unlikely", "Likely", "VeryLikely", "Frequent"}
+	impactConstants     = [...]string{"LowImpact", "MediumImpact", "HighImpact", "VeryHighImpact"}
+)
 
-type ThreatClass struct {
-	ID    string `xml:"id,attr"` // Map localRef:id to this
-	Name  string `xml:"name,attr"`
-	Title string `xml:"title,attr"`
-}
+func main() {
+	inputFile := flag.String("input", "input/threat_catalog.xsam", "XSAM threat catalog to parse")
+	outputFile := flag.String("output", "pkg/risks/automotive/generated_risks.go", "Go file to generate")
+	mappingFile := flag.String("mapping", "", "YAML file mapping threat class names (or prefixes) to STRIDE, function, likelihood and impact (default: built-in mapping)")
+	flag.Parse()
 
-type ParsedRisk struct {
-	ID          string
-	CleanID     string
-	Title       string
-	Description string
-}
+	mapping, err := xsam.LoadThreatClassMapping(*mappingFile)
+	if err != nil {
+		fmt.Println("Error loading mapping:", err)
+		return
+	}
 
-func main() {
-	xmlFile, err := os.Open("input/threat_catalog.xsam")
+	root, err := xsam.ReadCatalog(*inputFile)
 	if err != nil {
-		fmt.Println("Error opening file:", err)
+		fmt.Println("Error reading catalog:", err)
 		return
 	}
-	defer xmlFile.Close()
 
-	var root RootNodes
-	if err := xml.NewDecoder(xmlFile).Decode(&root); err != nil {
-		fmt.Println("Error decoding XML:", err)
+	threats, warnings, err := root.Threats(mapping)
+	for _, warning := range warnings {
+		fmt.Println("WARNING:", warning)
+	}
+	if err != nil {
+		fmt.Println("Error classifying threat classes:", err)
 		return
 	}
 
 	var parsedRisks []ParsedRisk
-	seenIDs := make(map[string]bool)
-
-	// Regex to clean IDs for Go struct names (remove dots, etc)
-	reg, _ := regexp.Compile("[^a-zA-Z0-9]+")
-
-	for _, catalog := range root.ThreatsCatalogs {
-		for _, threat := range catalog.ThreatClasses {
-			// cleanID for Go Struct name
-			cleanID := reg.ReplaceAllString(threat.Name, "")
-			if cleanID == "" {
-				cleanID = reg.ReplaceAllString(threat.ID, "")
-			}
-            
-            // Handle duplicate CleanIDs if necessary, or just skip
-            if seenIDs[cleanID] {
-                continue
-            }
-            seenIDs[cleanID] = true
-
-			parsedRisks = append(parsedRisks, ParsedRisk{
-				ID:          strings.ToLower(strings.ReplaceAll(threat.Name, ".", "-")),
-				CleanID:     cleanID,
-				Title:       threat.Title,
-				Description: threat.Title,
-			})
-		}
+	for _, threat := range threats {
+		parsedRisks = append(parsedRisks, ParsedRisk{
+			ID:            threat.ID,
+			Title:         threat.Title,
+			STRIDE:        strideConstants[threat.STRIDE],
+			Function:      functionConstants[threat.Function],
+			Likelihood:    likelihoodConstants[threat.Likelihood],
+			Impact:        impactConstants[threat.Impact],
+			Applicability: threat.Applicability,
+		})
 	}
 
-	tmpl := `package automotive
+	tmpl := `// Code generated by xsam-parser from an XSAM threat catalog. DO NOT EDIT.
+
+package automotive
+
 import (
+	"github.com/threagile/threagile/pkg/risks/xsam"
 	"github.com/threagile/threagile/pkg/types"
 )
-// This logic applies the risk to ANY technical asset tagged with "automotive"
-// It serves as a comprehensive catalog check.
-{{range .}}
-type Risk{{.CleanID}} struct {}
-func (r *Risk{{.CleanID}}) Category() *types.RiskCategory {
-	return &types.RiskCategory{
-		ID:          "automotive-{{.ID}}",
-		Title:       "{{.Title}}",
-		Description: "{{.Description}} (Imported from XSAM)",
-		Impact:      "Potential impact depending on the specific automotive context.",
-		Function:    types.BusinessSide,
-		STRIDE:      types.Tampering,
-		Action:      "Review automotive standards",
-		Mitigation:  "Apply automotive security controls (e.g. UN R155)",
-		Check:       "Is this threat applicable?",
-	}
-}
-func (r *Risk{{.CleanID}}) SupportedTags() []string {
-	return []string{"automotive"}
-}
-func (r *Risk{{.CleanID}}) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
-	risks := make([]*types.Risk, 0)
-	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
-		technicalAsset := parsedModel.TechnicalAssets[id]
-		if technicalAsset.OutOfScope {
-			continue
-		}
-		if technicalAsset.IsTaggedWithAny("automotive") {
-			risks = append(risks, r.createRisk(parsedModel, technicalAsset))
-		}
-	}
-	return risks, nil
-}
-func (r *Risk{{.CleanID}}) createRisk(parsedModel *types.Model, technicalAsset *types.TechnicalAsset) *types.Risk {
-	risk := &types.Risk{
-		CategoryId:                   r.Category().ID,
-		Severity:                     types.CalculateSeverity(types.Likely, types.MediumImpact),
-		ExploitationLikelihood:       types.Likely,
-		ExploitationImpact:           types.MediumImpact,
-		Title:                        "<b>{{.Title}}</b> risk at <b>" + technicalAsset.Title + "</b>",
-		MostRelevantTechnicalAssetId: technicalAsset.Id,
-		DataBreachProbability:        types.Probable,
-		DataBreachTechnicalAssetIDs:  []string{technicalAsset.Id},
-	}
-	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
-	return risk
-}
-{{end}}
-func GetAllAutomotiveRisks() []types.RiskRule {
-	return []types.RiskRule{
-		{{range .}}&Risk{{.CleanID}}{},
-		{{end}}
-	}
+
+var threats = []xsam.Threat{
+{{- range .}}
+	{ID: {{printf "%q" .ID}}, Title: {{printf "%q" .Title}}, Classification: xsam.Classification{STRIDE: types.{{.STRIDE}}, Function: types.{{.Function}}, Likelihood: types.{{.Likelihood}}, Impact: types.{{.Impact}}{{if .Applicability}}, Applicability: {{printf "%q" .Applicability}}{{end}}}},
+{{- end}}
 }
 `
 
 	t := template.Must(template.New("risks").Parse(tmpl))
-	outFile, err := os.Create("pkg/risks/automotive/generated_risks.go")
+	var generated bytes.Buffer
+	if err := t.Execute(&generated, parsedRisks); err != nil {
+		fmt.Println("Error executing template:", err)
+		return
+	}
+
+	source, err := format.Source(generated.Bytes())
 	if err != nil {
-		fmt.Println("Error creating output file:", err)
+		fmt.Println("Error formatting generated code:", err)
 		return
 	}
-	defer outFile.Close()
 
-	if err := t.Execute(outFile, parsedRisks); err != nil {
-		fmt.Println("Error executing template:", err)
+	if err := os.WriteFile(*outputFile, source, 0600); err != nil {
+		fmt.Println("Error writing output file:", err)
+		return
 	}
-    fmt.Println("Successfully generated risks.")
+	fmt.Println("Successfully generated risks.")
 }
@@ -19,12 +19,13 @@ func (what *Threagile) initAnalyze() *Threagile {
 			commands := what.readCommands()
 			progressReporter := DefaultProgressReporter{Verbose: what.config.GetVerbose()}
 
-			r, err := model.ReadAndAnalyzeModel(what.config, risks.GetBuiltInRiskRules(), progressReporter)
+			builtinRiskRules := risks.GetBuiltInRiskRules()
+			r, err := model.ReadAndAnalyzeModel(what.config, builtinRiskRules, progressReporter)
 			if err != nil {
 				return fmt.Errorf("failed to read and analyze model: %w", err)
 			}
 
-			err = report.Generate(what.config, r, commands, risks.GetBuiltInRiskRules(), progressReporter)
+			err = report.Generate(what.config, r, commands, builtinRiskRules, progressReporter)
 			if err != nil {
 				return fmt.Errorf("failed to generate reports: %w", err)
 			}
@@ -1,7 +0,0 @@
-package threagile
-
-type AttackerFocus struct {
-	Asset                 int // fibonacci sequence base index
-	ProcessedOrStoredData int // fibonacci sequence base index
-	TransferredData       int // fibonacci sequence base index
-}
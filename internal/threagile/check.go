@@ -0,0 +1,79 @@
+package threagile
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/threagile/threagile/pkg/model"
+	"github.com/threagile/threagile/pkg/report"
+	"github.com/threagile/threagile/pkg/risks"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// ExitCodeCheckFailed is the exit code of the check-model command if new unchecked risks at or above the severity to
+// fail on are found, unlike the exit code 1 of errors and the exit code 2 of the Go runtime on panics and fatal errors
+const ExitCodeCheckFailed = 3
+
+type exitCodeError struct {
+	code    int
+	message string
+}
+
+func (what *exitCodeError) Error() string {
+	return what.message
+}
+
+func (what *Threagile) initCheck() *Threagile {
+	what.rootCmd.AddCommand(&cobra.Command{
+		Use:   CheckModelCommand,
+		Short: "Check the risks of the model against a baseline",
+		Long: "\n" + Logo + "\n\n" + fmt.Sprintf(VersionText, what.buildTimestamp) + "\n\ncompare the risks of the model with the risks of a baseline " +
+			"(a risks JSON file as written by " + AnalyzeModelCommand + ") and report new, resolved and rerated risks, " +
+			"failing with exit code " + fmt.Sprint(ExitCodeCheckFailed) + " if new unchecked risks at or above the severity to fail on appear",
+		Aliases: []string{"check"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			what.processArgs(cmd, args)
+			progressReporter := DefaultProgressReporter{Verbose: what.config.GetVerbose()}
+
+			if len(what.config.GetBaselineRisksFile()) == 0 {
+				return fmt.Errorf("no baseline risks JSON file given (--%v)", baselineRisksFileFlagName)
+			}
+
+			failOnSeverity, err := types.ParseRiskSeverity(what.config.GetFailOnSeverity())
+			if err != nil {
+				return fmt.Errorf("invalid severity to fail on: %w", err)
+			}
+
+			baseline, err := report.ReadRisksJSON(what.config.GetBaselineRisksFile())
+			if err != nil {
+				return fmt.Errorf("failed to read baseline: %w", err)
+			}
+
+			r, err := model.ReadAndAnalyzeModel(what.config, risks.GetBuiltInRiskRules(), progressReporter)
+			if err != nil {
+				return fmt.Errorf("failed to read and analyze model: %w", err)
+			}
+
+			result := report.Check(r.ParsedModel, what.config.GetBaselineRisksFile(), baseline, failOnSeverity)
+			err = report.WriteCheckJSON(result, filepath.Join(what.config.GetOutputFolder(), what.config.GetJsonCheckFilename()))
+			if err != nil {
+				return err
+			}
+
+			for _, line := range result.Summary() {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), line)
+			}
+
+			if !result.Passed {
+				return &exitCodeError{code: ExitCodeCheckFailed, message: fmt.Sprintf("check failed: %d new unchecked risks", len(result.Failing))}
+			}
+			return nil
+		},
+		CompletionOptions: cobra.CompletionOptions{
+			DisableDefaultCmd: true,
+		},
+	})
+
+	return what
+}
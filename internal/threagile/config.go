@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -32,26 +33,41 @@ type Config struct {
 	TempFolderValue   string `json:"TempFolder,omitempty" yaml:"TempFolder"`
 	KeyFolderValue    string `json:"KeyFolder,omitempty" yaml:"KeyFolder"`
 
-	InputFileValue                   string `json:"InputFile,omitempty" yaml:"InputFile"`
-	ImportedInputFileValue           string `json:"ImportedInputFile,omitempty" yaml:"ImportedInputFile"`
-	DataFlowDiagramFilenamePNGValue  string `json:"DataFlowDiagramFilenamePNG,omitempty" yaml:"DataFlowDiagramFilenamePNG"`
-	DataAssetDiagramFilenamePNGValue string `json:"DataAssetDiagramFilenamePNG,omitempty" yaml:"DataAssetDiagramFilenamePNG"`
-	DataFlowDiagramFilenameDOTValue  string `json:"DataFlowDiagramFilenameDOT,omitempty" yaml:"DataFlowDiagramFilenameDOT"`
-	DataAssetDiagramFilenameDOTValue string `json:"DataAssetDiagramFilenameDOT,omitempty" yaml:"DataAssetDiagramFilenameDOT"`
-	ReportFilenameValue              string `json:"ReportFilename,omitempty" yaml:"ReportFilename"`
-	ExcelRisksFilenameValue          string `json:"ExcelRisksFilename,omitempty" yaml:"ExcelRisksFilename"`
-	ExcelTagsFilenameValue           string `json:"ExcelTagsFilename,omitempty" yaml:"ExcelTagsFilename"`
-	JsonRisksFilenameValue           string `json:"JsonRisksFilename,omitempty" yaml:"JsonRisksFilename"`
-	JsonTechnicalAssetsFilenameValue string `json:"JsonTechnicalAssetsFilename,omitempty" yaml:"JsonTechnicalAssetsFilename"`
-	JsonStatsFilenameValue           string `json:"JsonStatsFilename,omitempty" yaml:"JsonStatsFilename"`
-	TemplateFilenameValue            string `json:"TemplateFilename,omitempty" yaml:"TemplateFilename"`
-	ReportLogoImagePathValue         string `json:"ReportLogoImagePath,omitempty" yaml:"ReportLogoImagePath"`
-	TechnologyFilenameValue          string `json:"TechnologyFilename,omitempty" yaml:"TechnologyFilename"`
-	HideEmptyChaptersValue           bool   `json:"HideEmptyChapters,omitempty" yaml:"HideEmptyChapters"`
-
+	InputFileValue                          string `json:"InputFile,omitempty" yaml:"InputFile"`
+	ImportedInputFileValue                  string `json:"ImportedInputFile,omitempty" yaml:"ImportedInputFile"`
+	DataFlowDiagramFilenamePNGValue         string `json:"DataFlowDiagramFilenamePNG,omitempty" yaml:"DataFlowDiagramFilenamePNG"`
+	DataAssetDiagramFilenamePNGValue        string `json:"DataAssetDiagramFilenamePNG,omitempty" yaml:"DataAssetDiagramFilenamePNG"`
+	DataFlowDiagramFilenameDOTValue         string `json:"DataFlowDiagramFilenameDOT,omitempty" yaml:"DataFlowDiagramFilenameDOT"`
+	DataAssetDiagramFilenameDOTValue        string `json:"DataAssetDiagramFilenameDOT,omitempty" yaml:"DataAssetDiagramFilenameDOT"`
+	SecureBootDiagramFilenamePNGValue       string `json:"SecureBootDiagramFilenamePNG,omitempty" yaml:"SecureBootDiagramFilenamePNG"`
+	SecureBootDiagramFilenameDOTValue       string `json:"SecureBootDiagramFilenameDOT,omitempty" yaml:"SecureBootDiagramFilenameDOT"`
+	ReportFilenameValue                     string `json:"ReportFilename,omitempty" yaml:"ReportFilename"`
+	ExcelRisksFilenameValue                 string `json:"ExcelRisksFilename,omitempty" yaml:"ExcelRisksFilename"`
+	ExcelTagsFilenameValue                  string `json:"ExcelTagsFilename,omitempty" yaml:"ExcelTagsFilename"`
+	ExcelTaraFilenameValue                  string `json:"ExcelTaraFilename,omitempty" yaml:"ExcelTaraFilename"`
+	JsonRisksFilenameValue                  string `json:"JsonRisksFilename,omitempty" yaml:"JsonRisksFilename"`
+	JsonTechnicalAssetsFilenameValue        string `json:"JsonTechnicalAssetsFilename,omitempty" yaml:"JsonTechnicalAssetsFilename"`
+	JsonStatsFilenameValue                  string `json:"JsonStatsFilename,omitempty" yaml:"JsonStatsFilename"`
+	JsonAttackPathsFilenameValue            string `json:"JsonAttackPathsFilename,omitempty" yaml:"JsonAttackPathsFilename"`
+	JsonCybersecurityGoalsFilenameValue     string `json:"JsonCybersecurityGoalsFilename,omitempty" yaml:"JsonCybersecurityGoalsFilename"`
+	JsonAttackerAttractivenessFilenameValue string `json:"JsonAttackerAttractivenessFilename,omitempty" yaml:"JsonAttackerAttractivenessFilename"`
+	JsonCheckFilenameValue                  string `json:"JsonCheckFilename,omitempty" yaml:"JsonCheckFilename"`
+	JsonDiffFilenameValue                   string `json:"JsonDiffFilename,omitempty" yaml:"JsonDiffFilename"`
+	TemplateFilenameValue                   string `json:"TemplateFilename,omitempty" yaml:"TemplateFilename"`
+	ReportLogoImagePathValue                string `json:"ReportLogoImagePath,omitempty" yaml:"ReportLogoImagePath"`
+	TechnologyFilenameValue                 string `json:"TechnologyFilename,omitempty" yaml:"TechnologyFilename"` // deprecated
+	HideEmptyChaptersValue                  bool   `json:"HideEmptyChapters,omitempty" yaml:"HideEmptyChapters"`
+
+	TechnologyFilesValue   []string        `json:"TechnologyFiles,omitempty" yaml:"TechnologyFiles"`
 	RiskRulePluginsValue   []string        `json:"RiskRulePlugins,omitempty" yaml:"RiskRulePlugins"`
+	XsamCatalogsValue      []string        `json:"XsamCatalogs,omitempty" yaml:"XsamCatalogs"`
+	XsamMappingFileValue   string          `json:"XsamMappingFile,omitempty" yaml:"XsamMappingFile"`
 	SkipRiskRulesValue     []string        `json:"SkipRiskRules,omitempty" yaml:"SkipRiskRules"`
+	RiskRuleWorkersValue   int             `json:"RiskRuleWorkers,omitempty" yaml:"RiskRuleWorkers"` // zero for the number of CPUs
+	RiskRuleTimeoutValue   int             `json:"RiskRuleTimeout,omitempty" yaml:"RiskRuleTimeout"` // in seconds, zero for no timeout
 	ExecuteModelMacroValue string          `json:"ExecuteModelMacro,omitempty" yaml:"ExecuteModelMacro"`
+	BaselineRisksFileValue string          `json:"BaselineRisksFile,omitempty" yaml:"BaselineRisksFile"`
+	FailOnSeverityValue    string          `json:"FailOnSeverity,omitempty" yaml:"FailOnSeverity"`
 	RiskExcelValue         RiskExcelConfig `json:"RiskExcel" yaml:"RiskExcel"`
 
 	ServerModeValue               bool `json:"ServerMode,omitempty" yaml:"ServerMode"`
@@ -65,18 +81,26 @@ type Config struct {
 	AddLegendValue                  bool `json:"AddLegend,omitempty" yaml:"AddLegend"`
 	KeepDiagramSourceFilesValue     bool `json:"KeepDiagramSourceFiles,omitempty" yaml:"KeepDiagramSourceFiles"`
 	IgnoreOrphanedRiskTrackingValue bool `json:"IgnoreOrphanedRiskTracking,omitempty" yaml:"IgnoreOrphanedRiskTracking"`
-
-	SkipDataFlowDiagramValue     bool `json:"SkipDataFlowDiagram,omitempty" yaml:"SkipDataFlowDiagram"`
-	SkipDataAssetDiagramValue    bool `json:"SkipDataAssetDiagram,omitempty" yaml:"SkipDataAssetDiagram"`
-	SkipRisksJSONValue           bool `json:"SkipRisksJSON,omitempty" yaml:"SkipRisksJSON"`
-	SkipTechnicalAssetsJSONValue bool `json:"SkipTechnicalAssetsJSON,omitempty" yaml:"SkipTechnicalAssetsJSON"`
-	SkipStatsJSONValue           bool `json:"SkipStatsJSON,omitempty" yaml:"SkipStatsJSON"`
-	SkipRisksExcelValue          bool `json:"SkipRisksExcel,omitempty" yaml:"SkipRisksExcel"`
-	SkipTagsExcelValue           bool `json:"SkipTagsExcel,omitempty" yaml:"SkipTagsExcel"`
-	SkipReportPDFValue           bool `json:"SkipReportPDF,omitempty" yaml:"SkipReportPDF"`
-	SkipReportADOCValue          bool `json:"SkipReportADOC,omitempty" yaml:"SkipReportADOC"`
-
-	AttractivenessValue Attractiveness `json:"Attractiveness" yaml:"Attractiveness"`
+	SkipAttackPathAnalysisValue     bool `json:"SkipAttackPathAnalysis,omitempty" yaml:"SkipAttackPathAnalysis"`
+
+	SkipDataFlowDiagramValue            bool `json:"SkipDataFlowDiagram,omitempty" yaml:"SkipDataFlowDiagram"`
+	SkipDataAssetDiagramValue           bool `json:"SkipDataAssetDiagram,omitempty" yaml:"SkipDataAssetDiagram"`
+	SkipSecureBootDiagramValue          bool `json:"SkipSecureBootDiagram,omitempty" yaml:"SkipSecureBootDiagram"`
+	SkipRisksJSONValue                  bool `json:"SkipRisksJSON,omitempty" yaml:"SkipRisksJSON"`
+	SkipTechnicalAssetsJSONValue        bool `json:"SkipTechnicalAssetsJSON,omitempty" yaml:"SkipTechnicalAssetsJSON"`
+	SkipStatsJSONValue                  bool `json:"SkipStatsJSON,omitempty" yaml:"SkipStatsJSON"`
+	SkipAttackPathsJSONValue            bool `json:"SkipAttackPathsJSON,omitempty" yaml:"SkipAttackPathsJSON"`
+	SkipCybersecurityGoalsJSONValue     bool `json:"SkipCybersecurityGoalsJSON,omitempty" yaml:"SkipCybersecurityGoalsJSON"`
+	SkipAttackerAttractivenessJSONValue bool `json:"SkipAttackerAttractivenessJSON,omitempty" yaml:"SkipAttackerAttractivenessJSON"`
+	SkipRisksExcelValue                 bool `json:"SkipRisksExcel,omitempty" yaml:"SkipRisksExcel"`
+	SkipTagsExcelValue                  bool `json:"SkipTagsExcel,omitempty" yaml:"SkipTagsExcel"`
+	SkipTaraExcelValue                  bool `json:"SkipTaraExcel,omitempty" yaml:"SkipTaraExcel"`
+	SkipReportPDFValue                  bool `json:"SkipReportPDF,omitempty" yaml:"SkipReportPDF"`
+	SkipReportADOCValue                 bool `json:"SkipReportADOC,omitempty" yaml:"SkipReportADOC"`
+
+	AttractivenessValue types.Attractiveness `json:"Attractiveness" yaml:"Attractiveness"`
+
+	TaraRiskMatrixValue map[string]map[string]int `json:"TaraRiskMatrix,omitempty" yaml:"TaraRiskMatrix"`
 
 	ReportConfigurationValue report.ReportConfiguation `json:"ReportConfiguration" yaml:"ReportConfiguration"`
 }
@@ -92,23 +116,37 @@ type ConfigGetter interface {
 	GetServerFolder() string
 	GetTempFolder() string
 	GetKeyFolder() string
-	GetTechnologyFilename() string
+	GetTechnologyFiles() []string
 	GetInputFile() string
 	GetDataFlowDiagramFilenamePNG() string
 	GetDataAssetDiagramFilenamePNG() string
 	GetDataFlowDiagramFilenameDOT() string
 	GetDataAssetDiagramFilenameDOT() string
+	GetSecureBootDiagramFilenamePNG() string
+	GetSecureBootDiagramFilenameDOT() string
 	GetReportFilename() string
 	GetExcelRisksFilename() string
 	GetExcelTagsFilename() string
+	GetExcelTaraFilename() string
 	GetJsonRisksFilename() string
 	GetJsonTechnicalAssetsFilename() string
 	GetJsonStatsFilename() string
+	GetJsonAttackPathsFilename() string
+	GetJsonCybersecurityGoalsFilename() string
+	GetJsonAttackerAttractivenessFilename() string
+	GetJsonCheckFilename() string
+	GetJsonDiffFilename() string
 	GetReportLogoImagePath() string
 	GetTemplateFilename() string
 	GetRiskRulePlugins() []string
+	GetXsamCatalogs() []string
+	GetXsamMappingFile() string
 	GetSkipRiskRules() []string
+	GetRiskRuleWorkers() int
+	GetRiskRuleTimeout() int
 	GetExecuteModelMacro() string
+	GetBaselineRisksFile() string
+	GetFailOnSeverity() string
 	GetRiskExcelConfigHideColumns() []string
 	GetRiskExcelConfigSortByColumns() []string
 	GetRiskExcelConfigWidthOfColumns() map[string]float64
@@ -126,16 +164,23 @@ type ConfigGetter interface {
 	GetAddLegend() bool
 	GetKeepDiagramSourceFiles() bool
 	GetIgnoreOrphanedRiskTracking() bool
+	GetSkipAttackPathAnalysis() bool
 	GetSkipDataFlowDiagram() bool
 	GetSkipDataAssetDiagram() bool
+	GetSkipSecureBootDiagram() bool
 	GetSkipRisksJSON() bool
 	GetSkipTechnicalAssetsJSON() bool
 	GetSkipStatsJSON() bool
+	GetSkipAttackPathsJSON() bool
+	GetSkipCybersecurityGoalsJSON() bool
+	GetSkipAttackerAttractivenessJSON() bool
 	GetSkipRisksExcel() bool
 	GetSkipTagsExcel() bool
+	GetSkipTaraExcel() bool
 	GetSkipReportPDF() bool
 	GetSkipReportADOC() bool
-	GetAttractiveness() Attractiveness
+	GetAttractiveness() types.Attractiveness
+	GetTaraRiskMatrix() map[string]map[string]int
 	GetReportConfiguration() report.ReportConfiguation
 	GetThreagileVersion() string
 	GetProgressReporter() types.ProgressReporter
@@ -152,6 +197,7 @@ type ConfigSetter interface {
 	SetInputFile(inputFile string)
 	SetTemplateFilename(templateFilename string)
 	SetRiskRulePlugins(riskRulePlugins []string)
+	SetXsamCatalogs(xsamCatalogs []string)
 	SetSkipRiskRules(skipRiskRules []string)
 	SetServerMode(serverMode bool)
 	SetServerPort(serverPort int)
@@ -173,25 +219,40 @@ func (c *Config) Defaults(buildTimestamp string) *Config {
 		TempFolderValue:   TempDir,
 		KeyFolderValue:    KeyDir,
 
-		InputFileValue:                   InputFile,
-		DataFlowDiagramFilenamePNGValue:  DataFlowDiagramFilenamePNG,
-		DataAssetDiagramFilenamePNGValue: DataAssetDiagramFilenamePNG,
-		DataFlowDiagramFilenameDOTValue:  DataFlowDiagramFilenameDOT,
-		DataAssetDiagramFilenameDOTValue: DataAssetDiagramFilenameDOT,
-		ReportFilenameValue:              ReportFilename,
-		ExcelRisksFilenameValue:          ExcelRisksFilename,
-		ExcelTagsFilenameValue:           ExcelTagsFilename,
-		JsonRisksFilenameValue:           JsonRisksFilename,
-		JsonTechnicalAssetsFilenameValue: JsonTechnicalAssetsFilename,
-		JsonStatsFilenameValue:           JsonStatsFilename,
-		TemplateFilenameValue:            TemplateFilename,
-		ReportLogoImagePathValue:         ReportLogoImagePath,
-		TechnologyFilenameValue:          "",
-		HideEmptyChaptersValue:           false,
-
+		InputFileValue:                          InputFile,
+		DataFlowDiagramFilenamePNGValue:         DataFlowDiagramFilenamePNG,
+		DataAssetDiagramFilenamePNGValue:        DataAssetDiagramFilenamePNG,
+		DataFlowDiagramFilenameDOTValue:         DataFlowDiagramFilenameDOT,
+		DataAssetDiagramFilenameDOTValue:        DataAssetDiagramFilenameDOT,
+		SecureBootDiagramFilenamePNGValue:       SecureBootDiagramFilenamePNG,
+		SecureBootDiagramFilenameDOTValue:       SecureBootDiagramFilenameDOT,
+		ReportFilenameValue:                     ReportFilename,
+		ExcelRisksFilenameValue:                 ExcelRisksFilename,
+		ExcelTagsFilenameValue:                  ExcelTagsFilename,
+		ExcelTaraFilenameValue:                  ExcelTaraFilename,
+		JsonRisksFilenameValue:                  JsonRisksFilename,
+		JsonTechnicalAssetsFilenameValue:        JsonTechnicalAssetsFilename,
+		JsonStatsFilenameValue:                  JsonStatsFilename,
+		JsonAttackPathsFilenameValue:            JsonAttackPathsFilename,
+		JsonCybersecurityGoalsFilenameValue:     JsonCybersecurityGoalsFilename,
+		JsonAttackerAttractivenessFilenameValue: JsonAttackerAttractivenessFilename,
+		JsonCheckFilenameValue:                  JsonCheckFilename,
+		JsonDiffFilenameValue:                   JsonDiffFilename,
+		TemplateFilenameValue:                   TemplateFilename,
+		ReportLogoImagePathValue:                ReportLogoImagePath,
+		TechnologyFilenameValue:                 "",
+		HideEmptyChaptersValue:                  false,
+
+		TechnologyFilesValue:   make([]string, 0),
 		RiskRulePluginsValue:   make([]string, 0),
+		XsamCatalogsValue:      make([]string, 0),
+		XsamMappingFileValue:   "",
 		SkipRiskRulesValue:     make([]string, 0),
+		RiskRuleWorkersValue:   0,
+		RiskRuleTimeoutValue:   0,
 		ExecuteModelMacroValue: "",
+		BaselineRisksFileValue: "",
+		FailOnSeverityValue:    DefaultFailOnSeverity,
 		RiskExcelValue: RiskExcelConfig{
 			HideColumns:        make([]string, 0),
 			SortByColumns:      make([]string, 0),
@@ -212,26 +273,30 @@ func (c *Config) Defaults(buildTimestamp string) *Config {
 		AddLegendValue:                  false,
 		KeepDiagramSourceFilesValue:     false,
 		IgnoreOrphanedRiskTrackingValue: false,
+		SkipAttackPathAnalysisValue:     false,
 
-		AttractivenessValue: Attractiveness{
+		AttractivenessValue: types.Attractiveness{
 			Quantity: 0,
-			Confidentiality: AttackerFocus{
+			Confidentiality: types.AttackerFocus{
 				Asset:                 0,
 				ProcessedOrStoredData: 0,
 				TransferredData:       0,
 			},
-			Integrity: AttackerFocus{
+			Integrity: types.AttackerFocus{
 				Asset:                 0,
 				ProcessedOrStoredData: 0,
 				TransferredData:       0,
 			},
-			Availability: AttackerFocus{
+			Availability: types.AttackerFocus{
 				Asset:                 0,
 				ProcessedOrStoredData: 0,
 				TransferredData:       0,
 			},
+			PivotingDepth: 1,
 		},
 
+		TaraRiskMatrixValue: make(map[string]map[string]int),
+
 		ReportConfigurationValue: report.ReportConfiguation{
 			HideChapter: make(map[report.ChaptersToShowHide]bool),
 		},
@@ -312,6 +377,10 @@ func (c *Config) Load(configFilename string) error {
 		c.TechnologyFilenameValue = c.CleanPath(c.TechnologyFilenameValue)
 	}
 
+	for index, technologyFile := range c.TechnologyFilesValue {
+		c.TechnologyFilesValue[index] = c.CleanPath(technologyFile)
+	}
+
 	serverFolderError := c.CheckServerFolder()
 	if serverFolderError != nil {
 		errorList = append(errorList, serverFolderError)
@@ -392,6 +461,12 @@ func (c *Config) Merge(config Config, values map[string]any) {
 		case strings.ToLower("DataAssetDiagramFilenameDOT"):
 			c.DataAssetDiagramFilenameDOTValue = config.DataAssetDiagramFilenameDOTValue
 
+		case strings.ToLower("SecureBootDiagramFilenamePNG"):
+			c.SecureBootDiagramFilenamePNGValue = config.SecureBootDiagramFilenamePNGValue
+
+		case strings.ToLower("SecureBootDiagramFilenameDOT"):
+			c.SecureBootDiagramFilenameDOTValue = config.SecureBootDiagramFilenameDOTValue
+
 		case strings.ToLower("ReportFilename"):
 			c.ReportFilenameValue = config.ReportFilenameValue
 
@@ -401,6 +476,9 @@ func (c *Config) Merge(config Config, values map[string]any) {
 		case strings.ToLower("ExcelTagsFilename"):
 			c.ExcelTagsFilenameValue = config.ExcelTagsFilenameValue
 
+		case strings.ToLower("ExcelTaraFilename"):
+			c.ExcelTaraFilenameValue = config.ExcelTaraFilenameValue
+
 		case strings.ToLower("JsonRisksFilename"):
 			c.JsonRisksFilenameValue = config.JsonRisksFilenameValue
 
@@ -410,6 +488,21 @@ func (c *Config) Merge(config Config, values map[string]any) {
 		case strings.ToLower("JsonStatsFilename"):
 			c.JsonStatsFilenameValue = config.JsonStatsFilenameValue
 
+		case strings.ToLower("JsonAttackPathsFilename"):
+			c.JsonAttackPathsFilenameValue = config.JsonAttackPathsFilenameValue
+
+		case strings.ToLower("JsonCybersecurityGoalsFilename"):
+			c.JsonCybersecurityGoalsFilenameValue = config.JsonCybersecurityGoalsFilenameValue
+
+		case strings.ToLower("JsonAttackerAttractivenessFilename"):
+			c.JsonAttackerAttractivenessFilenameValue = config.JsonAttackerAttractivenessFilenameValue
+
+		case strings.ToLower("JsonCheckFilename"):
+			c.JsonCheckFilenameValue = config.JsonCheckFilenameValue
+
+		case strings.ToLower("JsonDiffFilename"):
+			c.JsonDiffFilenameValue = config.JsonDiffFilenameValue
+
 		case strings.ToLower("TemplateFilename"):
 			c.TemplateFilenameValue = config.TemplateFilenameValue
 
@@ -419,18 +512,39 @@ func (c *Config) Merge(config Config, values map[string]any) {
 		case strings.ToLower("TechnologyFilename"):
 			c.TechnologyFilenameValue = config.TechnologyFilenameValue
 
+		case strings.ToLower("TechnologyFiles"):
+			c.TechnologyFilesValue = config.TechnologyFilesValue
+
 		case strings.ToLower("HideEmptyChapters"):
 			c.HideEmptyChaptersValue = config.HideEmptyChaptersValue
 
 		case strings.ToLower("RiskRulePlugins"):
 			c.RiskRulePluginsValue = config.RiskRulePluginsValue
 
+		case strings.ToLower("XsamCatalogs"):
+			c.XsamCatalogsValue = config.XsamCatalogsValue
+
+		case strings.ToLower("XsamMappingFile"):
+			c.XsamMappingFileValue = config.XsamMappingFileValue
+
 		case strings.ToLower("SkipRiskRules"):
 			c.SkipRiskRulesValue = config.SkipRiskRulesValue
 
+		case strings.ToLower("RiskRuleWorkers"):
+			c.RiskRuleWorkersValue = config.RiskRuleWorkersValue
+
+		case strings.ToLower("RiskRuleTimeout"):
+			c.RiskRuleTimeoutValue = config.RiskRuleTimeoutValue
+
 		case strings.ToLower("ExecuteModelMacro"):
 			c.ExecuteModelMacroValue = config.ExecuteModelMacroValue
 
+		case strings.ToLower("BaselineRisksFile"):
+			c.BaselineRisksFileValue = config.BaselineRisksFileValue
+
+		case strings.ToLower("FailOnSeverity"):
+			c.FailOnSeverityValue = config.FailOnSeverityValue
+
 		case strings.ToLower("RiskExcel"):
 			configMap, mapOk := values[key].(map[string]any)
 			if !mapOk {
@@ -495,9 +609,27 @@ func (c *Config) Merge(config Config, values map[string]any) {
 		case strings.ToLower("IgnoreOrphanedRiskTracking"):
 			c.IgnoreOrphanedRiskTrackingValue = config.IgnoreOrphanedRiskTrackingValue
 
+		case strings.ToLower("SkipAttackPathAnalysis"):
+			c.SkipAttackPathAnalysisValue = config.SkipAttackPathAnalysisValue
+
 		case strings.ToLower("Attractiveness"):
 			c.AttractivenessValue = config.AttractivenessValue
 
+		case strings.ToLower("TaraRiskMatrix"):
+			if c.TaraRiskMatrixValue == nil {
+				c.TaraRiskMatrixValue = make(map[string]map[string]int)
+			}
+
+			for impact, row := range config.TaraRiskMatrixValue {
+				if c.TaraRiskMatrixValue[impact] == nil {
+					c.TaraRiskMatrixValue[impact] = make(map[string]int)
+				}
+
+				for feasibility, value := range row {
+					c.TaraRiskMatrixValue[impact][feasibility] = value
+				}
+			}
+
 		case strings.ToLower("ReportConfiguration"):
 			configMap, mapOk := values[key].(map[string]any)
 			if !mapOk {
@@ -635,8 +767,14 @@ func (c *Config) GetKeyFolder() string {
 	return c.KeyFolderValue
 }
 
-func (c *Config) GetTechnologyFilename() string {
-	return c.TechnologyFilenameValue
+// GetTechnologyFiles lists the technology files layered in order over the built-in technologies, including the
+// (deprecated) single technology file as last layer
+func (c *Config) GetTechnologyFiles() []string {
+	if c.TechnologyFilenameValue == "" || slices.Contains(c.TechnologyFilesValue, c.TechnologyFilenameValue) {
+		return c.TechnologyFilesValue
+	}
+
+	return append(slices.Clone(c.TechnologyFilesValue), c.TechnologyFilenameValue)
 }
 
 func (c *Config) GetHideEmptyChapters() bool {
@@ -675,6 +813,14 @@ func (c *Config) GetDataAssetDiagramFilenameDOT() string {
 	return c.DataAssetDiagramFilenameDOTValue
 }
 
+func (c *Config) GetSecureBootDiagramFilenamePNG() string {
+	return c.SecureBootDiagramFilenamePNGValue
+}
+
+func (c *Config) GetSecureBootDiagramFilenameDOT() string {
+	return c.SecureBootDiagramFilenameDOTValue
+}
+
 func (c *Config) GetReportFilename() string {
 	return c.ReportFilenameValue
 }
@@ -687,6 +833,10 @@ func (c *Config) GetExcelTagsFilename() string {
 	return c.ExcelTagsFilenameValue
 }
 
+func (c *Config) GetExcelTaraFilename() string {
+	return c.ExcelTaraFilenameValue
+}
+
 func (c *Config) GetJsonRisksFilename() string {
 	return c.JsonRisksFilenameValue
 }
@@ -699,6 +849,26 @@ func (c *Config) GetJsonStatsFilename() string {
 	return c.JsonStatsFilenameValue
 }
 
+func (c *Config) GetJsonAttackPathsFilename() string {
+	return c.JsonAttackPathsFilenameValue
+}
+
+func (c *Config) GetJsonCybersecurityGoalsFilename() string {
+	return c.JsonCybersecurityGoalsFilenameValue
+}
+
+func (c *Config) GetJsonAttackerAttractivenessFilename() string {
+	return c.JsonAttackerAttractivenessFilenameValue
+}
+
+func (c *Config) GetJsonCheckFilename() string {
+	return c.JsonCheckFilenameValue
+}
+
+func (c *Config) GetJsonDiffFilename() string {
+	return c.JsonDiffFilenameValue
+}
+
 func (c *Config) GetReportLogoImagePath() string {
 	return c.ReportLogoImagePathValue
 }
@@ -719,10 +889,30 @@ func (c *Config) SetRiskRulePlugins(riskRulePlugins []string) {
 	c.RiskRulePluginsValue = riskRulePlugins
 }
 
+func (c *Config) GetXsamCatalogs() []string {
+	return c.XsamCatalogsValue
+}
+
+func (c *Config) SetXsamCatalogs(xsamCatalogs []string) {
+	c.XsamCatalogsValue = xsamCatalogs
+}
+
+func (c *Config) GetXsamMappingFile() string {
+	return c.XsamMappingFileValue
+}
+
 func (c *Config) GetSkipRiskRules() []string {
 	return c.SkipRiskRulesValue
 }
 
+func (c *Config) GetRiskRuleWorkers() int {
+	return c.RiskRuleWorkersValue
+}
+
+func (c *Config) GetRiskRuleTimeout() int {
+	return c.RiskRuleTimeoutValue
+}
+
 func (c *Config) SetSkipRiskRules(skipRiskRules []string) {
 	c.SkipRiskRulesValue = skipRiskRules
 }
@@ -731,6 +921,14 @@ func (c *Config) GetExecuteModelMacro() string {
 	return c.ExecuteModelMacroValue
 }
 
+func (c *Config) GetBaselineRisksFile() string {
+	return c.BaselineRisksFileValue
+}
+
+func (c *Config) GetFailOnSeverity() string {
+	return c.FailOnSeverityValue
+}
+
 func (c *Config) GetRiskExcelConfigHideColumns() []string {
 	return c.RiskExcelValue.HideColumns
 }
@@ -815,6 +1013,10 @@ func (c *Config) SetIgnoreOrphanedRiskTracking(ignoreOrphanedRiskTracking bool)
 	c.IgnoreOrphanedRiskTrackingValue = ignoreOrphanedRiskTracking
 }
 
+func (c *Config) GetSkipAttackPathAnalysis() bool {
+	return c.SkipAttackPathAnalysisValue
+}
+
 func (c *Config) GetSkipDataFlowDiagram() bool {
 	return c.SkipDataFlowDiagramValue
 }
@@ -823,6 +1025,10 @@ func (c *Config) GetSkipDataAssetDiagram() bool {
 	return c.SkipDataAssetDiagramValue
 }
 
+func (c *Config) GetSkipSecureBootDiagram() bool {
+	return c.SkipSecureBootDiagramValue
+}
+
 func (c *Config) GetSkipRisksJSON() bool {
 	return c.SkipRisksJSONValue
 }
@@ -835,6 +1041,18 @@ func (c *Config) GetSkipStatsJSON() bool {
 	return c.SkipStatsJSONValue
 }
 
+func (c *Config) GetSkipAttackPathsJSON() bool {
+	return c.SkipAttackPathsJSONValue
+}
+
+func (c *Config) GetSkipCybersecurityGoalsJSON() bool {
+	return c.SkipCybersecurityGoalsJSONValue
+}
+
+func (c *Config) GetSkipAttackerAttractivenessJSON() bool {
+	return c.SkipAttackerAttractivenessJSONValue
+}
+
 func (c *Config) GetSkipRisksExcel() bool {
 	return c.SkipRisksExcelValue
 }
@@ -843,6 +1061,10 @@ func (c *Config) GetSkipTagsExcel() bool {
 	return c.SkipTagsExcelValue
 }
 
+func (c *Config) GetSkipTaraExcel() bool {
+	return c.SkipTaraExcelValue
+}
+
 func (c *Config) GetSkipReportPDF() bool {
 	return c.SkipReportPDFValue
 }
@@ -851,10 +1073,14 @@ func (c *Config) GetSkipReportADOC() bool {
 	return c.SkipReportADOCValue
 }
 
-func (c *Config) GetAttractiveness() Attractiveness {
+func (c *Config) GetAttractiveness() types.Attractiveness {
 	return c.AttractivenessValue
 }
 
+func (c *Config) GetTaraRiskMatrix() map[string]map[string]int {
+	return c.TaraRiskMatrixValue
+}
+
 func (c *Config) GetReportConfiguration() report.ReportConfiguation {
 	return c.ReportConfigurationValue
 }
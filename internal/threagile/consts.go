@@ -15,29 +15,44 @@ const (
 	ReportFilename              = "report.pdf"
 	ExcelRisksFilename          = "risks.xlsx"
 	ExcelTagsFilename           = "tags.xlsx"
+	ExcelTaraFilename           = "tara.xlsx"
 	JsonRisksFilename           = "risks.json"
 	JsonTechnicalAssetsFilename = "technical-assets.json"
 	JsonStatsFilename           = "stats.json"
+	JsonAttackPathsFilename     = "attack-paths.json"
+	JsonCybersecurityGoalsFilename = "cybersecurity-goals.json"
+	JsonAttackerAttractivenessFilename = "attacker-attractiveness.json"
+	JsonCheckFilename           = "check.json"
+	JsonDiffFilename            = "diff.json"
+	VehicleNetworkFilename      = "threagile-vehicle-network.yaml"
+	MermaidModelFilename        = "threagile-mermaid-model.yaml"
 	TemplateFilename            = "background.pdf"
 	ReportLogoImagePath         = "report/threagile-logo.png"
 	DataFlowDiagramFilenameDOT  = "data-flow-diagram.gv"
 	DataFlowDiagramFilenamePNG  = "data-flow-diagram.png"
 	DataAssetDiagramFilenameDOT = "data-asset-diagram.gv"
 	DataAssetDiagramFilenamePNG = "data-asset-diagram.png"
+	SecureBootDiagramFilenameDOT = "secure-boot-diagram.gv"
+	SecureBootDiagramFilenamePNG = "secure-boot-diagram.png"
 
 	DefaultDiagramDPI               = 100
 	DefaultGraphvizDPI              = 120
 	MinGraphvizDPI                  = 20
 	MaxGraphvizDPI                  = 300
 	DefaultBackupHistoryFilesToKeep = 50
+	DefaultFailOnSeverity           = "high"
 )
 
 const (
 	AnalyzeModelCommand         = "analyze-model"
+	CheckModelCommand           = "check-model"
 	CreateExampleModelCommand   = "create-example-model"
 	CreateStubModelCommand      = "create-stub-model"
+	DiffModelCommand            = "diff-model"
 	CreateEditingSupportCommand = "create-editing-support"
 	ImportModelCommand         	= "import-model"
+	ImportVehicleNetworkCommand = "import-vehicle-network"
+	ImportMermaidCommand        = "import-mermaid"
 	ListTypesCommand            = "list-types"
 	ListRiskRulesCommand        = "list-risk-rules"
 	ListModelMacrosCommand      = "list-model-macros"
@@ -0,0 +1,82 @@
+package threagile
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/threagile/threagile/pkg/input"
+	"github.com/threagile/threagile/pkg/model"
+	"github.com/threagile/threagile/pkg/report"
+	"github.com/threagile/threagile/pkg/risks"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func (what *Threagile) initDiff() *Threagile {
+	what.rootCmd.AddCommand(&cobra.Command{
+		Use:   DiffModelCommand + " <previous-model-file> <model-file>",
+		Short: "Compare two versions of a model",
+		Long: "\n" + Logo + "\n\n" + fmt.Sprintf(VersionText, what.buildTimestamp) + "\n\nanalyze two versions of a model and report " +
+			"the technical assets, communication links, data assets and trust boundaries added, removed or modified as well as " +
+			"the new, resolved and rerated risks, generating the reports of the second version with an additional chapter on the changes",
+		Aliases: []string{"diff"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			what.processArgs(cmd, args)
+			commands := what.readCommands()
+			progressReporter := DefaultProgressReporter{Verbose: what.config.GetVerbose()}
+
+			builtinRiskRules := risks.GetBuiltInRiskRules()
+			customRiskRules := model.LoadCustomAndXsamRiskRules(what.config, builtinRiskRules, progressReporter)
+
+			from, err := what.analyzeModelFile(what.config.CleanPath(args[0]), builtinRiskRules, customRiskRules, progressReporter)
+			if err != nil {
+				return err
+			}
+
+			to, err := what.analyzeModelFile(what.config.CleanPath(args[1]), builtinRiskRules, customRiskRules, progressReporter)
+			if err != nil {
+				return err
+			}
+
+			diff := types.DiffModels(args[0], from.ParsedModel, args[1], to.ParsedModel)
+			err = report.WriteModelDiffJSON(diff, filepath.Join(what.config.GetOutputFolder(), what.config.GetJsonDiffFilename()))
+			if err != nil {
+				return err
+			}
+
+			for _, line := range report.ModelDiffSummary(diff) {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), line)
+			}
+
+			to.ParsedModel.ModelDiff = diff
+			what.config.InputFileValue = what.config.CleanPath(args[1])
+			err = report.Generate(what.config, to, commands, builtinRiskRules, progressReporter)
+			if err != nil {
+				return fmt.Errorf("failed to generate reports: %w", err)
+			}
+			return nil
+		},
+		CompletionOptions: cobra.CompletionOptions{
+			DisableDefaultCmd: true,
+		},
+	})
+
+	return what
+}
+
+func (what *Threagile) analyzeModelFile(filename string, builtinRiskRules types.RiskRules, customRiskRules types.RiskRules, progressReporter DefaultProgressReporter) (*model.ReadResult, error) {
+	progressReporter.Infof("Parsing model: %v", filename)
+
+	modelInput := new(input.Model).Defaults()
+	err := modelInput.Load(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load model yaml %q: %w", filename, err)
+	}
+
+	result, err := model.AnalyzeModel(modelInput, what.config, builtinRiskRules, customRiskRules, progressReporter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze model %q: %w", filename, err)
+	}
+	return result, nil
+}
@@ -26,7 +26,7 @@ func (what *Threagile) initExplainNew() *Threagile {
 			Use:        RiskItem,
 			Short:      "Detailed explanation of why a risk was flagged",
 			Args:       cobra.MinimumNArgs(1),
-			ArgAliases: []string{"risk_id", "..."},
+			ArgAliases: []string{"synthetic_risk_id", "..."},
 			RunE:       what.explainRisk,
 		},
 		&cobra.Command{
@@ -61,10 +61,18 @@ func (what *Threagile) explainRisk(cmd *cobra.Command, args []string) error {
 		return runError
 	}
 
-	// todo: implement this
+	for n, risk := range args {
+		if n > 0 {
+			cmd.Println()
+		}
 
-	_ = result
-	return fmt.Errorf("not implemented yet")
+		explainError := result.ExplainRisk(risk, cmd)
+		if explainError != nil {
+			return explainError
+		}
+	}
+
+	return nil
 }
 
 func (what *Threagile) explainRules(cmd *cobra.Command, args []string) error {
@@ -76,7 +84,8 @@ func (what *Threagile) explainRules(cmd *cobra.Command, args []string) error {
 	cmd.Println("----------------------")
 	cmd.Println("Custom risk rules:")
 	cmd.Println("----------------------")
-	customRiskRules := model.LoadCustomRiskRules(what.config.GetPluginFolder(), what.config.GetRiskRulePlugins(), DefaultProgressReporter{Verbose: what.config.GetVerbose()})
+	builtinRiskRules := risks.GetBuiltInRiskRules()
+	customRiskRules := model.LoadCustomAndXsamRiskRules(what.config, builtinRiskRules, DefaultProgressReporter{Verbose: what.config.GetVerbose()})
 	for _, rule := range customRiskRules {
 		cmd.Printf("%v: %v\n", rule.Category().ID, rule.Category().Description)
 	}
@@ -85,7 +94,7 @@ func (what *Threagile) explainRules(cmd *cobra.Command, args []string) error {
 	cmd.Println("Built-in risk rules:")
 	cmd.Println("--------------------")
 	cmd.Println()
-	for _, rule := range risks.GetBuiltInRiskRules() {
+	for _, rule := range builtinRiskRules {
 		cmd.Printf("%v: %v\n", rule.Category().ID, rule.Category().Description)
 	}
 	cmd.Println()
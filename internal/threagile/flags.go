@@ -21,25 +21,39 @@ const (
 	tempDirFlagName   = "temp-dir"
 	keyDirFlagName    = "key-dir"
 
-	inputFileFlagName               = "model"
-	importedFileFlagName            = "imported-model"
-	dataFlowDiagramPNGFileFlagName  = "data-flow-diagram-png"
-	dataAssetDiagramPNGFileFlagName = "data-asset-diagram-png"
-	dataFlowDiagramDOTFileFlagName  = "data-flow-diagram-dot"
-	dataAssetDiagramDOTFileFlagName = "data-asset-diagram-dot"
-	reportFileFlagName              = "report"
-	risksExcelFileFlagName          = "risks-excel"
-	tagsExcelFileFlagName           = "tags-excel"
-	risksJsonFileFlagName           = "risks-json"
-	technicalAssetsJsonFileFlagName = "technical-assets-json"
-	statsJsonFileFlagName           = "stats-json"
-	templateFileNameFlagName        = "background"
-	reportLogoImagePathFlagName     = "reportLogoImagePath"
-	technologyFileFlagName          = "technology"
+	inputFileFlagName                      = "model"
+	importedFileFlagName                   = "imported-model"
+	dataFlowDiagramPNGFileFlagName         = "data-flow-diagram-png"
+	dataAssetDiagramPNGFileFlagName        = "data-asset-diagram-png"
+	dataFlowDiagramDOTFileFlagName         = "data-flow-diagram-dot"
+	dataAssetDiagramDOTFileFlagName        = "data-asset-diagram-dot"
+	secureBootDiagramPNGFileFlagName       = "secure-boot-diagram-png"
+	secureBootDiagramDOTFileFlagName       = "secure-boot-diagram-dot"
+	reportFileFlagName                     = "report"
+	risksExcelFileFlagName                 = "risks-excel"
+	tagsExcelFileFlagName                  = "tags-excel"
+	taraExcelFileFlagName                  = "tara-excel"
+	risksJsonFileFlagName                  = "risks-json"
+	technicalAssetsJsonFileFlagName        = "technical-assets-json"
+	statsJsonFileFlagName                  = "stats-json"
+	attackPathsJsonFileFlagName            = "attack-paths-json"
+	cybersecurityGoalsJsonFileFlagName     = "cybersecurity-goals-json"
+	attackerAttractivenessJsonFileFlagName = "attacker-attractiveness-json"
+	checkJsonFileFlagName                  = "check-json"
+	diffJsonFileFlagName                   = "diff-json"
+	templateFileNameFlagName               = "background"
+	reportLogoImagePathFlagName            = "reportLogoImagePath"
+	technologyFileFlagName                 = "technology"
 
 	customRiskRulesPluginFlagName = "custom-risk-rules-plugin"
 	skipRiskRulesFlagName         = "skip-risk-rules"
+	riskRuleWorkersFlagName       = "risk-rule-workers"
+	riskRuleTimeoutFlagName       = "risk-rule-timeout"
+	xsamCatalogsFlagName          = "xsam-catalogs"
+	xsamMappingFileFlagName       = "xsam-mapping"
 	executeModelMacroFlagName     = "execute-model-macro"
+	baselineRisksFileFlagName     = "baseline"
+	failOnSeverityFlagName        = "fail-on-severity"
 
 	serverModeFlagName               = "server-mode"
 	serverPortFlagName               = "server-port"
@@ -50,16 +64,22 @@ const (
 	addModelTitleFlagName              = "add-model-title"
 	keepDiagramSourceFilesFlagName     = "keep-diagram-source-files"
 	ignoreOrphanedRiskTrackingFlagName = "ignore-orphaned-risk-tracking"
+	skipAttackPathAnalysisFlagName     = "skip-attack-path-analysis"
 
-	skipDataFlowDiagramFlagName     = "skip-data-flow-diagram"
-	skipDataAssetDiagramFlagName    = "skip-data-asset-diagram"
-	skipRisksJSONFlagName           = "skip-risks-json"
-	skipTechnicalAssetsJSONFlagName = "skip-technical-assets-json"
-	skipStatsJSONFlagName           = "skip-stats-json"
-	skipRisksExcelFlagName          = "skip-risks-excel"
-	skipTagsExcelFlagName           = "skip-tags-excel"
-	skipReportPDFFlagName           = "skip-report-pdf"
-	skipReportADOCFlagName          = "skip-report-adoc"
+	skipDataFlowDiagramFlagName            = "skip-data-flow-diagram"
+	skipDataAssetDiagramFlagName           = "skip-data-asset-diagram"
+	skipSecureBootDiagramFlagName          = "skip-secure-boot-diagram"
+	skipRisksJSONFlagName                  = "skip-risks-json"
+	skipTechnicalAssetsJSONFlagName        = "skip-technical-assets-json"
+	skipStatsJSONFlagName                  = "skip-stats-json"
+	skipAttackPathsJSONFlagName            = "skip-attack-paths-json"
+	skipCybersecurityGoalsJSONFlagName     = "skip-cybersecurity-goals-json"
+	skipAttackerAttractivenessJSONFlagName = "skip-attacker-attractiveness-json"
+	skipRisksExcelFlagName                 = "skip-risks-excel"
+	skipTagsExcelFlagName                  = "skip-tags-excel"
+	skipTaraExcelFlagName                  = "skip-tara-excel"
+	skipReportPDFFlagName                  = "skip-report-pdf"
+	skipReportADOCFlagName                 = "skip-report-adoc"
 
 	generateDataFlowDiagramFlagName     = "generate-data-flow-diagram"
 	generateDataAssetDiagramFlagName    = "generate-data-asset-diagram"
@@ -78,6 +98,8 @@ type Flags struct {
 	configFlag           string
 	riskRulePluginsValue string
 	skipRiskRulesValue   string
+	xsamCatalogsValue    string
+	technologyFilesValue string
 
 	generateDataFlowDiagramFlag     bool // deprecated
 	generateDataAssetDiagramFlag    bool // deprecated
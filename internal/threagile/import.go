@@ -2,10 +2,17 @@ package threagile
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
+	"github.com/threagile/threagile/pkg/importer"
 	"github.com/threagile/threagile/pkg/model"
 	"github.com/threagile/threagile/pkg/report"
 	"github.com/threagile/threagile/pkg/risks"
+	"gopkg.in/yaml.v3"
 )
 
 func (what *Threagile) initImport() *Threagile {
@@ -18,12 +25,13 @@ func (what *Threagile) initImport() *Threagile {
 			commands := what.readCommands()
 			progressReporter := DefaultProgressReporter{Verbose: what.config.GetVerbose()}
 
-			r, err := model.ReadAndAnalyzeModel(what.config, risks.GetBuiltInRiskRules(), progressReporter)
+			builtinRiskRules := risks.GetBuiltInRiskRules()
+			r, err := model.ReadAndAnalyzeModel(what.config, builtinRiskRules, progressReporter)
 			if err != nil {
 				return fmt.Errorf("failed to read and analyze model: %w", err)
 			}
 
-			err = report.Generate(what.config, r, commands, risks.GetBuiltInRiskRules(), progressReporter)
+			err = report.Generate(what.config, r, commands, builtinRiskRules, progressReporter)
 			if err != nil {
 				return fmt.Errorf("failed to generate reports: %w", err)
 			}
@@ -37,5 +45,81 @@ func (what *Threagile) initImport() *Threagile {
 
 	what.rootCmd.AddCommand(analyze)
 
+	what.rootCmd.AddCommand(&cobra.Command{
+		Use:   ImportVehicleNetworkCommand + " [file.arxml|file.dbc]...",
+		Short: "Import AUTOSAR ARXML and CAN DBC files into a model file to be included",
+		Long: "\n" + Logo + "\n\n" + fmt.Sprintf(VersionText, what.buildTimestamp) + "\n\nimport the ECU instances, software components, ports and PDUs of ARXML files " +
+			"and the nodes, messages and signals of DBC files (named after their bus) into technical assets, communication links and data assets " +
+			"written to " + VehicleNetworkFilename + " in the output directory, to be refined and merged into a model via includes",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			what.processArgs(cmd, args)
+
+			network, err := importer.ReadVehicleNetworkFiles(args...)
+			if err != nil {
+				return fmt.Errorf("failed to import vehicle network: %w", err)
+			}
+
+			data, err := yaml.Marshal(network.Model())
+			if err != nil {
+				return fmt.Errorf("failed to marshal vehicle network model: %w", err)
+			}
+
+			filename := filepath.Join(what.config.GetOutputFolder(), VehicleNetworkFilename)
+			err = os.WriteFile(filename, data, 0600)
+			if err != nil {
+				return fmt.Errorf("failed to write vehicle network model: %w", err)
+			}
+
+			cmd.Printf("Imported %d ECUs, %d software components and %d messages into %q.\n",
+				len(network.Ecus), len(network.SoftwareComponents), len(network.Messages), filename)
+			return nil
+		},
+	})
+
+	what.rootCmd.AddCommand(&cobra.Command{
+		Use:   ImportMermaidCommand + " [file.md|file.mmd]",
+		Short: "Create a model stub from the Mermaid flowcharts of a design document",
+		Long: "\n" + Logo + "\n\n" + fmt.Sprintf(VersionText, what.buildTimestamp) + "\n\ncreate a model stub named " + MermaidModelFilename +
+			" in the output directory from the Mermaid flowcharts (of the mermaid code blocks of a markdown document), " +
+			"with a trust boundary per subgraph, a technical asset per node and a communication link per edge",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			what.processArgs(cmd, args)
+
+			file, err := os.Open(filepath.Clean(args[0]))
+			if err != nil {
+				return fmt.Errorf("failed to open %q: %w", args[0], err)
+			}
+			flowchart, err := importer.ReadMermaid(file)
+			_ = file.Close()
+			if err != nil {
+				return fmt.Errorf("failed to read %q: %w", args[0], err)
+			}
+
+			stub := flowchart.Model()
+			stub.ThreagileVersion = ThreagileVersion
+			stub.Date = time.Now().Format("2006-01-02")
+			if len(stub.Title) == 0 {
+				stub.Title = strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+			}
+
+			data, err := yaml.Marshal(stub)
+			if err != nil {
+				return fmt.Errorf("failed to marshal model stub: %w", err)
+			}
+
+			filename := filepath.Join(what.config.GetOutputFolder(), MermaidModelFilename)
+			err = os.WriteFile(filename, data, 0600)
+			if err != nil {
+				return fmt.Errorf("failed to write model stub: %w", err)
+			}
+
+			cmd.Printf("Created a model stub with %d trust boundaries, %d technical assets and %d communication links in %q.\n",
+				len(flowchart.Subgraphs), len(flowchart.Nodes), len(flowchart.Edges), filename)
+			return nil
+		},
+	})
+
 	return what
 }
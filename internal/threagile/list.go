@@ -22,7 +22,8 @@ func (what *Threagile) initList() *Threagile {
 			cmd.Println("----------------------")
 			cmd.Println("Custom risk rules:")
 			cmd.Println("----------------------")
-			customRiskRules := model.LoadCustomRiskRules(what.config.GetPluginFolder(), what.config.GetRiskRulePlugins(), DefaultProgressReporter{Verbose: what.config.GetVerbose()})
+			builtinRiskRules := risks.GetBuiltInRiskRules()
+			customRiskRules := model.LoadCustomAndXsamRiskRules(what.config, builtinRiskRules, DefaultProgressReporter{Verbose: what.config.GetVerbose()})
 			for id, customRule := range customRiskRules {
 				cmd.Println(id, "-->", customRule.Category().Title, "--> with tags:", customRule.SupportedTags())
 			}
@@ -31,7 +32,7 @@ func (what *Threagile) initList() *Threagile {
 			cmd.Println("Built-in risk rules:")
 			cmd.Println("--------------------")
 			cmd.Println()
-			for _, rule := range risks.GetBuiltInRiskRules() {
+			for _, rule := range builtinRiskRules {
 				cmd.Println(rule.Category().ID, "-->", rule.Category().Title, "--> with tags:", rule.SupportedTags())
 			}
 
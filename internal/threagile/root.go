@@ -76,19 +76,33 @@ func (what *Threagile) initFlags() *Threagile {
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.DataAssetDiagramFilenamePNGValue, dataAssetDiagramPNGFileFlagName, what.config.GetDataAssetDiagramFilenamePNG(), "data asset diagram PNG file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.DataFlowDiagramFilenameDOTValue, dataFlowDiagramDOTFileFlagName, what.config.GetDataFlowDiagramFilenameDOT(), "data flow diagram DOT file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.DataAssetDiagramFilenameDOTValue, dataAssetDiagramDOTFileFlagName, what.config.GetDataAssetDiagramFilenameDOT(), "data asset diagram DOT file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.SecureBootDiagramFilenamePNGValue, secureBootDiagramPNGFileFlagName, what.config.GetSecureBootDiagramFilenamePNG(), "secure boot diagram PNG file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.SecureBootDiagramFilenameDOTValue, secureBootDiagramDOTFileFlagName, what.config.GetSecureBootDiagramFilenameDOT(), "secure boot diagram DOT file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.ReportFilenameValue, reportFileFlagName, what.config.GetReportFilename(), "report file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.ExcelRisksFilenameValue, risksExcelFileFlagName, what.config.GetExcelRisksFilename(), "risks Excel file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.ExcelTagsFilenameValue, tagsExcelFileFlagName, what.config.GetExcelTagsFilename(), "tags Excel file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.ExcelTaraFilenameValue, taraExcelFileFlagName, what.config.GetExcelTaraFilename(), "TARA Excel file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonRisksFilenameValue, risksJsonFileFlagName, what.config.GetJsonRisksFilename(), "risks JSON file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonTechnicalAssetsFilenameValue, technicalAssetsJsonFileFlagName, what.config.GetJsonTechnicalAssetsFilename(), "technical assets JSON file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonStatsFilenameValue, statsJsonFileFlagName, what.config.GetJsonStatsFilename(), "stats JSON file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonAttackPathsFilenameValue, attackPathsJsonFileFlagName, what.config.GetJsonAttackPathsFilename(), "attack paths JSON file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonCybersecurityGoalsFilenameValue, cybersecurityGoalsJsonFileFlagName, what.config.GetJsonCybersecurityGoalsFilename(), "cybersecurity goals JSON file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonAttackerAttractivenessFilenameValue, attackerAttractivenessJsonFileFlagName, what.config.GetJsonAttackerAttractivenessFilename(), "attacker attractiveness (RAA) breakdown JSON file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonCheckFilenameValue, checkJsonFileFlagName, what.config.GetJsonCheckFilename(), "check result JSON file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.JsonDiffFilenameValue, diffJsonFileFlagName, what.config.GetJsonDiffFilename(), "model diff JSON file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.TemplateFilenameValue, templateFileNameFlagName, what.config.GetTemplateFilename(), "template pdf file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.ReportLogoImagePathValue, reportLogoImagePathFlagName, what.config.GetReportLogoImagePath(), "report logo image")
-	what.rootCmd.PersistentFlags().StringVar(&what.flags.TechnologyFilenameValue, technologyFileFlagName, what.config.GetTechnologyFilename(), "file name of additional technologies")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.technologyFilesValue, technologyFileFlagName, strings.Join(what.config.GetTechnologyFiles(), ","), "comma-separated list of technology files layered in order over the built-in technologies")
 
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.riskRulePluginsValue, customRiskRulesPluginFlagName, strings.Join(what.config.GetRiskRulePlugins(), ","), "comma-separated list of plugins file names with custom risk rules to load")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.skipRiskRulesValue, skipRiskRulesFlagName, strings.Join(what.config.GetSkipRiskRules(), ","), "comma-separated list of risk rules (by their ID) to skip")
+	what.rootCmd.PersistentFlags().IntVar(&what.flags.RiskRuleWorkersValue, riskRuleWorkersFlagName, what.config.GetRiskRuleWorkers(), "number of risk rules to evaluate in parallel (default: number of CPUs)")
+	what.rootCmd.PersistentFlags().IntVar(&what.flags.RiskRuleTimeoutValue, riskRuleTimeoutFlagName, what.config.GetRiskRuleTimeout(), "timeout in seconds for evaluating a single risk rule (default: no timeout)")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.xsamCatalogsValue, xsamCatalogsFlagName, strings.Join(what.config.GetXsamCatalogs(), ","), "comma-separated list of XSAM threat catalog files to load as risk rules (optionally prefixed by an ID namespace: namespace=file.xsam)")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.XsamMappingFileValue, xsamMappingFileFlagName, what.config.GetXsamMappingFile(), "YAML file mapping XSAM threat classes to STRIDE and ratings (default: built-in mapping)")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.ExecuteModelMacroValue, executeModelMacroFlagName, what.config.GetExecuteModelMacro(), "macro to execute")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.BaselineRisksFileValue, baselineRisksFileFlagName, what.config.GetBaselineRisksFile(), "risks JSON file (as written by analyze-model) to check the risks of the model against")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.FailOnSeverityValue, failOnSeverityFlagName, what.config.GetFailOnSeverity(), "lowest severity of new unchecked risks failing the check")
 
 	// RiskExcelValue not available as flags
 
@@ -101,14 +115,20 @@ func (what *Threagile) initFlags() *Threagile {
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.AddModelTitleValue, addModelTitleFlagName, what.config.GetAddModelTitle(), "add model title")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.KeepDiagramSourceFilesValue, keepDiagramSourceFilesFlagName, what.config.GetKeepDiagramSourceFiles(), "keep diagram source files")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.IgnoreOrphanedRiskTrackingValue, ignoreOrphanedRiskTrackingFlagName, what.config.GetIgnoreOrphanedRiskTracking(), "ignore orphaned risk tracking (just log them) not matching a concrete risk")
+	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipAttackPathAnalysisValue, skipAttackPathAnalysisFlagName, what.config.GetSkipAttackPathAnalysis(), "skip the attack path analysis (e.g. for large and densely linked models)")
 
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipDataFlowDiagramValue, skipDataFlowDiagramFlagName, what.config.GetSkipDataFlowDiagram(), "skip generating data flow diagram")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipDataAssetDiagramValue, skipDataAssetDiagramFlagName, what.config.GetSkipDataAssetDiagram(), "skip generating data asset diagram")
+	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipSecureBootDiagramValue, skipSecureBootDiagramFlagName, what.config.GetSkipSecureBootDiagram(), "skip generating secure boot diagram")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipRisksJSONValue, skipRisksJSONFlagName, what.config.GetSkipRisksJSON(), "skip generating risks json")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipTechnicalAssetsJSONValue, skipTechnicalAssetsJSONFlagName, what.config.GetSkipTechnicalAssetsJSON(), "skip generating technical assets json")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipStatsJSONValue, skipStatsJSONFlagName, what.config.GetSkipStatsJSON(), "skip generating stats json")
+	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipAttackPathsJSONValue, skipAttackPathsJSONFlagName, what.config.GetSkipAttackPathsJSON(), "skip generating attack paths json")
+	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipCybersecurityGoalsJSONValue, skipCybersecurityGoalsJSONFlagName, what.config.GetSkipCybersecurityGoalsJSON(), "skip generating cybersecurity goals json")
+	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipAttackerAttractivenessJSONValue, skipAttackerAttractivenessJSONFlagName, what.config.GetSkipAttackerAttractivenessJSON(), "skip generating attacker attractiveness json")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipRisksExcelValue, skipRisksExcelFlagName, what.config.GetSkipRisksExcel(), "skip generating risks excel")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipTagsExcelValue, skipTagsExcelFlagName, what.config.GetSkipTagsExcel(), "skip generating tags excel")
+	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipTaraExcelValue, skipTaraExcelFlagName, what.config.GetSkipTaraExcel(), "skip generating TARA excel")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipReportPDFValue, skipReportPDFFlagName, what.config.GetSkipReportPDF(), "skip generating report pdf, including diagrams")
 	what.rootCmd.PersistentFlags().BoolVar(&what.flags.SkipReportADOCValue, skipReportADOCFlagName, what.config.GetSkipReportADOC(), "skip generating report adoc, including diagrams")
 
@@ -255,11 +275,16 @@ func (what *Threagile) readCommands() *report.GenerateCommands {
 	commands := new(report.GenerateCommands).Defaults()
 	commands.DataFlowDiagram = !what.flags.SkipDataFlowDiagramValue
 	commands.DataAssetDiagram = !what.flags.SkipDataAssetDiagramValue
+	commands.SecureBootDiagram = !what.flags.SkipSecureBootDiagramValue
 	commands.RisksJSON = !what.flags.SkipRisksJSONValue
 	commands.StatsJSON = !what.flags.SkipStatsJSONValue
 	commands.TechnicalAssetsJSON = !what.flags.SkipTechnicalAssetsJSONValue
+	commands.AttackPathsJSON = !what.flags.SkipAttackPathsJSONValue
+	commands.CybersecurityGoalsJSON = !what.flags.SkipCybersecurityGoalsJSONValue
+	commands.AttackerAttractivenessJSON = !what.flags.SkipAttackerAttractivenessJSONValue
 	commands.RisksExcel = !what.flags.SkipRisksExcelValue
 	commands.TagsExcel = !what.flags.SkipTagsExcelValue
+	commands.TaraExcel = !what.flags.SkipTaraExcelValue
 	commands.ReportPDF = !what.flags.SkipReportPDFValue
 	commands.ReportADOC = !what.flags.SkipReportADOCValue
 	return commands
@@ -341,6 +366,14 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.DataAssetDiagramFilenameDOTValue = what.config.CleanPath(what.flags.DataAssetDiagramFilenameDOTValue)
 	}
 
+	if what.isFlagOverridden(cmd, secureBootDiagramPNGFileFlagName) {
+		what.config.SecureBootDiagramFilenamePNGValue = what.config.CleanPath(what.flags.SecureBootDiagramFilenamePNGValue)
+	}
+
+	if what.isFlagOverridden(cmd, secureBootDiagramDOTFileFlagName) {
+		what.config.SecureBootDiagramFilenameDOTValue = what.config.CleanPath(what.flags.SecureBootDiagramFilenameDOTValue)
+	}
+
 	if what.isFlagOverridden(cmd, reportFileFlagName) {
 		what.config.ReportFilenameValue = what.config.CleanPath(what.flags.ReportFilenameValue)
 	}
@@ -353,6 +386,10 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.ExcelTagsFilenameValue = what.config.CleanPath(what.flags.ExcelTagsFilenameValue)
 	}
 
+	if what.isFlagOverridden(cmd, taraExcelFileFlagName) {
+		what.config.ExcelTaraFilenameValue = what.config.CleanPath(what.flags.ExcelTaraFilenameValue)
+	}
+
 	if what.isFlagOverridden(cmd, risksJsonFileFlagName) {
 		what.config.JsonRisksFilenameValue = what.config.CleanPath(what.flags.JsonRisksFilenameValue)
 	}
@@ -365,6 +402,26 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.JsonStatsFilenameValue = what.config.CleanPath(what.flags.JsonStatsFilenameValue)
 	}
 
+	if what.isFlagOverridden(cmd, attackPathsJsonFileFlagName) {
+		what.config.JsonAttackPathsFilenameValue = what.config.CleanPath(what.flags.JsonAttackPathsFilenameValue)
+	}
+
+	if what.isFlagOverridden(cmd, cybersecurityGoalsJsonFileFlagName) {
+		what.config.JsonCybersecurityGoalsFilenameValue = what.config.CleanPath(what.flags.JsonCybersecurityGoalsFilenameValue)
+	}
+
+	if what.isFlagOverridden(cmd, attackerAttractivenessJsonFileFlagName) {
+		what.config.JsonAttackerAttractivenessFilenameValue = what.config.CleanPath(what.flags.JsonAttackerAttractivenessFilenameValue)
+	}
+
+	if what.isFlagOverridden(cmd, checkJsonFileFlagName) {
+		what.config.JsonCheckFilenameValue = what.config.CleanPath(what.flags.JsonCheckFilenameValue)
+	}
+
+	if what.isFlagOverridden(cmd, diffJsonFileFlagName) {
+		what.config.JsonDiffFilenameValue = what.config.CleanPath(what.flags.JsonDiffFilenameValue)
+	}
+
 	if what.isFlagOverridden(cmd, templateFileNameFlagName) {
 		what.config.TemplateFilenameValue = what.flags.TemplateFilenameValue
 	}
@@ -374,7 +431,13 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 	}
 
 	if what.isFlagOverridden(cmd, technologyFileFlagName) {
-		what.config.TechnologyFilenameValue = what.flags.TechnologyFilenameValue
+		what.config.TechnologyFilenameValue = ""
+		what.config.TechnologyFilesValue = make([]string, 0)
+		for _, technologyFile := range strings.Split(what.flags.technologyFilesValue, ",") {
+			if len(strings.TrimSpace(technologyFile)) > 0 {
+				what.config.TechnologyFilesValue = append(what.config.TechnologyFilesValue, strings.TrimSpace(technologyFile))
+			}
+		}
 	}
 
 	if what.isFlagOverridden(cmd, customRiskRulesPluginFlagName) {
@@ -385,10 +448,34 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.SkipRiskRulesValue = strings.Split(what.flags.skipRiskRulesValue, ",")
 	}
 
+	if what.isFlagOverridden(cmd, riskRuleWorkersFlagName) {
+		what.config.RiskRuleWorkersValue = what.flags.RiskRuleWorkersValue
+	}
+
+	if what.isFlagOverridden(cmd, riskRuleTimeoutFlagName) {
+		what.config.RiskRuleTimeoutValue = what.flags.RiskRuleTimeoutValue
+	}
+
+	if what.isFlagOverridden(cmd, xsamCatalogsFlagName) {
+		what.config.XsamCatalogsValue = strings.Split(what.flags.xsamCatalogsValue, ",")
+	}
+
+	if what.isFlagOverridden(cmd, xsamMappingFileFlagName) {
+		what.config.XsamMappingFileValue = what.flags.XsamMappingFileValue
+	}
+
 	if what.isFlagOverridden(cmd, executeModelMacroFlagName) {
 		what.config.ExecuteModelMacroValue = what.flags.ExecuteModelMacroValue
 	}
 
+	if what.isFlagOverridden(cmd, baselineRisksFileFlagName) {
+		what.config.BaselineRisksFileValue = what.flags.BaselineRisksFileValue
+	}
+
+	if what.isFlagOverridden(cmd, failOnSeverityFlagName) {
+		what.config.FailOnSeverityValue = what.flags.FailOnSeverityValue
+	}
+
 	// RiskExcelValue not available as flags
 
 	if what.isFlagOverridden(cmd, serverModeFlagName) {
@@ -425,6 +512,10 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.IgnoreOrphanedRiskTrackingValue = what.flags.IgnoreOrphanedRiskTrackingValue
 	}
 
+	if what.isFlagOverridden(cmd, skipAttackPathAnalysisFlagName) {
+		what.config.SkipAttackPathAnalysisValue = what.flags.SkipAttackPathAnalysisValue
+	}
+
 	if what.isFlagOverridden(cmd, skipDataFlowDiagramFlagName) {
 		what.config.SkipDataFlowDiagramValue = what.flags.SkipDataFlowDiagramValue
 	}
@@ -433,6 +524,10 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.SkipDataAssetDiagramValue = what.flags.SkipDataAssetDiagramValue
 	}
 
+	if what.isFlagOverridden(cmd, skipSecureBootDiagramFlagName) {
+		what.config.SkipSecureBootDiagramValue = what.flags.SkipSecureBootDiagramValue
+	}
+
 	if what.isFlagOverridden(cmd, skipRisksJSONFlagName) {
 		what.config.SkipRisksJSONValue = what.flags.SkipRisksJSONValue
 	}
@@ -445,6 +540,18 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.SkipStatsJSONValue = what.flags.SkipStatsJSONValue
 	}
 
+	if what.isFlagOverridden(cmd, skipAttackPathsJSONFlagName) {
+		what.config.SkipAttackPathsJSONValue = what.flags.SkipAttackPathsJSONValue
+	}
+
+	if what.isFlagOverridden(cmd, skipCybersecurityGoalsJSONFlagName) {
+		what.config.SkipCybersecurityGoalsJSONValue = what.flags.SkipCybersecurityGoalsJSONValue
+	}
+
+	if what.isFlagOverridden(cmd, skipAttackerAttractivenessJSONFlagName) {
+		what.config.SkipAttackerAttractivenessJSONValue = what.flags.SkipAttackerAttractivenessJSONValue
+	}
+
 	if what.isFlagOverridden(cmd, skipRisksExcelFlagName) {
 		what.config.SkipRisksExcelValue = what.flags.SkipRisksExcelValue
 	}
@@ -453,6 +560,10 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.SkipTagsExcelValue = what.flags.SkipTagsExcelValue
 	}
 
+	if what.isFlagOverridden(cmd, skipTaraExcelFlagName) {
+		what.config.SkipTaraExcelValue = what.flags.SkipTaraExcelValue
+	}
+
 	if what.isFlagOverridden(cmd, skipReportPDFFlagName) {
 		what.config.SkipReportPDFValue = what.flags.SkipReportPDFValue
 	}
@@ -1,6 +1,7 @@
 package threagile
 
 import (
+	"errors"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -17,6 +18,10 @@ func (what *Threagile) Execute() {
 	err := what.rootCmd.Execute()
 	if err != nil {
 		what.rootCmd.Println(err)
+		var exitError *exitCodeError
+		if errors.As(err, &exitError) {
+			os.Exit(exitError.code)
+		}
 		os.Exit(1)
 	}
 
@@ -30,5 +35,5 @@ func (what *Threagile) Execute() {
 
 func (what *Threagile) Init(buildTimestamp string) *Threagile {
 	what.buildTimestamp = buildTimestamp
-	return what.initRoot().initImport().initAnalyze().initCreate().initExecute().initExplain().initList().initPrint().initQuit().initServer().initVersion().processSystemArgs(what.rootCmd)
+	return what.initRoot().initImport().initAnalyze().initCheck().initCreate().initDiff().initExecute().initExplain().initList().initPrint().initQuit().initServer().initVersion().processSystemArgs(what.rootCmd)
 }
@@ -0,0 +1,247 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type arxmlElement struct {
+	tag      string
+	text     string
+	parent   *arxmlElement
+	children []*arxmlElement
+}
+
+// ReadArxml reads the ECU instances, software components (with their ports and ECU mappings), assembly connectors
+// and the PDUs triggered on the CAN, CAN FD, FlexRay, LIN and Ethernet (SOME/IP) clusters of an AUTOSAR ARXML file
+func ReadArxml(reader io.Reader) (*VehicleNetwork, error) {
+	root, parseError := parseArxml(reader)
+	if parseError != nil {
+		return nil, parseError
+	}
+
+	elementsByPath := make(map[string]*arxmlElement)
+	root.walk(func(element *arxmlElement) {
+		if len(element.shortName()) > 0 {
+			elementsByPath[element.path()] = element
+		}
+	})
+	resolve := func(reference string) *arxmlElement {
+		return elementsByPath[strings.TrimSpace(reference)]
+	}
+
+	network := new(VehicleNetwork)
+	type ecuPort struct {
+		ecu      string
+		outgoing bool
+	}
+	ports := make(map[string]ecuPort)
+	for _, ecu := range root.descendants("ECU-INSTANCE") {
+		network.addEcu(ecu.shortName())
+		ecu.walk(func(element *arxmlElement) {
+			if direction := element.child("COMMUNICATION-DIRECTION"); direction != nil && len(element.shortName()) > 0 {
+				ports[element.path()] = ecuPort{ecu: ecu.shortName(), outgoing: strings.TrimSpace(direction.text) == "OUT"}
+			}
+		})
+	}
+
+	componentTypesByPath := make(map[string]*SoftwareComponent)
+	root.walk(func(element *arxmlElement) {
+		if !strings.HasSuffix(element.tag, "-SW-COMPONENT-TYPE") || element.tag == "COMPOSITION-SW-COMPONENT-TYPE" {
+			return
+		}
+		component := &SoftwareComponent{
+			Name:     element.shortName(),
+			Adaptive: element.tag == "ADAPTIVE-APPLICATION-SW-COMPONENT-TYPE",
+		}
+		if portsElement := element.child("PORTS"); portsElement != nil {
+			for _, port := range portsElement.children {
+				component.Ports = append(component.Ports, port.shortName())
+			}
+		}
+		componentTypesByPath[element.path()] = component
+		network.SoftwareComponents = append(network.SoftwareComponents, component)
+	})
+
+	// software component prototypes are referenced by the mappings and connectors, so resolve them to their types
+	componentOfPrototype := func(reference string) *SoftwareComponent {
+		prototype := resolve(reference)
+		if prototype == nil {
+			return nil
+		}
+		return componentTypesByPath[strings.TrimSpace(prototype.childText("TYPE-TREF"))]
+	}
+
+	for _, mapping := range root.descendants("SWC-TO-ECU-MAPPING") {
+		ecu := resolve(mapping.childText("ECU-INSTANCE-REF"))
+		if ecu == nil {
+			continue
+		}
+		for _, reference := range mapping.descendants("TARGET-COMPONENT-REF") {
+			if component := componentOfPrototype(reference.text); component != nil {
+				component.Ecu = ecu.shortName()
+			}
+		}
+	}
+
+	for _, connector := range root.descendants("ASSEMBLY-SW-CONNECTOR") {
+		providerReference, requesterReference := connector.child("PROVIDER-IREF"), connector.child("REQUESTER-IREF")
+		if providerReference == nil || requesterReference == nil {
+			continue
+		}
+		provider := componentOfPrototype(providerReference.childText("CONTEXT-COMPONENT-REF"))
+		requester := componentOfPrototype(requesterReference.childText("CONTEXT-COMPONENT-REF"))
+		if provider == nil || requester == nil {
+			continue
+		}
+		network.Connectors = append(network.Connectors, &SoftwareConnector{
+			Provider:  provider.Name,
+			Requester: requester.Name,
+			Port:      lastPathSegment(providerReference.childText("TARGET-P-PORT-REF")),
+		})
+	}
+
+	groupOfPdu := make(map[string]string)
+	for _, group := range root.descendants("I-SIGNAL-I-PDU-GROUP") {
+		for _, reference := range group.descendants("I-SIGNAL-I-PDU-REF") {
+			groupOfPdu[strings.TrimSpace(reference.text)] = group.shortName()
+		}
+	}
+
+	clusters := map[string]types.Protocol{
+		"CAN-CLUSTER":      types.CAN,
+		"FLEXRAY-CLUSTER":  types.FlexRay,
+		"LIN-CLUSTER":      types.LIN,
+		"ETHERNET-CLUSTER": types.SomeIP,
+	}
+	root.walk(func(cluster *arxmlElement) {
+		protocol, ok := clusters[cluster.tag]
+		if !ok {
+			return
+		}
+		if protocol == types.CAN {
+			for _, baudRate := range cluster.descendants("CAN-FD-BAUDRATE") {
+				if len(strings.TrimSpace(baudRate.text)) > 0 {
+					protocol = types.CANFD
+				}
+			}
+		}
+
+		for _, triggering := range cluster.descendants("PDU-TRIGGERING") {
+			pduReference := strings.TrimSpace(triggering.childText("I-PDU-REF"))
+			if len(pduReference) == 0 {
+				continue
+			}
+			message := &Message{
+				Name:     lastPathSegment(pduReference),
+				Bus:      cluster.shortName(),
+				Protocol: protocol,
+				Group:    groupOfPdu[pduReference],
+			}
+			for _, portReference := range triggering.descendants("I-PDU-PORT-REF") {
+				port, ok := ports[strings.TrimSpace(portReference.text)]
+				switch {
+				case !ok:
+				case port.outgoing:
+					message.Sender = port.ecu
+				default:
+					message.Receivers = appendUnique(message.Receivers, port.ecu)
+				}
+			}
+			sort.Strings(message.Receivers)
+			network.Messages = append(network.Messages, message)
+		}
+	})
+
+	return network, nil
+}
+
+func parseArxml(reader io.Reader) (*arxmlElement, error) {
+	decoder := xml.NewDecoder(reader)
+	root := new(arxmlElement)
+	current := root
+	for {
+		token, tokenError := decoder.Token()
+		if tokenError == io.EOF {
+			break
+		}
+		if tokenError != nil {
+			return nil, fmt.Errorf("failed to parse ARXML: %w", tokenError)
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			child := &arxmlElement{tag: element.Name.Local, parent: current}
+			current.children = append(current.children, child)
+			current = child
+		case xml.EndElement:
+			current = current.parent
+		case xml.CharData:
+			current.text += string(element)
+		}
+	}
+
+	if len(root.descendants("AUTOSAR")) == 0 {
+		return nil, fmt.Errorf("failed to parse ARXML: missing AUTOSAR root element")
+	}
+
+	return root, nil
+}
+
+func (what *arxmlElement) walk(visit func(element *arxmlElement)) {
+	for _, child := range what.children {
+		visit(child)
+		child.walk(visit)
+	}
+}
+
+func (what *arxmlElement) descendants(tag string) []*arxmlElement {
+	result := make([]*arxmlElement, 0)
+	what.walk(func(element *arxmlElement) {
+		if element.tag == tag {
+			result = append(result, element)
+		}
+	})
+	return result
+}
+
+func (what *arxmlElement) child(tag string) *arxmlElement {
+	for _, child := range what.children {
+		if child.tag == tag {
+			return child
+		}
+	}
+	return nil
+}
+
+func (what *arxmlElement) childText(tag string) string {
+	if child := what.child(tag); child != nil {
+		return strings.TrimSpace(child.text)
+	}
+	return ""
+}
+
+func (what *arxmlElement) shortName() string {
+	return what.childText("SHORT-NAME")
+}
+
+// path returns the AUTOSAR reference path (like "/Ecus/Gateway") built from the short names of the element and its ancestors
+func (what *arxmlElement) path() string {
+	path := ""
+	for element := what; element != nil; element = element.parent {
+		if shortName := element.shortName(); len(shortName) > 0 {
+			path = "/" + shortName + path
+		}
+	}
+	return path
+}
+
+func lastPathSegment(reference string) string {
+	reference = strings.TrimSpace(reference)
+	return reference[strings.LastIndex(reference, "/")+1:]
+}
@@ -0,0 +1,188 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const testArxml = `<?xml version="1.0" encoding="UTF-8"?>
+<AUTOSAR xmlns="http://autosar.org/schema/r4.0">
+  <AR-PACKAGES>
+    <AR-PACKAGE>
+      <SHORT-NAME>Ecus</SHORT-NAME>
+      <ELEMENTS>
+        <ECU-INSTANCE>
+          <SHORT-NAME>CentralGateway</SHORT-NAME>
+          <CONNECTORS>
+            <CAN-COMMUNICATION-CONNECTOR>
+              <SHORT-NAME>GwCan</SHORT-NAME>
+              <ECU-COMM-PORT-INSTANCES>
+                <I-PDU-PORT>
+                  <SHORT-NAME>TorqueOut</SHORT-NAME>
+                  <COMMUNICATION-DIRECTION>OUT</COMMUNICATION-DIRECTION>
+                </I-PDU-PORT>
+              </ECU-COMM-PORT-INSTANCES>
+            </CAN-COMMUNICATION-CONNECTOR>
+          </CONNECTORS>
+        </ECU-INSTANCE>
+        <ECU-INSTANCE>
+          <SHORT-NAME>PowertrainECU</SHORT-NAME>
+          <CONNECTORS>
+            <CAN-COMMUNICATION-CONNECTOR>
+              <SHORT-NAME>PtCan</SHORT-NAME>
+              <ECU-COMM-PORT-INSTANCES>
+                <I-PDU-PORT>
+                  <SHORT-NAME>TorqueIn</SHORT-NAME>
+                  <COMMUNICATION-DIRECTION>IN</COMMUNICATION-DIRECTION>
+                </I-PDU-PORT>
+              </ECU-COMM-PORT-INSTANCES>
+            </CAN-COMMUNICATION-CONNECTOR>
+          </CONNECTORS>
+        </ECU-INSTANCE>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>Components</SHORT-NAME>
+      <ELEMENTS>
+        <APPLICATION-SW-COMPONENT-TYPE>
+          <SHORT-NAME>TorqueController</SHORT-NAME>
+          <PORTS>
+            <P-PORT-PROTOTYPE><SHORT-NAME>TorqueRequest</SHORT-NAME></P-PORT-PROTOTYPE>
+          </PORTS>
+        </APPLICATION-SW-COMPONENT-TYPE>
+        <APPLICATION-SW-COMPONENT-TYPE>
+          <SHORT-NAME>MotorControl</SHORT-NAME>
+          <PORTS>
+            <R-PORT-PROTOTYPE><SHORT-NAME>TorqueRequest</SHORT-NAME></R-PORT-PROTOTYPE>
+          </PORTS>
+        </APPLICATION-SW-COMPONENT-TYPE>
+        <COMPOSITION-SW-COMPONENT-TYPE>
+          <SHORT-NAME>Vehicle</SHORT-NAME>
+          <COMPONENTS>
+            <SW-COMPONENT-PROTOTYPE>
+              <SHORT-NAME>TorqueControllerPrototype</SHORT-NAME>
+              <TYPE-TREF DEST="APPLICATION-SW-COMPONENT-TYPE">/Components/TorqueController</TYPE-TREF>
+            </SW-COMPONENT-PROTOTYPE>
+            <SW-COMPONENT-PROTOTYPE>
+              <SHORT-NAME>MotorControlPrototype</SHORT-NAME>
+              <TYPE-TREF DEST="APPLICATION-SW-COMPONENT-TYPE">/Components/MotorControl</TYPE-TREF>
+            </SW-COMPONENT-PROTOTYPE>
+          </COMPONENTS>
+          <CONNECTORS>
+            <ASSEMBLY-SW-CONNECTOR>
+              <SHORT-NAME>TorqueConnector</SHORT-NAME>
+              <PROVIDER-IREF>
+                <CONTEXT-COMPONENT-REF DEST="SW-COMPONENT-PROTOTYPE">/Components/Vehicle/TorqueControllerPrototype</CONTEXT-COMPONENT-REF>
+                <TARGET-P-PORT-REF DEST="P-PORT-PROTOTYPE">/Components/TorqueController/TorqueRequest</TARGET-P-PORT-REF>
+              </PROVIDER-IREF>
+              <REQUESTER-IREF>
+                <CONTEXT-COMPONENT-REF DEST="SW-COMPONENT-PROTOTYPE">/Components/Vehicle/MotorControlPrototype</CONTEXT-COMPONENT-REF>
+                <TARGET-R-PORT-REF DEST="R-PORT-PROTOTYPE">/Components/MotorControl/TorqueRequest</TARGET-R-PORT-REF>
+              </REQUESTER-IREF>
+            </ASSEMBLY-SW-CONNECTOR>
+          </CONNECTORS>
+        </COMPOSITION-SW-COMPONENT-TYPE>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>System</SHORT-NAME>
+      <ELEMENTS>
+        <SYSTEM>
+          <SHORT-NAME>VehicleSystem</SHORT-NAME>
+          <MAPPINGS>
+            <SYSTEM-MAPPING>
+              <SHORT-NAME>Mapping</SHORT-NAME>
+              <SW-MAPPINGS>
+                <SWC-TO-ECU-MAPPING>
+                  <SHORT-NAME>PowertrainMapping</SHORT-NAME>
+                  <COMPONENT-IREFS>
+                    <COMPONENT-IREF>
+                      <TARGET-COMPONENT-REF DEST="SW-COMPONENT-PROTOTYPE">/Components/Vehicle/TorqueControllerPrototype</TARGET-COMPONENT-REF>
+                    </COMPONENT-IREF>
+                    <COMPONENT-IREF>
+                      <TARGET-COMPONENT-REF DEST="SW-COMPONENT-PROTOTYPE">/Components/Vehicle/MotorControlPrototype</TARGET-COMPONENT-REF>
+                    </COMPONENT-IREF>
+                  </COMPONENT-IREFS>
+                  <ECU-INSTANCE-REF DEST="ECU-INSTANCE">/Ecus/PowertrainECU</ECU-INSTANCE-REF>
+                </SWC-TO-ECU-MAPPING>
+              </SW-MAPPINGS>
+            </SYSTEM-MAPPING>
+          </MAPPINGS>
+        </SYSTEM>
+        <I-SIGNAL-I-PDU>
+          <SHORT-NAME>TorqueRequestPdu</SHORT-NAME>
+          <LENGTH>8</LENGTH>
+        </I-SIGNAL-I-PDU>
+        <I-SIGNAL-I-PDU-GROUP>
+          <SHORT-NAME>PowertrainControl</SHORT-NAME>
+          <I-SIGNAL-I-PDUS>
+            <I-SIGNAL-I-PDU-REF-CONDITIONAL>
+              <I-SIGNAL-I-PDU-REF DEST="I-SIGNAL-I-PDU">/System/TorqueRequestPdu</I-SIGNAL-I-PDU-REF>
+            </I-SIGNAL-I-PDU-REF-CONDITIONAL>
+          </I-SIGNAL-I-PDUS>
+        </I-SIGNAL-I-PDU-GROUP>
+        <CAN-CLUSTER>
+          <SHORT-NAME>PowertrainCAN</SHORT-NAME>
+          <CAN-CLUSTER-VARIANTS>
+            <CAN-CLUSTER-CONDITIONAL>
+              <BAUDRATE>500000</BAUDRATE>
+              <CAN-FD-BAUDRATE>2000000</CAN-FD-BAUDRATE>
+              <PHYSICAL-CHANNELS>
+                <CAN-PHYSICAL-CHANNEL>
+                  <SHORT-NAME>PowertrainChannel</SHORT-NAME>
+                  <PDU-TRIGGERINGS>
+                    <PDU-TRIGGERING>
+                      <SHORT-NAME>TorqueRequestTriggering</SHORT-NAME>
+                      <I-PDU-PORT-REFS>
+                        <I-PDU-PORT-REF DEST="I-PDU-PORT">/Ecus/CentralGateway/GwCan/TorqueOut</I-PDU-PORT-REF>
+                        <I-PDU-PORT-REF DEST="I-PDU-PORT">/Ecus/PowertrainECU/PtCan/TorqueIn</I-PDU-PORT-REF>
+                      </I-PDU-PORT-REFS>
+                      <I-PDU-REF DEST="I-SIGNAL-I-PDU">/System/TorqueRequestPdu</I-PDU-REF>
+                    </PDU-TRIGGERING>
+                  </PDU-TRIGGERINGS>
+                </CAN-PHYSICAL-CHANNEL>
+              </PHYSICAL-CHANNELS>
+            </CAN-CLUSTER-CONDITIONAL>
+          </CAN-CLUSTER-VARIANTS>
+        </CAN-CLUSTER>
+      </ELEMENTS>
+    </AR-PACKAGE>
+  </AR-PACKAGES>
+</AUTOSAR>
+`
+
+func TestReadArxml(t *testing.T) {
+	network, err := ReadArxml(strings.NewReader(testArxml))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CentralGateway", "PowertrainECU"}, network.Ecus)
+	assert.Equal(t, []*SoftwareComponent{
+		{Name: "TorqueController", Ecu: "PowertrainECU", Ports: []string{"TorqueRequest"}},
+		{Name: "MotorControl", Ecu: "PowertrainECU", Ports: []string{"TorqueRequest"}},
+	}, network.SoftwareComponents)
+	assert.Equal(t, []*SoftwareConnector{
+		{Provider: "TorqueController", Requester: "MotorControl", Port: "TorqueRequest"},
+	}, network.Connectors)
+	assert.Equal(t, []*Message{
+		{
+			Name:      "TorqueRequestPdu",
+			Bus:       "PowertrainCAN",
+			Protocol:  types.CANFD,
+			Group:     "PowertrainControl",
+			Sender:    "CentralGateway",
+			Receivers: []string{"PowertrainECU"},
+		},
+	}, network.Messages)
+}
+
+func TestReadArxmlInvalid(t *testing.T) {
+	_, err := ReadArxml(strings.NewReader("<AUTOSAR><AR-PACKAGES>"))
+	assert.NotNil(t, err)
+
+	_, err = ReadArxml(strings.NewReader("<model></model>"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// dbcPlaceholderNode is used by DBC files for messages and signals without sender or receiver
+const dbcPlaceholderNode = "Vector__XXX"
+
+// ReadDbc reads the nodes (BU_), messages (BO_) and signal receivers (SG_) of a CAN DBC file describing the given bus.
+// The messages of each sending node form a message group. Buses declared as "CAN FD" via the BusType attribute,
+// or having messages longer than 8 bytes, are imported as CAN FD.
+func ReadDbc(reader io.Reader, bus string) (*VehicleNetwork, error) {
+	network := new(VehicleNetwork)
+	protocol := types.CAN
+	var message *Message
+
+	scanner := bufio.NewScanner(reader)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "BU_:":
+			for _, node := range fields[1:] {
+				if node != dbcPlaceholderNode {
+					network.addEcu(node)
+				}
+			}
+
+		case "BO_":
+			if len(fields) < 5 {
+				return nil, fmt.Errorf("invalid message definition in line %d: %q", lineNumber, line)
+			}
+			id, idError := strconv.ParseUint(fields[1], 10, 32)
+			if idError != nil {
+				return nil, fmt.Errorf("invalid message id in line %d: %w", lineNumber, idError)
+			}
+			length, lengthError := strconv.Atoi(fields[3])
+			if lengthError != nil {
+				return nil, fmt.Errorf("invalid message length in line %d: %w", lineNumber, lengthError)
+			}
+			if length > 8 {
+				protocol = types.CANFD
+			}
+			message = &Message{
+				Name: strings.TrimSuffix(fields[2], ":"),
+				Id:   fmt.Sprintf("0x%X", id&0x1FFFFFFF), // the highest bit only flags extended frame ids
+				Bus:  bus,
+			}
+			if fields[4] != dbcPlaceholderNode {
+				message.Sender = fields[4]
+			}
+			network.Messages = append(network.Messages, message)
+
+		case "SG_":
+			if message == nil {
+				return nil, fmt.Errorf("signal outside of message definition in line %d: %q", lineNumber, line)
+			}
+			// the receivers follow the quoted unit at the end of the signal definition
+			receivers := line[strings.LastIndex(line, "\"")+1:]
+			for _, receiver := range strings.FieldsFunc(receivers, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+				if receiver != dbcPlaceholderNode && receiver != message.Sender {
+					message.Receivers = appendUnique(message.Receivers, receiver)
+				}
+			}
+
+		case "BA_":
+			if len(fields) >= 3 && fields[1] == `"BusType"` && strings.Contains(strings.Join(fields[2:], " "), `"CAN FD"`) {
+				protocol = types.CANFD
+			}
+
+		default:
+			message = nil
+		}
+	}
+	if scanError := scanner.Err(); scanError != nil {
+		return nil, fmt.Errorf("failed to read DBC: %w", scanError)
+	}
+
+	for _, message := range network.Messages {
+		message.Protocol = protocol
+		sort.Strings(message.Receivers)
+	}
+
+	return network, nil
+}
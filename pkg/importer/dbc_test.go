@@ -0,0 +1,74 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const testDbc = `VERSION ""
+
+NS_ :
+	CM_
+	BA_DEF_
+
+BS_:
+
+BU_: BodyControl DoorModule Vector__XXX
+
+BO_ 512 DoorCommand: 2 BodyControl
+ SG_ LockRequest : 0|1@1+ (1,0) [0|1] "" DoorModule
+ SG_ WindowPosition : 8|8@1+ (1,0) [0|100] "%" DoorModule,Vector__XXX
+
+BO_ 2147484161 DoorStatus: 1 DoorModule
+ SG_ Locked : 0|1@1+ (1,0) [0|1] "" BodyControl
+
+CM_ BO_ 512 "Lock and window commands";
+`
+
+func TestReadDbc(t *testing.T) {
+	network, err := ReadDbc(strings.NewReader(testDbc), "BodyCAN")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"BodyControl", "DoorModule"}, network.Ecus)
+	assert.Equal(t, []*Message{
+		{Name: "DoorCommand", Id: "0x200", Bus: "BodyCAN", Protocol: types.CAN, Sender: "BodyControl", Receivers: []string{"DoorModule"}},
+		{Name: "DoorStatus", Id: "0x201", Bus: "BodyCAN", Protocol: types.CAN, Sender: "DoorModule", Receivers: []string{"BodyControl"}},
+	}, network.Messages)
+}
+
+func TestReadDbcCanFd(t *testing.T) {
+	testCases := map[string]string{
+		"bus type attribute": "BU_: A B\nBO_ 1 Status: 8 A\n SG_ Value : 0|8@1+ (1,0) [0|255] \"\" B\nBA_ \"BusType\" \"CAN FD\";\n",
+		"long message":       "BU_: A B\nBO_ 1 Status: 64 A\n SG_ Value : 0|8@1+ (1,0) [0|255] \"\" B\n",
+	}
+
+	for name, dbc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			network, err := ReadDbc(strings.NewReader(dbc), "ChassisCAN")
+
+			assert.Nil(t, err)
+			assert.Len(t, network.Messages, 1)
+			assert.Equal(t, types.CANFD, network.Messages[0].Protocol)
+		})
+	}
+}
+
+func TestReadDbcInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"incomplete message": "BO_ 1 Status: 8\n",
+		"invalid message id": "BO_ x Status: 8 A\n",
+		"orphaned signal":    " SG_ Value : 0|8@1+ (1,0) [0|255] \"\" B\n",
+	}
+
+	for name, dbc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadDbc(strings.NewReader(dbc), "ChassisCAN")
+
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/input"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// Flowchart is the intermediate representation of the Mermaid flowcharts (graph or flowchart diagrams) read from
+// a Mermaid file or from the mermaid code blocks of a markdown document
+type Flowchart struct {
+	Title     string
+	Subgraphs []*FlowchartSubgraph
+	Nodes     []*FlowchartNode
+	Edges     []*FlowchartEdge
+}
+
+type FlowchartSubgraph struct {
+	Title  string
+	Parent *FlowchartSubgraph
+}
+
+type FlowchartNode struct {
+	Id        string
+	Label     string
+	Datastore bool
+	Subgraph  *FlowchartSubgraph
+}
+
+type FlowchartEdge struct {
+	From          string
+	To            string
+	Label         string
+	Bidirectional bool
+}
+
+var (
+	markdownHeading   = regexp.MustCompile(`^#\s+(.+)$`)
+	flowchartHeader   = regexp.MustCompile(`^(graph|flowchart)(\s+(TB|TD|BT|RL|LR))?\s*;?$`)
+	flowchartEdge     = regexp.MustCompile(`^\s*([<ox]?)(?:-{2,}|={2,}|-\.+-)([>ox]?)(?:\|([^|]*)\|)?\s*`)
+	flowchartTextEdge = regexp.MustCompile(`^\s*([<ox]?)(?:--|==|-\.)\s+([^|<>]+?)\s+(?:-{2,}|={2,}|\.-+)([>ox]?)\s*`)
+	flowchartNodeId   = regexp.MustCompile(`^([A-Za-z0-9_]+(?:-[A-Za-z0-9_]+)*)(?::::[A-Za-z0-9_\-]+)?\s*(.*)$`)
+	labelWithId       = regexp.MustCompile(`^(.*?)\s*\(([a-z0-9\-]+)\)$`)
+)
+
+// node shapes ordered so that multi-character delimiters are tried first
+var flowchartShapes = [][2]string{
+	{"[(", ")]"}, {"((", "))"}, {"([", "])"}, {"[[", "]]"}, {"{{", "}}"}, {"[/", "/]"}, {"[\\", "\\]"},
+	{"[/", "\\]"}, {"[\\", "/]"}, {"[", "]"}, {"(", ")"}, {"{", "}"}, {">", "]"},
+}
+
+// ReadMermaid reads the subgraphs, nodes and edges of all Mermaid flowcharts. Markdown documents contribute their
+// first heading as title and the flowcharts of their mermaid code blocks, any other input is read as a single flowchart.
+func ReadMermaid(reader io.Reader) (*Flowchart, error) {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if scanError := scanner.Err(); scanError != nil {
+		return nil, fmt.Errorf("failed to read Mermaid: %w", scanError)
+	}
+
+	flowchart := new(Flowchart)
+	markdown := false
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```mermaid") {
+			markdown = true
+		}
+	}
+
+	inBlock, inFlowchart := !markdown, false
+	var subgraph *FlowchartSubgraph
+	for lineNumber, line := range lines {
+		line = strings.TrimSpace(line)
+		switch {
+		case markdown && !inBlock && strings.HasPrefix(line, "```mermaid"):
+			inBlock, inFlowchart = true, false
+			continue
+		case markdown && inBlock && strings.HasPrefix(line, "```"):
+			inBlock, inFlowchart = false, false
+			continue
+		case !inBlock:
+			if heading := markdownHeading.FindStringSubmatch(line); heading != nil && len(flowchart.Title) == 0 {
+				flowchart.Title = strings.TrimSpace(heading[1])
+			}
+			continue
+		case len(line) == 0 || strings.HasPrefix(line, "%%"):
+			continue
+		case !inFlowchart:
+			// skip other diagram types like sequence diagrams
+			inFlowchart = flowchartHeader.MatchString(line)
+			continue
+		}
+
+		line = strings.TrimSuffix(line, ";")
+		keyword, rest, _ := strings.Cut(line, " ")
+		switch keyword {
+		case "subgraph":
+			subgraph = &FlowchartSubgraph{Title: subgraphTitle(rest), Parent: subgraph}
+			flowchart.Subgraphs = append(flowchart.Subgraphs, subgraph)
+		case "end":
+			if subgraph == nil {
+				return nil, fmt.Errorf("unexpected end of subgraph in line %d", lineNumber+1)
+			}
+			subgraph = subgraph.Parent
+		case "style", "classDef", "class", "linkStyle", "click", "direction":
+		default:
+			if parseError := flowchart.parseStatement(line, subgraph); parseError != nil {
+				return nil, fmt.Errorf("invalid statement in line %d: %w", lineNumber+1, parseError)
+			}
+		}
+	}
+	if subgraph != nil {
+		return nil, fmt.Errorf("missing end of subgraph %q", subgraph.Title)
+	}
+
+	return flowchart, nil
+}
+
+// parseStatement reads node definitions and chains of edges like `A[Label] -->|text| B & C -.- D <--> E`
+func (what *Flowchart) parseStatement(statement string, subgraph *FlowchartSubgraph) error {
+	var previous []*FlowchartNode
+	label, bidirectional := "", false
+	for len(statement) > 0 {
+		nodes := make([]*FlowchartNode, 0)
+		for {
+			node, rest, nodeError := what.parseNode(statement, subgraph)
+			if nodeError != nil {
+				return nodeError
+			}
+			nodes = append(nodes, node)
+			statement = strings.TrimSpace(rest)
+			if !strings.HasPrefix(statement, "&") {
+				break
+			}
+			statement = strings.TrimSpace(statement[1:])
+		}
+
+		for _, from := range previous {
+			for _, to := range nodes {
+				what.Edges = append(what.Edges, &FlowchartEdge{From: from.Id, To: to.Id, Label: label, Bidirectional: bidirectional})
+			}
+		}
+		previous = nodes
+		if len(statement) == 0 {
+			break
+		}
+
+		// edges with arrow heads (or circles or crosses) on both ends like `<-->` or `o--o` are bidirectional
+		var edge []string
+		if textEdge := flowchartTextEdge.FindStringSubmatch(statement); textEdge != nil {
+			edge = []string{textEdge[0], textEdge[1], textEdge[3], textEdge[2]}
+		} else if edge = flowchartEdge.FindStringSubmatch(statement); edge == nil {
+			return fmt.Errorf("unexpected %q", statement)
+		}
+		label = strings.Trim(strings.TrimSpace(edge[3]), `"`)
+		bidirectional = len(edge[1]) > 0 && len(edge[2]) > 0
+		statement = statement[len(edge[0]):]
+		if len(statement) == 0 {
+			return fmt.Errorf("missing target of edge")
+		}
+	}
+	return nil
+}
+
+// parseNode reads a single node with an optional shape and label, returning the rest of the statement
+func (what *Flowchart) parseNode(statement string, subgraph *FlowchartSubgraph) (*FlowchartNode, string, error) {
+	match := flowchartNodeId.FindStringSubmatch(statement)
+	if match == nil {
+		return nil, "", fmt.Errorf("missing node id at %q", statement)
+	}
+	node := what.node(match[1], subgraph)
+	rest := match[2]
+	for _, shape := range flowchartShapes {
+		if !strings.HasPrefix(rest, shape[0]) {
+			continue
+		}
+		end := closingDelimiter(rest, shape[0], shape[1])
+		if end < 0 {
+			return nil, "", fmt.Errorf("unterminated label of node %q", node.Id)
+		}
+		node.Label = strings.TrimSpace(rest[len(shape[0]):end])
+		if unquoted := strings.Trim(node.Label, `"`); len(unquoted) == len(node.Label)-2 {
+			node.Label = unquoted
+		}
+		node.Datastore = shape[0] == "[("
+		rest = rest[end+len(shape[1]):]
+		break
+	}
+	return node, rest, nil
+}
+
+// closingDelimiter finds the end of a node label, ignoring delimiters within quotes
+func closingDelimiter(text string, open string, close string) int {
+	quoted := false
+	for index := len(open); index < len(text); index++ {
+		switch {
+		case text[index] == '"':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(text[index:], close):
+			return index
+		}
+	}
+	return -1
+}
+
+func (what *Flowchart) node(id string, subgraph *FlowchartSubgraph) *FlowchartNode {
+	for _, node := range what.Nodes {
+		if node.Id == id {
+			return node
+		}
+	}
+	// nodes belong to the subgraph they are mentioned first in
+	node := &FlowchartNode{Id: id, Subgraph: subgraph}
+	what.Nodes = append(what.Nodes, node)
+	return node
+}
+
+func subgraphTitle(definition string) string {
+	definition = strings.TrimSpace(definition)
+	if start := strings.Index(definition, "["); start > 0 && strings.HasSuffix(definition, "]") {
+		definition = definition[start+1 : len(definition)-1]
+	}
+	return strings.Trim(definition, `"`)
+}
+
+// Model converts the flowchart into a model stub with a trust boundary per subgraph (nested like the subgraphs),
+// a technical asset per node and a communication link per edge (and its reverse if bidirectional). Node labels ending with an id in parentheses
+// (like "Reverse Proxy (reverse-proxy)") define the technical asset id. Technologies and protocols not derivable
+// from the flowchart are unknown and the CIA ratings are marked as TODO, so that the incomplete model risk rule
+// highlights what to fill in.
+func (what *Flowchart) Model() *input.Model {
+	model := new(input.Model).Defaults()
+	model.Title = what.Title
+	model.BusinessCriticality = types.Important.String()
+	model.TechnicalAssets = make(map[string]input.TechnicalAsset)
+	model.TrustBoundaries = make(map[string]input.TrustBoundary)
+
+	boundaryTitles := make(map[*FlowchartSubgraph]string)
+	boundaryIds := make(map[*FlowchartSubgraph]string)
+	usedBoundaryIds := make(map[string]bool)
+	for _, subgraph := range what.Subgraphs {
+		title, id := titleAndId(subgraph.Title)
+		id = uniqueId(id, "", usedBoundaryIds)
+		title = uniqueTitle(title, subgraph.Title, func(title string) bool {
+			_, exists := model.TrustBoundaries[title]
+			return exists
+		})
+		boundaryType := types.NetworkOnPrem
+		if subgraph.Parent != nil {
+			boundaryType = types.ExecutionEnvironment
+		}
+		boundaryTitles[subgraph], boundaryIds[subgraph] = title, id
+		model.TrustBoundaries[title] = input.TrustBoundary{ID: id, Type: boundaryType.String()}
+		if subgraph.Parent != nil {
+			parent := model.TrustBoundaries[boundaryTitles[subgraph.Parent]]
+			parent.TrustBoundariesNested = append(parent.TrustBoundariesNested, id)
+			model.TrustBoundaries[boundaryTitles[subgraph.Parent]] = parent
+		}
+	}
+
+	assetTitles := make(map[string]string)
+	assetIds := make(map[string]string)
+	usedAssetIds := make(map[string]bool)
+	for _, node := range what.Nodes {
+		label := node.Label
+		if len(label) == 0 {
+			label = node.Id
+		}
+		title, id := titleAndId(label)
+		id = uniqueId(id, node.Id, usedAssetIds)
+		title = uniqueTitle(title, node.Id, func(title string) bool {
+			_, exists := model.TechnicalAssets[title]
+			return exists
+		})
+		assetType := types.Process
+		if node.Datastore {
+			assetType = types.Datastore
+		}
+		assetTitles[node.Id], assetIds[node.Id] = title, id
+		model.TechnicalAssets[title] = input.TechnicalAsset{
+			ID:                     id,
+			Type:                   assetType.String(),
+			Usage:                  types.Business.String(),
+			Size:                   types.Component.String(),
+			Technology:             types.UnknownTechnology,
+			Machine:                types.Virtual.String(),
+			Encryption:             types.NoneEncryption.String(),
+			Confidentiality:        types.Internal.String(),
+			Integrity:              types.Operational.String(),
+			Availability:           types.Operational.String(),
+			JustificationCiaRating: types.TodoMarker + ": rate confidentiality, integrity and availability",
+			CommunicationLinks:     make(map[string]input.CommunicationLink),
+		}
+		if node.Subgraph != nil {
+			trustBoundary := model.TrustBoundaries[boundaryTitles[node.Subgraph]]
+			trustBoundary.TechnicalAssetsInside = append(trustBoundary.TechnicalAssetsInside, id)
+			model.TrustBoundaries[boundaryTitles[node.Subgraph]] = trustBoundary
+		}
+	}
+
+	for _, edge := range what.Edges {
+		if edge.From == edge.To {
+			continue
+		}
+		addCommunicationLink(model.TechnicalAssets[assetTitles[edge.From]], assetTitles[edge.To], assetIds[edge.To], edge.Label)
+		if edge.Bidirectional {
+			addCommunicationLink(model.TechnicalAssets[assetTitles[edge.To]], assetTitles[edge.From], assetIds[edge.From], edge.Label)
+		}
+	}
+
+	return model
+}
+
+// addCommunicationLink adds a communication link titled by the label (or the target if ambiguous) to the source
+func addCommunicationLink(source input.TechnicalAsset, targetTitle string, targetId string, label string) {
+	title := label
+	if len(title) == 0 || hasCommunicationLink(source, title) {
+		title = strings.TrimSpace(title + " to " + targetTitle)
+	}
+	for index := 2; hasCommunicationLink(source, title); index++ {
+		title = fmt.Sprintf("%v to %v (%d)", label, targetTitle, index)
+	}
+	source.CommunicationLinks[title] = input.CommunicationLink{
+		Target:         targetId,
+		Description:    label,
+		Protocol:       guessProtocol(label).String(),
+		Authentication: types.NoneAuthentication.String(),
+		Authorization:  types.NoneAuthorization.String(),
+		Usage:          types.Business.String(),
+	}
+}
+
+// titleAndId splits labels like "Reverse Proxy (reverse-proxy)" into title and id, or derives the id from the title
+func titleAndId(label string) (string, string) {
+	if match := labelWithId.FindStringSubmatch(label); match != nil && len(match[1]) > 0 {
+		return match[1], match[2]
+	}
+	return label, createId(label)
+}
+
+// uniqueId qualifies ids already used (like of nodes with the same label) by the qualifier (the Mermaid node id) or a
+// number and marks the resulting id as used
+func uniqueId(id string, qualifier string, used map[string]bool) string {
+	if used[id] && len(qualifier) > 0 {
+		id = id + "-" + createId(qualifier)
+	}
+	uniqueId := id
+	for index := 2; used[uniqueId]; index++ {
+		uniqueId = fmt.Sprintf("%v-%d", id, index)
+	}
+	used[uniqueId] = true
+	return uniqueId
+}
+
+func uniqueTitle(title string, qualifier string, exists func(title string) bool) string {
+	if exists(title) {
+		return title + " (" + qualifier + ")"
+	}
+	return title
+}
+
+func hasCommunicationLink(technicalAsset input.TechnicalAsset, title string) bool {
+	_, exists := technicalAsset.CommunicationLinks[title]
+	return exists
+}
+
+var edgeLabelProtocols = []struct {
+	pattern  *regexp.Regexp
+	protocol types.Protocol
+}{
+	{regexp.MustCompile(`some[-/ ]?ip`), types.SomeIP},
+	{regexp.MustCompile(`can[- ]?fd`), types.CANFD},
+	{regexp.MustCompile(`\bcan\b`), types.CAN},
+	{regexp.MustCompile(`flexray`), types.FlexRay},
+	{regexp.MustCompile(`\blin\b`), types.LIN},
+	{regexp.MustCompile(`ipc|shared[- ]mem|rpmsg`), types.IPC},
+	{regexp.MustCompile(`https`), types.HTTPS},
+	{regexp.MustCompile(`\bhttp\b|rest`), types.HTTP},
+	{regexp.MustCompile(`\bmqtt\b`), types.MQTT},
+	{regexp.MustCompile(`\bssh\b`), types.SSH},
+	{regexp.MustCompile(`\btls\b`), types.BinaryEncrypted},
+	{regexp.MustCompile(`\bdoip\b|\btcp\b`), types.TCP},
+	{regexp.MustCompile(`\budp\b`), types.UDP},
+}
+
+// guessProtocol derives the protocol from edge labels like "CAN / CAN FD" or "Ethernet / SOMEIP"
+func guessProtocol(label string) types.Protocol {
+	label = strings.ToLower(strings.TrimSpace(label))
+	if protocol, err := types.ParseProtocol(label); err == nil {
+		return protocol
+	}
+	for _, candidate := range edgeLabelProtocols {
+		if candidate.pattern.MatchString(label) {
+			return candidate.protocol
+		}
+	}
+	return types.UnknownProtocol
+}
@@ -0,0 +1,196 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const testMarkdown = "# Gateway ECU Design\n\n" +
+	"Some text.\n\n" +
+	"```mermaid\n" +
+	"sequenceDiagram\n" +
+	"    Cloud->>Gateway: Unlock\n" +
+	"```\n\n" +
+	"```mermaid\n" +
+	`graph TD
+    subgraph "External World"
+        Cloud[Cloud Backend]
+    end
+
+    subgraph gateway ["Gateway ECU"]
+        style Linux fill:#ffcccc
+        subgraph "VM1: Linux (QM)"
+            RevProxy["Reverse Proxy (reverse-proxy)"]
+        end
+        subgraph "Classic AUTOSAR"
+            SigGw["Signal Gateway (signal-gateway)"]
+            Keys[(Key Store)]
+        end
+
+        %% Connections
+        Cloud <===>|TLS| RevProxy
+        RevProxy -->|IPC| SigGw & Keys
+        SigGw -- CAN FD --> Chassis{Chassis CAN}
+        SigGw -.-> Keys
+    end
+` + "```\n"
+
+func TestReadMermaid(t *testing.T) {
+	flowchart, err := ReadMermaid(strings.NewReader(testMarkdown))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Gateway ECU Design", flowchart.Title)
+
+	subgraphs := make([]string, 0)
+	for _, subgraph := range flowchart.Subgraphs {
+		subgraphs = append(subgraphs, subgraph.Title)
+	}
+	assert.Equal(t, []string{"External World", "Gateway ECU", "VM1: Linux (QM)", "Classic AUTOSAR"}, subgraphs)
+	assert.Equal(t, "Gateway ECU", flowchart.Subgraphs[3].Parent.Title)
+
+	nodes := make([]string, 0)
+	for _, node := range flowchart.Nodes {
+		nodes = append(nodes, node.Id+"="+node.Label+"@"+node.Subgraph.Title)
+	}
+	assert.Equal(t, []string{
+		"Cloud=Cloud Backend@External World",
+		"RevProxy=Reverse Proxy (reverse-proxy)@VM1: Linux (QM)",
+		"SigGw=Signal Gateway (signal-gateway)@Classic AUTOSAR",
+		"Keys=Key Store@Classic AUTOSAR",
+		"Chassis=Chassis CAN@Gateway ECU",
+	}, nodes)
+	assert.True(t, flowchart.Nodes[3].Datastore)
+
+	assert.Equal(t, []*FlowchartEdge{
+		{From: "Cloud", To: "RevProxy", Label: "TLS", Bidirectional: true},
+		{From: "RevProxy", To: "SigGw", Label: "IPC"},
+		{From: "RevProxy", To: "Keys", Label: "IPC"},
+		{From: "SigGw", To: "Chassis", Label: "CAN FD"},
+		{From: "SigGw", To: "Keys"},
+	}, flowchart.Edges)
+}
+
+func TestReadMermaidInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"missing end":         "graph LR\nsubgraph Vehicle\nA --> B\n",
+		"unexpected end":      "graph LR\nA --> B\nend\n",
+		"missing edge":        "graph LR\nA B\n",
+		"missing edge target": "graph LR\nA -->\n",
+		"unterminated label":  "graph LR\nA[Gateway --> B\n",
+	}
+
+	for name, mermaid := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadMermaid(strings.NewReader(mermaid))
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestFlowchartModel(t *testing.T) {
+	flowchart, err := ReadMermaid(strings.NewReader(testMarkdown))
+	assert.Nil(t, err)
+
+	model := flowchart.Model()
+
+	assert.Equal(t, "Gateway ECU Design", model.Title)
+	assert.Len(t, model.TrustBoundaries, 4)
+	assert.Equal(t, "network-on-prem", model.TrustBoundaries["Gateway ECU"].Type)
+	assert.Equal(t, []string{"vm1-linux-qm", "classic-autosar"}, model.TrustBoundaries["Gateway ECU"].TrustBoundariesNested)
+	assert.Equal(t, []string{"chassis-can"}, model.TrustBoundaries["Gateway ECU"].TechnicalAssetsInside)
+	assert.Equal(t, "execution-environment", model.TrustBoundaries["Classic AUTOSAR"].Type)
+	assert.Equal(t, []string{"signal-gateway", "key-store"}, model.TrustBoundaries["Classic AUTOSAR"].TechnicalAssetsInside)
+
+	assert.Len(t, model.TechnicalAssets, 5)
+	reverseProxy := model.TechnicalAssets["Reverse Proxy"]
+	assert.Equal(t, "reverse-proxy", reverseProxy.ID)
+	assert.Equal(t, "unknown-technology", reverseProxy.Technology)
+	assert.Equal(t, "TODO: rate confidentiality, integrity and availability", reverseProxy.JustificationCiaRating)
+	assert.Equal(t, "datastore", model.TechnicalAssets["Key Store"].Type)
+
+	assert.Equal(t, "binary-encrypted", model.TechnicalAssets["Cloud Backend"].CommunicationLinks["TLS"].Protocol)
+	assert.Equal(t, "cloud-backend", reverseProxy.CommunicationLinks["TLS"].Target)
+	assert.Equal(t, "signal-gateway", reverseProxy.CommunicationLinks["IPC"].Target)
+	assert.Equal(t, "key-store", reverseProxy.CommunicationLinks["IPC to Key Store"].Target)
+	signalGateway := model.TechnicalAssets["Signal Gateway"]
+	assert.Equal(t, "can-fd", signalGateway.CommunicationLinks["CAN FD"].Protocol)
+	assert.Equal(t, "unknown-protocol", signalGateway.CommunicationLinks["to Key Store"].Protocol)
+}
+
+func TestFlowchartModelSameLabels(t *testing.T) {
+	flowchart, err := ReadMermaid(strings.NewReader(`graph LR
+    subgraph Front
+        A[ECU]
+    end
+    subgraph Front
+        B[ECU]
+        C[ECU]
+    end
+    A --> B
+    B --> C
+`))
+	assert.Nil(t, err)
+
+	model := flowchart.Model()
+
+	assert.Equal(t, "front", model.TrustBoundaries["Front"].ID)
+	assert.Equal(t, "front-2", model.TrustBoundaries["Front (Front)"].ID)
+	assert.Equal(t, "ecu", model.TechnicalAssets["ECU"].ID)
+	assert.Equal(t, "ecu-b", model.TechnicalAssets["ECU (B)"].ID)
+	assert.Equal(t, "ecu-c", model.TechnicalAssets["ECU (C)"].ID)
+	assert.Equal(t, "ecu-b", model.TechnicalAssets["ECU"].CommunicationLinks["to ECU (B)"].Target)
+	assert.Equal(t, "ecu-c", model.TechnicalAssets["ECU (B)"].CommunicationLinks["to ECU (C)"].Target)
+	assert.Equal(t, []string{"ecu-b", "ecu-c"}, model.TrustBoundaries["Front (Front)"].TechnicalAssetsInside)
+}
+
+func TestFlowchartModelBidirectionalEdges(t *testing.T) {
+	flowchart, err := ReadMermaid(strings.NewReader(`graph LR
+    A <--> B
+    B o--o|CAN| C
+    C x-- SOME/IP --x D
+    D --o A
+`))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []*FlowchartEdge{
+		{From: "A", To: "B", Bidirectional: true},
+		{From: "B", To: "C", Label: "CAN", Bidirectional: true},
+		{From: "C", To: "D", Label: "SOME/IP", Bidirectional: true},
+		{From: "D", To: "A"},
+	}, flowchart.Edges)
+
+	model := flowchart.Model()
+
+	assert.Equal(t, "b", model.TechnicalAssets["A"].CommunicationLinks["to B"].Target)
+	assert.Equal(t, "a", model.TechnicalAssets["B"].CommunicationLinks["to A"].Target)
+	assert.Equal(t, "c", model.TechnicalAssets["B"].CommunicationLinks["CAN"].Target)
+	assert.Equal(t, "b", model.TechnicalAssets["C"].CommunicationLinks["CAN"].Target)
+	assert.Equal(t, "some-ip", model.TechnicalAssets["D"].CommunicationLinks["SOME/IP"].Protocol)
+	assert.Equal(t, "a", model.TechnicalAssets["D"].CommunicationLinks["to A"].Target)
+	assert.Len(t, model.TechnicalAssets["A"].CommunicationLinks, 1)
+}
+
+func TestGuessProtocol(t *testing.T) {
+	testCases := map[string]types.Protocol{
+		"https":               types.HTTPS,
+		"CAN / CAN FD":        types.CANFD,
+		"UDS on CAN":          types.CAN,
+		"Ethernet / SOMEIP":   types.SomeIP,
+		"TI-IPC / Shared Mem": types.IPC,
+		"DoIP":                types.TCP,
+		"REST":                types.HTTP,
+		"Ethernet":            types.UnknownProtocol,
+		"":                    types.UnknownProtocol,
+	}
+
+	for label, expected := range testCases {
+		t.Run(label, func(t *testing.T) {
+			assert.Equal(t, expected, guessProtocol(label))
+		})
+	}
+}
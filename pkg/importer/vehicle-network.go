@@ -0,0 +1,322 @@
+package importer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/input"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// VehicleNetwork is the intermediate representation of the electronic control units, software components and
+// messages read from AUTOSAR ARXML and CAN DBC files, before being converted into a threagile model
+type VehicleNetwork struct {
+	Sources            []string
+	Ecus               []string
+	SoftwareComponents []*SoftwareComponent
+	Connectors         []*SoftwareConnector
+	Messages           []*Message
+}
+
+type SoftwareComponent struct {
+	Name     string
+	Adaptive bool
+	Ecu      string
+	Ports    []string
+}
+
+type SoftwareConnector struct {
+	Provider  string
+	Requester string
+	Port      string
+}
+
+type Message struct {
+	Name      string
+	Id        string
+	Bus       string
+	Protocol  types.Protocol
+	Group     string
+	Sender    string
+	Receivers []string
+}
+
+// ReadVehicleNetworkFiles reads all given ARXML (.arxml) and DBC (.dbc) files into a single vehicle network
+func ReadVehicleNetworkFiles(filenames ...string) (*VehicleNetwork, error) {
+	network := new(VehicleNetwork)
+	for _, filename := range filenames {
+		file, openError := os.Open(filepath.Clean(filename))
+		if openError != nil {
+			return nil, fmt.Errorf("failed to open %q: %w", filename, openError)
+		}
+
+		var fileNetwork *VehicleNetwork
+		var readError error
+		switch strings.ToLower(filepath.Ext(filename)) {
+		case ".arxml":
+			fileNetwork, readError = ReadArxml(file)
+		case ".dbc":
+			fileNetwork, readError = ReadDbc(file, strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename)))
+		default:
+			readError = fmt.Errorf("unsupported file type (expected .arxml or .dbc)")
+		}
+		_ = file.Close()
+		if readError != nil {
+			return nil, fmt.Errorf("failed to read %q: %w", filename, readError)
+		}
+
+		fileNetwork.Sources = []string{filepath.Base(filename)}
+		network.Merge(fileNetwork)
+	}
+
+	return network, nil
+}
+
+func (what *VehicleNetwork) Merge(other *VehicleNetwork) {
+	what.Sources = append(what.Sources, other.Sources...)
+	for _, ecu := range other.Ecus {
+		what.addEcu(ecu)
+	}
+	what.SoftwareComponents = append(what.SoftwareComponents, other.SoftwareComponents...)
+	what.Connectors = append(what.Connectors, other.Connectors...)
+	what.Messages = append(what.Messages, other.Messages...)
+}
+
+func (what *VehicleNetwork) addEcu(name string) {
+	for _, ecu := range what.Ecus {
+		if ecu == name {
+			return
+		}
+	}
+	what.Ecus = append(what.Ecus, name)
+}
+
+// Model converts the vehicle network into a threagile model with a technical asset per ECU and software component,
+// a communication link per sender and receiver of messages on each bus and a data asset per message group.
+// Names resulting in the same id (like "EngineECU" and "Engine_ECU") get numbered ids.
+// The CIA ratings are conservative defaults to be refined after importing.
+func (what *VehicleNetwork) Model() *input.Model {
+	model := new(input.Model).Defaults()
+	model.DataAssets = make(map[string]input.DataAsset)
+	model.TechnicalAssets = make(map[string]input.TechnicalAsset)
+	model.TrustBoundaries = make(map[string]input.TrustBoundary)
+
+	usedAssetIds := make(map[string]bool)
+	usedBoundaryIds := make(map[string]bool)
+	usedDataAssetIds := make(map[string]bool)
+
+	justification := "Imported from " + strings.Join(what.Sources, ", ") + " (to be refined)"
+	ecuIds := make(map[string]string)
+	for _, ecu := range what.Ecus {
+		ecuIds[ecu] = uniqueId(createId(ecu), "", usedAssetIds)
+		model.TechnicalAssets[ecu] = input.TechnicalAsset{
+			ID:                     ecuIds[ecu],
+			Description:            "Electronic control unit " + ecu,
+			Type:                   types.Process.String(),
+			Usage:                  types.Business.String(),
+			Size:                   types.System.String(),
+			Technology:             "electronic-control-unit",
+			Machine:                types.Physical.String(),
+			Encryption:             types.NoneEncryption.String(),
+			Confidentiality:        types.Internal.String(),
+			Integrity:              types.Critical.String(),
+			Availability:           types.Critical.String(),
+			JustificationCiaRating: justification,
+			CustomDevelopedParts:   true,
+			CommunicationLinks:     make(map[string]input.CommunicationLink),
+		}
+	}
+
+	componentsByName := make(map[string]*SoftwareComponent)
+	componentTitles := make(map[*SoftwareComponent]string)
+	componentIds := make(map[*SoftwareComponent]string)
+	for _, component := range what.SoftwareComponents {
+		componentsByName[component.Name] = component
+		componentTitles[component] = uniqueTitle(component.Name, "software component "+createId(component.Name), func(title string) bool {
+			_, exists := model.TechnicalAssets[title]
+			return exists
+		})
+		componentIds[component] = uniqueId(createId(component.Name), "", usedAssetIds)
+		technology := "autosar-classic"
+		if component.Adaptive {
+			technology = "autosar-adaptive"
+		}
+		description := "Software component " + component.Name
+		if len(component.Ports) > 0 {
+			description += " with ports " + strings.Join(component.Ports, ", ")
+		}
+		model.TechnicalAssets[componentTitles[component]] = input.TechnicalAsset{
+			ID:                     componentIds[component],
+			Description:            description,
+			Type:                   types.Process.String(),
+			Usage:                  types.Business.String(),
+			Size:                   types.Component.String(),
+			Technology:             technology,
+			Machine:                types.Physical.String(),
+			Encryption:             types.NoneEncryption.String(),
+			Confidentiality:        types.Internal.String(),
+			Integrity:              types.Critical.String(),
+			Availability:           types.Critical.String(),
+			JustificationCiaRating: justification,
+			CustomDevelopedParts:   true,
+			CommunicationLinks:     make(map[string]input.CommunicationLink),
+		}
+
+		if len(component.Ecu) > 0 {
+			title := component.Ecu + " Software"
+			trustBoundary, ok := model.TrustBoundaries[title]
+			if !ok {
+				trustBoundary = input.TrustBoundary{
+					ID:          uniqueId(createId(title), "", usedBoundaryIds),
+					Description: "Software running on electronic control unit " + component.Ecu,
+					Type:        types.ExecutionEnvironment.String(),
+				}
+				if ecuId, ok := ecuIds[component.Ecu]; ok {
+					trustBoundary.TechnicalAssetsInside = []string{ecuId}
+				}
+			}
+			trustBoundary.TechnicalAssetsInside = append(trustBoundary.TechnicalAssetsInside, componentIds[component])
+			model.TrustBoundaries[title] = trustBoundary
+		}
+	}
+
+	for _, connector := range what.Connectors {
+		provider, providerOk := componentsByName[connector.Provider]
+		requester, requesterOk := componentsByName[connector.Requester]
+		if !providerOk || !requesterOk || len(provider.Ecu) == 0 || provider.Ecu != requester.Ecu {
+			// connectors between ECUs are covered by the messages on the buses
+			continue
+		}
+		protocol := types.InProcessLibraryCall
+		if provider.Adaptive || requester.Adaptive {
+			protocol = types.IPC
+		}
+		model.TechnicalAssets[componentTitles[provider]].CommunicationLinks[connector.Port+" to "+requester.Name] = input.CommunicationLink{
+			Target:         componentIds[requester],
+			Description:    "Port " + connector.Port + " connected to " + requester.Name,
+			Protocol:       protocol.String(),
+			Authentication: types.NoneAuthentication.String(),
+			Authorization:  types.NoneAuthorization.String(),
+			Usage:          types.Business.String(),
+		}
+	}
+
+	type linkKey struct {
+		sender, bus, receiver string
+	}
+	linkMessages := make(map[linkKey][]*Message)
+	for _, message := range what.Messages {
+		group := message.groupOrDefault()
+		dataAsset, ok := model.DataAssets[group]
+		if !ok {
+			dataAsset = input.DataAsset{
+				ID:                     uniqueId(createId(group), "", usedDataAssetIds),
+				Usage:                  types.Business.String(),
+				Quantity:               types.Many.String(),
+				Confidentiality:        types.Internal.String(),
+				Integrity:              types.Critical.String(),
+				Availability:           types.Critical.String(),
+				JustificationCiaRating: justification,
+			}
+		}
+		dataAsset.Description = appendListItem(dataAsset.Description, "Messages: ", message.label())
+		dataAsset.Origin = appendListItem(dataAsset.Origin, "", message.Sender)
+		model.DataAssets[group] = dataAsset
+
+		// messages are sent and received by ECUs
+		if _, ok := ecuIds[message.Sender]; !ok {
+			continue
+		}
+		sender := model.TechnicalAssets[message.Sender]
+		sender.DataAssetsProcessed = appendUnique(sender.DataAssetsProcessed, dataAsset.ID)
+		model.TechnicalAssets[message.Sender] = sender
+		for _, receiver := range message.Receivers {
+			if _, ok := ecuIds[receiver]; ok {
+				target := model.TechnicalAssets[receiver]
+				target.DataAssetsProcessed = appendUnique(target.DataAssetsProcessed, dataAsset.ID)
+				model.TechnicalAssets[receiver] = target
+				key := linkKey{sender: message.Sender, bus: message.Bus, receiver: receiver}
+				linkMessages[key] = append(linkMessages[key], message)
+			}
+		}
+	}
+
+	for key, messages := range linkMessages {
+		communicationLink := input.CommunicationLink{
+			Target:         ecuIds[key.receiver],
+			Protocol:       messages[0].Protocol.String(),
+			Authentication: types.NoneAuthentication.String(),
+			Authorization:  types.NoneAuthorization.String(),
+			Usage:          types.Business.String(),
+		}
+		for _, message := range messages {
+			communicationLink.Description = appendListItem(communicationLink.Description, "Messages sent over "+key.bus+": ", message.label())
+			communicationLink.DataAssetsSent = appendUnique(communicationLink.DataAssetsSent, model.DataAssets[message.groupOrDefault()].ID)
+		}
+		sort.Strings(communicationLink.DataAssetsSent)
+		model.TechnicalAssets[key.sender].CommunicationLinks[key.bus+" to "+key.receiver] = communicationLink
+	}
+
+	for title, technicalAsset := range model.TechnicalAssets {
+		sort.Strings(technicalAsset.DataAssetsProcessed)
+		model.TechnicalAssets[title] = technicalAsset
+	}
+
+	return model
+}
+
+func (what *Message) label() string {
+	if len(what.Id) > 0 {
+		return what.Name + " (" + what.Id + ")"
+	}
+	return what.Name
+}
+
+func (what *Message) groupOrDefault() string {
+	if len(what.Group) > 0 {
+		return what.Group
+	}
+	if len(what.Sender) > 0 {
+		return what.Sender + " Messages"
+	}
+	return what.Bus + " Messages"
+}
+
+func appendListItem(list string, prefix string, item string) string {
+	if len(item) == 0 {
+		return list
+	}
+	if len(list) == 0 {
+		return prefix + item
+	}
+	for _, existing := range strings.Split(strings.TrimPrefix(list, prefix), ", ") {
+		if existing == item {
+			return list
+		}
+	}
+	return list + ", " + item
+}
+
+func appendUnique(list []string, item string) []string {
+	for _, existing := range list {
+		if existing == item {
+			return list
+		}
+	}
+	return append(list, item)
+}
+
+var (
+	camelCaseBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+	acronymBoundary   = regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`)
+)
+
+// createId turns names like "EngineECU_Torque" into valid threagile ids like "engine-ecu-torque"
+func createId(name string) string {
+	id := acronymBoundary.ReplaceAllString(name, "$1-$2")
+	return types.MakeID(camelCaseBoundary.ReplaceAllString(id, "$1-$2"))
+}
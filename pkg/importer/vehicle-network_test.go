@@ -0,0 +1,98 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestVehicleNetworkModel(t *testing.T) {
+	network, err := ReadArxml(strings.NewReader(testArxml))
+	assert.Nil(t, err)
+	network.Sources = []string{"powertrain.arxml"}
+	dbcNetwork, err := ReadDbc(strings.NewReader(testDbc), "BodyCAN")
+	assert.Nil(t, err)
+	dbcNetwork.Sources = []string{"body.dbc"}
+	network.Merge(dbcNetwork)
+
+	model := network.Model()
+
+	assert.Len(t, model.TechnicalAssets, 6)
+	gateway := model.TechnicalAssets["CentralGateway"]
+	assert.Equal(t, "central-gateway", gateway.ID)
+	assert.Equal(t, "electronic-control-unit", gateway.Technology)
+	assert.Equal(t, "Imported from powertrain.arxml, body.dbc (to be refined)", gateway.JustificationCiaRating)
+	assert.Equal(t, []string{"powertrain-control"}, gateway.DataAssetsProcessed)
+
+	torqueLink := gateway.CommunicationLinks["PowertrainCAN to PowertrainECU"]
+	assert.Equal(t, "powertrain-ecu", torqueLink.Target)
+	assert.Equal(t, "can-fd", torqueLink.Protocol)
+	assert.Equal(t, "Messages sent over PowertrainCAN: TorqueRequestPdu", torqueLink.Description)
+	assert.Equal(t, []string{"powertrain-control"}, torqueLink.DataAssetsSent)
+
+	doorLink := model.TechnicalAssets["BodyControl"].CommunicationLinks["BodyCAN to DoorModule"]
+	assert.Equal(t, "door-module", doorLink.Target)
+	assert.Equal(t, "can-bus", doorLink.Protocol)
+	assert.Equal(t, "Messages sent over BodyCAN: DoorCommand (0x200)", doorLink.Description)
+	assert.Equal(t, []string{"body-control-messages"}, doorLink.DataAssetsSent)
+
+	assert.Len(t, model.DataAssets, 3)
+	assert.Equal(t, "door-module-messages", model.DataAssets["DoorModule Messages"].ID)
+	assert.Equal(t, "Messages: DoorStatus (0x201)", model.DataAssets["DoorModule Messages"].Description)
+	assert.Equal(t, "CentralGateway", model.DataAssets["PowertrainControl"].Origin)
+
+	componentLink := model.TechnicalAssets["TorqueController"].CommunicationLinks["TorqueRequest to MotorControl"]
+	assert.Equal(t, "motor-control", componentLink.Target)
+	assert.Equal(t, "in-process-library-call", componentLink.Protocol)
+
+	assert.Equal(t, []string{"powertrain-ecu", "torque-controller", "motor-control"}, model.TrustBoundaries["PowertrainECU Software"].TechnicalAssetsInside)
+}
+
+func TestVehicleNetworkModelSameIds(t *testing.T) {
+	network := &VehicleNetwork{
+		Ecus: []string{"EngineECU", "Engine_ECU"},
+		SoftwareComponents: []*SoftwareComponent{
+			{Name: "EngineECU", Ecu: "Engine_ECU"},
+		},
+		Messages: []*Message{
+			{Name: "Torque", Bus: "CAN", Protocol: types.CAN, Group: "Engine ECU", Sender: "Engine_ECU", Receivers: []string{"EngineECU"}},
+			{Name: "Speed", Bus: "CAN", Protocol: types.CAN, Group: "EngineECU", Sender: "EngineECU", Receivers: []string{"Engine_ECU"}},
+		},
+	}
+
+	model := network.Model()
+
+	assert.Len(t, model.TechnicalAssets, 3)
+	assert.Equal(t, "engine-ecu", model.TechnicalAssets["EngineECU"].ID)
+	assert.Equal(t, "engine-ecu-2", model.TechnicalAssets["Engine_ECU"].ID)
+	assert.Equal(t, "engine-ecu-3", model.TechnicalAssets["EngineECU (software component engine-ecu)"].ID)
+	assert.Equal(t, []string{"engine-ecu-2", "engine-ecu-3"}, model.TrustBoundaries["Engine_ECU Software"].TechnicalAssetsInside)
+
+	assert.Equal(t, "engine-ecu", model.TechnicalAssets["Engine_ECU"].CommunicationLinks["CAN to EngineECU"].Target)
+	assert.Equal(t, "engine-ecu-2", model.TechnicalAssets["EngineECU"].CommunicationLinks["CAN to Engine_ECU"].Target)
+
+	assert.Len(t, model.DataAssets, 2)
+	assert.Equal(t, "engine-ecu", model.DataAssets["Engine ECU"].ID)
+	assert.Equal(t, "engine-ecu-2", model.DataAssets["EngineECU"].ID)
+	assert.Equal(t, []string{"engine-ecu"}, model.TechnicalAssets["Engine_ECU"].CommunicationLinks["CAN to EngineECU"].DataAssetsSent)
+}
+
+func TestCreateId(t *testing.T) {
+	testCases := map[string]string{
+		"Gateway":          "gateway",
+		"PowertrainECU":    "powertrain-ecu",
+		"ECUInstance":      "ecu-instance",
+		"Door_Module 2":    "door-module-2",
+		"ADAS2Controller":  "adas2-controller",
+		"BodyCAN Messages": "body-can-messages",
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, expected, createId(name))
+		})
+	}
+}
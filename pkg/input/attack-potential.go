@@ -0,0 +1,62 @@
+package input
+
+import "fmt"
+
+type AttackPotential struct {
+	ElapsedTime         string `yaml:"elapsed_time,omitempty" json:"elapsed_time,omitempty"`
+	Expertise           string `yaml:"expertise,omitempty" json:"expertise,omitempty"`
+	KnowledgeOfItem     string `yaml:"knowledge_of_item,omitempty" json:"knowledge_of_item,omitempty"`
+	WindowOfOpportunity string `yaml:"window_of_opportunity,omitempty" json:"window_of_opportunity,omitempty"`
+	Equipment           string `yaml:"equipment,omitempty" json:"equipment,omitempty"`
+	Justification       string `yaml:"justification,omitempty" json:"justification,omitempty"`
+}
+
+func (what *AttackPotential) Merge(other AttackPotential) error {
+	var mergeError error
+	what.ElapsedTime, mergeError = new(Strings).MergeSingleton(what.ElapsedTime, other.ElapsedTime)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge elapsed_time: %w", mergeError)
+	}
+
+	what.Expertise, mergeError = new(Strings).MergeSingleton(what.Expertise, other.Expertise)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge expertise: %w", mergeError)
+	}
+
+	what.KnowledgeOfItem, mergeError = new(Strings).MergeSingleton(what.KnowledgeOfItem, other.KnowledgeOfItem)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge knowledge_of_item: %w", mergeError)
+	}
+
+	what.WindowOfOpportunity, mergeError = new(Strings).MergeSingleton(what.WindowOfOpportunity, other.WindowOfOpportunity)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge window_of_opportunity: %w", mergeError)
+	}
+
+	what.Equipment, mergeError = new(Strings).MergeSingleton(what.Equipment, other.Equipment)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge equipment: %w", mergeError)
+	}
+
+	what.Justification = new(Strings).MergeMultiline(what.Justification, other.Justification)
+
+	return nil
+}
+
+func (what *AttackPotential) MergeMap(first map[string]AttackPotential, second map[string]AttackPotential) (map[string]AttackPotential, error) {
+	for mapKey, mapValue := range second {
+		mapItem, ok := first[mapKey]
+		if ok {
+			mergeError := mapItem.Merge(mapValue)
+			if mergeError != nil {
+				return first, fmt.Errorf("failed to merge attack potential %q: %w", mapKey, mergeError)
+			}
+
+			first[mapKey] = mapItem
+		} else {
+			first[mapKey] = mapValue
+		}
+	}
+
+	return first, nil
+}
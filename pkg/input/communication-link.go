@@ -8,6 +8,8 @@ type CommunicationLink struct {
 	Protocol               string   `yaml:"protocol,omitempty" json:"protocol,omitempty"`
 	Authentication         string   `yaml:"authentication,omitempty" json:"authentication,omitempty"`
 	Authorization          string   `yaml:"authorization,omitempty" json:"authorization,omitempty"`
+	MessageAuthentication  string   `yaml:"message_authentication,omitempty" json:"message_authentication,omitempty"`
+	E2EProtection          string   `yaml:"e2e_protection,omitempty" json:"e2e_protection,omitempty"`
 	Tags                   []string `yaml:"tags,omitempty" json:"tags,omitempty"`
 	VPN                    bool     `yaml:"vpn,omitempty" json:"vpn,omitempty"`
 	IpFiltered             bool     `yaml:"ip_filtered,omitempty" json:"ip_filtered,omitempty"`
@@ -46,6 +48,16 @@ func (what *CommunicationLink) Merge(other CommunicationLink) error {
 		return fmt.Errorf("failed to merge authorization: %w", mergeError)
 	}
 
+	what.MessageAuthentication, mergeError = new(Strings).MergeSingleton(what.MessageAuthentication, other.MessageAuthentication)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge message authentication: %w", mergeError)
+	}
+
+	what.E2EProtection, mergeError = new(Strings).MergeSingleton(what.E2EProtection, other.E2EProtection)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge e2e protection: %w", mergeError)
+	}
+
 	what.Tags = new(Strings).MergeUniqueSlice(what.Tags, other.Tags)
 
 	if !what.VPN {
@@ -0,0 +1,41 @@
+package input
+
+import "fmt"
+
+type CybersecurityGoal struct {
+	ID          string   `yaml:"id,omitempty" json:"id,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Risks       []string `yaml:"risks,omitempty" json:"risks,omitempty"`
+}
+
+func (what *CybersecurityGoal) Merge(other CybersecurityGoal) error {
+	var mergeError error
+	what.ID, mergeError = new(Strings).MergeSingleton(what.ID, other.ID)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge id: %w", mergeError)
+	}
+
+	what.Description = new(Strings).MergeMultiline(what.Description, other.Description)
+
+	what.Risks = new(Strings).MergeUniqueSlice(what.Risks, other.Risks)
+
+	return nil
+}
+
+func (what *CybersecurityGoal) MergeMap(first map[string]CybersecurityGoal, second map[string]CybersecurityGoal) (map[string]CybersecurityGoal, error) {
+	for mapKey, mapValue := range second {
+		mapItem, ok := first[mapKey]
+		if ok {
+			mergeError := mapItem.Merge(mapValue)
+			if mergeError != nil {
+				return first, fmt.Errorf("failed to merge cybersecurity goal %q: %w", mapKey, mergeError)
+			}
+
+			first[mapKey] = mapItem
+		} else {
+			first[mapKey] = mapValue
+		}
+	}
+
+	return first, nil
+}
@@ -0,0 +1,65 @@
+package input
+
+import "fmt"
+
+type DamageScenario struct {
+	ID          string   `yaml:"id,omitempty" json:"id,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Safety      string   `yaml:"safety,omitempty" json:"safety,omitempty"`
+	Financial   string   `yaml:"financial,omitempty" json:"financial,omitempty"`
+	Operational string   `yaml:"operational,omitempty" json:"operational,omitempty"`
+	Privacy     string   `yaml:"privacy,omitempty" json:"privacy,omitempty"`
+	Risks       []string `yaml:"risks,omitempty" json:"risks,omitempty"`
+}
+
+func (what *DamageScenario) Merge(other DamageScenario) error {
+	var mergeError error
+	what.ID, mergeError = new(Strings).MergeSingleton(what.ID, other.ID)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge id: %w", mergeError)
+	}
+
+	what.Description = new(Strings).MergeMultiline(what.Description, other.Description)
+
+	what.Safety, mergeError = new(Strings).MergeSingleton(what.Safety, other.Safety)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge safety: %w", mergeError)
+	}
+
+	what.Financial, mergeError = new(Strings).MergeSingleton(what.Financial, other.Financial)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge financial: %w", mergeError)
+	}
+
+	what.Operational, mergeError = new(Strings).MergeSingleton(what.Operational, other.Operational)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge operational: %w", mergeError)
+	}
+
+	what.Privacy, mergeError = new(Strings).MergeSingleton(what.Privacy, other.Privacy)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge privacy: %w", mergeError)
+	}
+
+	what.Risks = new(Strings).MergeUniqueSlice(what.Risks, other.Risks)
+
+	return nil
+}
+
+func (what *DamageScenario) MergeMap(first map[string]DamageScenario, second map[string]DamageScenario) (map[string]DamageScenario, error) {
+	for mapKey, mapValue := range second {
+		mapItem, ok := first[mapKey]
+		if ok {
+			mergeError := mapItem.Merge(mapValue)
+			if mergeError != nil {
+				return first, fmt.Errorf("failed to merge damage scenario %q: %w", mapKey, mergeError)
+			}
+
+			first[mapKey] = mapItem
+		} else {
+			first[mapKey] = mapValue
+		}
+	}
+
+	return first, nil
+}
@@ -21,34 +21,40 @@ import (
 // === Model Type Stuff ======================================
 
 type Model struct { // TODO: Eventually remove this and directly use ParsedModelRoot? But then the error messages for model errors are not quite as good anymore...
-	ThreagileVersion                              string                    `yaml:"threagile_version,omitempty" json:"threagile_version,omitempty"`
-	Includes                                      []string                  `yaml:"includes,omitempty" json:"includes,omitempty"`
-	Title                                         string                    `yaml:"title,omitempty" json:"title,omitempty"`
-	Author                                        Author                    `yaml:"author,omitempty" json:"author,omitempty"`
-	Contributors                                  []Author                  `yaml:"contributors,omitempty" json:"contributors,omitempty"`
-	Date                                          string                    `yaml:"date,omitempty" json:"date,omitempty"`
-	AppDescription                                Overview                  `yaml:"application_description,omitempty" json:"application_description,omitempty"`
-	BusinessOverview                              Overview                  `yaml:"business_overview,omitempty" json:"business_overview,omitempty"`
-	TechnicalOverview                             Overview                  `yaml:"technical_overview,omitempty" json:"technical_overview,omitempty"`
-	BusinessCriticality                           string                    `yaml:"business_criticality,omitempty" json:"business_criticality,omitempty"`
-	ManagementSummaryComment                      string                    `yaml:"management_summary_comment,omitempty" json:"management_summary_comment,omitempty"`
-	SecurityRequirements                          map[string]string         `yaml:"security_requirements,omitempty" json:"security_requirements,omitempty"`
-	Questions                                     map[string]string         `yaml:"questions,omitempty" json:"questions,omitempty"`
-	AbuseCases                                    map[string]string         `yaml:"abuse_cases,omitempty" json:"abuse_cases,omitempty"`
-	TagsAvailable                                 []string                  `yaml:"tags_available,omitempty" json:"tags_available,omitempty"`
-	DataAssets                                    map[string]DataAsset      `yaml:"data_assets,omitempty" json:"data_assets,omitempty"`
-	TechnicalAssets                               map[string]TechnicalAsset `yaml:"technical_assets,omitempty" json:"technical_assets,omitempty"`
-	TrustBoundaries                               map[string]TrustBoundary  `yaml:"trust_boundaries,omitempty" json:"trust_boundaries,omitempty"`
-	SharedRuntimes                                map[string]SharedRuntime  `yaml:"shared_runtimes,omitempty" json:"shared_runtimes,omitempty"`
-	CustomRiskCategories                          RiskCategories            `yaml:"custom_risk_categories,omitempty" json:"custom_risk_categories,omitempty"`
-	RiskTracking                                  map[string]RiskTracking   `yaml:"risk_tracking,omitempty" json:"risk_tracking,omitempty"`
-	DiagramTweakNodesep                           int                       `yaml:"diagram_tweak_nodesep,omitempty" json:"diagram_tweak_nodesep,omitempty"`
-	DiagramTweakRanksep                           int                       `yaml:"diagram_tweak_ranksep,omitempty" json:"diagram_tweak_ranksep,omitempty"`
-	DiagramTweakEdgeLayout                        string                    `yaml:"diagram_tweak_edge_layout,omitempty" json:"diagram_tweak_edge_layout,omitempty"`
-	DiagramTweakSuppressEdgeLabels                bool                      `yaml:"diagram_tweak_suppress_edge_labels,omitempty" json:"diagram_tweak_suppress_edge_labels,omitempty"`
-	DiagramTweakLayoutLeftToRight                 bool                      `yaml:"diagram_tweak_layout_left_to_right,omitempty" json:"diagram_tweak_layout_left_to_right,omitempty"`
-	DiagramTweakInvisibleConnectionsBetweenAssets []string                  `yaml:"diagram_tweak_invisible_connections_between_assets,omitempty" json:"diagram_tweak_invisible_connections_between_assets,omitempty"`
-	DiagramTweakSameRankAssets                    []string                  `yaml:"diagram_tweak_same_rank_assets,omitempty" json:"diagram_tweak_same_rank_assets,omitempty"`
+	ThreagileVersion                              string                       `yaml:"threagile_version,omitempty" json:"threagile_version,omitempty"`
+	Includes                                      []string                     `yaml:"includes,omitempty" json:"includes,omitempty"`
+	Title                                         string                       `yaml:"title,omitempty" json:"title,omitempty"`
+	Author                                        Author                       `yaml:"author,omitempty" json:"author,omitempty"`
+	Contributors                                  []Author                     `yaml:"contributors,omitempty" json:"contributors,omitempty"`
+	Date                                          string                       `yaml:"date,omitempty" json:"date,omitempty"`
+	AppDescription                                Overview                     `yaml:"application_description,omitempty" json:"application_description,omitempty"`
+	BusinessOverview                              Overview                     `yaml:"business_overview,omitempty" json:"business_overview,omitempty"`
+	TechnicalOverview                             Overview                     `yaml:"technical_overview,omitempty" json:"technical_overview,omitempty"`
+	BusinessCriticality                           string                       `yaml:"business_criticality,omitempty" json:"business_criticality,omitempty"`
+	ManagementSummaryComment                      string                       `yaml:"management_summary_comment,omitempty" json:"management_summary_comment,omitempty"`
+	SecurityRequirements                          map[string]string            `yaml:"security_requirements,omitempty" json:"security_requirements,omitempty"`
+	Questions                                     map[string]string            `yaml:"questions,omitempty" json:"questions,omitempty"`
+	AbuseCases                                    map[string]string            `yaml:"abuse_cases,omitempty" json:"abuse_cases,omitempty"`
+	TagsAvailable                                 []string                     `yaml:"tags_available,omitempty" json:"tags_available,omitempty"`
+	DataAssets                                    map[string]DataAsset         `yaml:"data_assets,omitempty" json:"data_assets,omitempty"`
+	TechnicalAssets                               map[string]TechnicalAsset    `yaml:"technical_assets,omitempty" json:"technical_assets,omitempty"`
+	TrustBoundaries                               map[string]TrustBoundary     `yaml:"trust_boundaries,omitempty" json:"trust_boundaries,omitempty"`
+	SharedRuntimes                                map[string]SharedRuntime     `yaml:"shared_runtimes,omitempty" json:"shared_runtimes,omitempty"`
+	CustomRiskCategories                          RiskCategories               `yaml:"custom_risk_categories,omitempty" json:"custom_risk_categories,omitempty"`
+	RiskTracking                                  map[string]RiskTracking      `yaml:"risk_tracking,omitempty" json:"risk_tracking,omitempty"`
+	DamageScenarios                               map[string]DamageScenario    `yaml:"damage_scenarios,omitempty" json:"damage_scenarios,omitempty"`
+	AttackPotentials                              map[string]AttackPotential   `yaml:"attack_potentials,omitempty" json:"attack_potentials,omitempty"`
+	CybersecurityGoals                            map[string]CybersecurityGoal `yaml:"cybersecurity_goals,omitempty" json:"cybersecurity_goals,omitempty"`
+	CybersecurityClaims                           map[string]CybersecurityGoal `yaml:"cybersecurity_claims,omitempty" json:"cybersecurity_claims,omitempty"`
+	SubModels                                     map[string]SubModel          `yaml:"sub_models,omitempty" json:"sub_models,omitempty"`
+	CrossModelLinks                               map[string]CrossModelLink    `yaml:"cross_model_links,omitempty" json:"cross_model_links,omitempty"`
+	DiagramTweakNodesep                           int                          `yaml:"diagram_tweak_nodesep,omitempty" json:"diagram_tweak_nodesep,omitempty"`
+	DiagramTweakRanksep                           int                          `yaml:"diagram_tweak_ranksep,omitempty" json:"diagram_tweak_ranksep,omitempty"`
+	DiagramTweakEdgeLayout                        string                       `yaml:"diagram_tweak_edge_layout,omitempty" json:"diagram_tweak_edge_layout,omitempty"`
+	DiagramTweakSuppressEdgeLabels                bool                         `yaml:"diagram_tweak_suppress_edge_labels,omitempty" json:"diagram_tweak_suppress_edge_labels,omitempty"`
+	DiagramTweakLayoutLeftToRight                 bool                         `yaml:"diagram_tweak_layout_left_to_right,omitempty" json:"diagram_tweak_layout_left_to_right,omitempty"`
+	DiagramTweakInvisibleConnectionsBetweenAssets []string                     `yaml:"diagram_tweak_invisible_connections_between_assets,omitempty" json:"diagram_tweak_invisible_connections_between_assets,omitempty"`
+	DiagramTweakSameRankAssets                    []string                     `yaml:"diagram_tweak_same_rank_assets,omitempty" json:"diagram_tweak_same_rank_assets,omitempty"`
 }
 
 func (model *Model) Defaults() *Model {
@@ -62,29 +68,65 @@ func (model *Model) Defaults() *Model {
 		SharedRuntimes:       make(map[string]SharedRuntime),
 		CustomRiskCategories: make(RiskCategories, 0),
 		RiskTracking:         make(map[string]RiskTracking),
+		DamageScenarios:      make(map[string]DamageScenario),
+		AttackPotentials:     make(map[string]AttackPotential),
+		CybersecurityGoals:   make(map[string]CybersecurityGoal),
+		CybersecurityClaims:  make(map[string]CybersecurityGoal),
+		SubModels:            make(map[string]SubModel),
+		CrossModelLinks:      make(map[string]CrossModelLink),
 	}
 
 	return model
 }
 
 func (model *Model) Load(inputFilename string) error {
+	loadError := model.load(inputFilename)
+	if loadError != nil {
+		log.Fatal(loadError)
+	}
+
+	return nil
+}
+
+func (model *Model) load(inputFilename string) error {
+	return model.loadComposed(inputFilename, make(map[string]bool))
+}
+
+// loadComposed loads the model file and composes its sub-models, loading lists the model files currently being loaded
+// (the file itself and the models composing it) to detect cycles of sub-models
+func (model *Model) loadComposed(inputFilename string, loading map[string]bool) error {
+	loadingKey, absError := filepath.Abs(inputFilename)
+	if absError != nil {
+		loadingKey = filepath.Clean(inputFilename)
+	}
+	if loading[loadingKey] {
+		return fmt.Errorf("cyclic sub-model: %v is already being loaded", inputFilename)
+	}
+	loading[loadingKey] = true
+	defer delete(loading, loadingKey)
+
 	modelYaml, readError := os.ReadFile(filepath.Clean(inputFilename))
 	if readError != nil {
-		log.Fatal("Unable to read model file: ", readError)
+		return fmt.Errorf("unable to read model file: %w", readError)
 	}
 
 	unmarshalError := yaml.Unmarshal(modelYaml, &model)
 	if unmarshalError != nil {
-		log.Fatal("Unable to parse model yaml: ", unmarshalError)
+		return fmt.Errorf("unable to parse model yaml: %w", unmarshalError)
 	}
 
 	for _, includeFile := range model.Includes {
 		mergeError := model.Merge(filepath.Dir(inputFilename), includeFile)
 		if mergeError != nil {
-			log.Fatalf("Unable to merge model include %q: %v", includeFile, mergeError)
+			return fmt.Errorf("unable to merge model include %q: %w", includeFile, mergeError)
 		}
 	}
 
+	composeError := model.compose(filepath.Dir(inputFilename), loading)
+	if composeError != nil {
+		return fmt.Errorf("unable to compose sub-models: %w", composeError)
+	}
+
 	return nil
 }
 
@@ -231,6 +273,54 @@ func (model *Model) Merge(dir string, includeFilename string) error {
 				return fmt.Errorf("failed to merge risk tracking: %w", mergeError)
 			}
 
+		case strings.ToLower("damage_scenarios"):
+			model.DamageScenarios, mergeError = new(DamageScenario).MergeMap(model.DamageScenarios, includedModel.DamageScenarios)
+			if mergeError != nil {
+				return fmt.Errorf("failed to merge damage scenarios: %w", mergeError)
+			}
+
+		case strings.ToLower("attack_potentials"):
+			model.AttackPotentials, mergeError = new(AttackPotential).MergeMap(model.AttackPotentials, includedModel.AttackPotentials)
+			if mergeError != nil {
+				return fmt.Errorf("failed to merge attack potentials: %w", mergeError)
+			}
+
+		case strings.ToLower("cybersecurity_goals"):
+			model.CybersecurityGoals, mergeError = new(CybersecurityGoal).MergeMap(model.CybersecurityGoals, includedModel.CybersecurityGoals)
+			if mergeError != nil {
+				return fmt.Errorf("failed to merge cybersecurity goals: %w", mergeError)
+			}
+
+		case strings.ToLower("cybersecurity_claims"):
+			model.CybersecurityClaims, mergeError = new(CybersecurityGoal).MergeMap(model.CybersecurityClaims, includedModel.CybersecurityClaims)
+			if mergeError != nil {
+				return fmt.Errorf("failed to merge cybersecurity claims: %w", mergeError)
+			}
+
+		case strings.ToLower("sub_models"):
+			// the model files of sub-models are relative to the included file
+			subModels := make(map[string]SubModel)
+			for namespace, subModel := range includedModel.SubModels {
+				if !filepath.IsAbs(subModel.Model) {
+					subModel.Model, mergeError = filepath.Abs(filepath.Join(dir, filepath.Dir(includeFilename), subModel.Model))
+					if mergeError != nil {
+						return fmt.Errorf("failed to resolve model of sub-model %q: %w", namespace, mergeError)
+					}
+				}
+				subModels[namespace] = subModel
+			}
+
+			model.SubModels, mergeError = new(SubModel).MergeMap(model.SubModels, subModels)
+			if mergeError != nil {
+				return fmt.Errorf("failed to merge sub-models: %w", mergeError)
+			}
+
+		case strings.ToLower("cross_model_links"):
+			model.CrossModelLinks, mergeError = new(CrossModelLink).MergeMap(model.CrossModelLinks, includedModel.CrossModelLinks)
+			if mergeError != nil {
+				return fmt.Errorf("failed to merge cross-model links: %w", mergeError)
+			}
+
 		case "diagram_tweak_nodesep":
 			model.DiagramTweakNodesep = includedModel.DiagramTweakNodesep
 
@@ -8,6 +8,7 @@ type RiskTracking struct {
 	Ticket        string `yaml:"ticket,omitempty" json:"ticket,omitempty"`
 	Date          string `yaml:"date,omitempty" json:"date,omitempty"`
 	CheckedBy     string `yaml:"checked_by,omitempty" json:"checked_by,omitempty"`
+	Treatment     string `yaml:"treatment,omitempty" json:"treatment,omitempty"`
 }
 
 func (what *RiskTracking) Merge(other RiskTracking) error {
@@ -37,6 +38,11 @@ func (what *RiskTracking) Merge(other RiskTracking) error {
 		return fmt.Errorf("failed to merge checked_by: %w", mergeError)
 	}
 
+	what.Treatment, mergeError = new(Strings).MergeSingleton(what.Treatment, other.Treatment)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge treatment: %w", mergeError)
+	}
+
 	return nil
 }
 
@@ -6,6 +6,7 @@ type SharedRuntime struct {
 	ID                     string   `yaml:"id,omitempty" json:"id,omitempty"`
 	Description            string   `yaml:"description,omitempty" json:"description,omitempty"`
 	Tags                   []string `yaml:"tags,omitempty" json:"tag,omitempty"`
+	SafetyIntegrityLevel   string   `yaml:"safety_integrity_level,omitempty" json:"safety_integrity_level,omitempty"`
 	TechnicalAssetsRunning []string `yaml:"technical_assets_running,omitempty" json:"technical_assets_running,omitempty"`
 }
 
@@ -23,6 +24,11 @@ func (what *SharedRuntime) Merge(other SharedRuntime) error {
 
 	what.Tags = new(Strings).MergeUniqueSlice(what.Tags, other.Tags)
 
+	what.SafetyIntegrityLevel, mergeError = new(Strings).MergeSingleton(what.SafetyIntegrityLevel, other.SafetyIntegrityLevel)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge safety integrity level: %w", mergeError)
+	}
+
 	what.TechnicalAssetsRunning = new(Strings).MergeUniqueSlice(what.TechnicalAssetsRunning, other.TechnicalAssetsRunning)
 
 	return nil
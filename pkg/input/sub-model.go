@@ -0,0 +1,463 @@
+package input
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SubModel is a separately modelled system (like an ECU) composed into the model under its namespace
+type SubModel struct {
+	Model   string   `yaml:"model,omitempty" json:"model,omitempty"`
+	Exports []string `yaml:"exports,omitempty" json:"exports,omitempty"`
+}
+
+// CrossModelLink is a communication link between technical assets of different sub-models, declared by the composing
+// model and added to the communication links of its source technical asset
+type CrossModelLink struct {
+	Source            string `yaml:"source,omitempty" json:"source,omitempty"`
+	CommunicationLink `yaml:",inline"`
+}
+
+func (what *SubModel) Merge(other SubModel) error {
+	var mergeError error
+	what.Model, mergeError = new(Strings).MergeSingleton(what.Model, other.Model)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge model: %w", mergeError)
+	}
+
+	what.Exports = new(Strings).MergeUniqueSlice(what.Exports, other.Exports)
+
+	return nil
+}
+
+func (what *SubModel) MergeMap(first map[string]SubModel, second map[string]SubModel) (map[string]SubModel, error) {
+	for mapKey, mapValue := range second {
+		mapItem, ok := first[mapKey]
+		if ok {
+			mergeError := mapItem.Merge(mapValue)
+			if mergeError != nil {
+				return first, fmt.Errorf("failed to merge sub-model %q: %w", mapKey, mergeError)
+			}
+
+			first[mapKey] = mapItem
+		} else {
+			first[mapKey] = mapValue
+		}
+	}
+
+	return first, nil
+}
+
+func (what *CrossModelLink) Merge(other CrossModelLink) error {
+	var mergeError error
+	what.Source, mergeError = new(Strings).MergeSingleton(what.Source, other.Source)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge source: %w", mergeError)
+	}
+
+	return what.CommunicationLink.Merge(other.CommunicationLink)
+}
+
+func (what *CrossModelLink) MergeMap(first map[string]CrossModelLink, second map[string]CrossModelLink) (map[string]CrossModelLink, error) {
+	for mapKey, mapValue := range second {
+		mapItem, ok := first[mapKey]
+		if ok {
+			mergeError := mapItem.Merge(mapValue)
+			if mergeError != nil {
+				return first, fmt.Errorf("failed to merge cross-model link %q: %w", mapKey, mergeError)
+			}
+
+			first[mapKey] = mapItem
+		} else {
+			first[mapKey] = mapValue
+		}
+	}
+
+	return first, nil
+}
+
+// Compose loads the sub-models (relative to dir), prefixes all their ids with their namespace and merges them into
+// the model. Technical assets of sub-models can be referenced by the model (like in trust boundaries or communication
+// links) by their prefixed id only when exported; cross-model links are added to their source technical asset.
+func (model *Model) Compose(dir string) error {
+	return model.compose(dir, make(map[string]bool))
+}
+
+func (model *Model) compose(dir string, loading map[string]bool) error {
+	if len(model.SubModels) == 0 && len(model.CrossModelLinks) == 0 {
+		return nil
+	}
+
+	// technical assets referenced by the model itself, before merging the sub-models
+	references := model.technicalAssetReferences()
+	for title, link := range model.CrossModelLinks {
+		references[link.Source] = append(references[link.Source], fmt.Sprintf("source of cross-model link %q", title))
+		references[link.Target] = append(references[link.Target], fmt.Sprintf("target of cross-model link %q", title))
+	}
+
+	validNamespace := regexp.MustCompile(`^[a-zA-Z0-9\-]+$`)
+	namespaces := make([]string, 0, len(model.SubModels))
+	for namespace := range model.SubModels {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	notExported := make(map[string]string)
+	for _, namespace := range namespaces {
+		subModel := model.SubModels[namespace]
+		if !validNamespace.MatchString(namespace) {
+			return fmt.Errorf("invalid namespace of sub-model (only letters, numbers, and hyphen allowed): %v", namespace)
+		}
+
+		filename := subModel.Model
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(dir, filename)
+		}
+
+		subModelInput := new(Model).Defaults()
+		loadError := subModelInput.loadComposed(filename, loading)
+		if loadError != nil {
+			return fmt.Errorf("unable to load sub-model %q: %w", namespace, loadError)
+		}
+
+		exports := make(map[string]bool)
+		for _, id := range subModel.Exports {
+			if subModelInput.findTechnicalAsset(id) == "" {
+				return fmt.Errorf("sub-model %q exports unknown technical asset %q", namespace, id)
+			}
+			exports[id] = true
+		}
+
+		for _, technicalAsset := range subModelInput.TechnicalAssets {
+			if !exports[technicalAsset.ID] {
+				notExported[namespacedId(namespace, technicalAsset.ID)] = namespace
+			}
+		}
+
+		subModelInput.addNamespace(namespace)
+		mergeError := model.mergeSubModel(subModelInput)
+		if mergeError != nil {
+			return fmt.Errorf("failed to merge sub-model %q: %w", namespace, mergeError)
+		}
+	}
+
+	for id, usages := range references {
+		if namespace, ok := notExported[id]; ok {
+			return fmt.Errorf("technical asset %q not exported by sub-model %q is referenced as %v", id, namespace, strings.Join(usages, ", "))
+		}
+	}
+
+	titles := make([]string, 0, len(model.CrossModelLinks))
+	for title := range model.CrossModelLinks {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+
+	for _, title := range titles {
+		link := model.CrossModelLinks[title]
+		sourceTitle := model.findTechnicalAsset(link.Source)
+		if sourceTitle == "" {
+			return fmt.Errorf("unknown source technical asset %q of cross-model link %q", link.Source, title)
+		}
+
+		source := model.TechnicalAssets[sourceTitle]
+		if _, exists := source.CommunicationLinks[title]; exists {
+			return fmt.Errorf("cross-model link %q duplicates communication link of technical asset %q", title, link.Source)
+		}
+
+		if source.CommunicationLinks == nil {
+			source.CommunicationLinks = make(map[string]CommunicationLink)
+		}
+		source.CommunicationLinks[title] = link.CommunicationLink
+		model.TechnicalAssets[sourceTitle] = source
+	}
+
+	return nil
+}
+
+// technicalAssetReferences lists the usages of technical asset ids in trust boundaries, shared runtimes and
+// communication links of the model
+func (model *Model) technicalAssetReferences() map[string][]string {
+	references := make(map[string][]string)
+	for title, trustBoundary := range model.TrustBoundaries {
+		for _, id := range trustBoundary.TechnicalAssetsInside {
+			references[id] = append(references[id], fmt.Sprintf("technical asset inside trust boundary %q", title))
+		}
+	}
+
+	for title, sharedRuntime := range model.SharedRuntimes {
+		for _, id := range sharedRuntime.TechnicalAssetsRunning {
+			references[id] = append(references[id], fmt.Sprintf("technical asset running on shared runtime %q", title))
+		}
+	}
+
+	for title, technicalAsset := range model.TechnicalAssets {
+		for linkTitle, link := range technicalAsset.CommunicationLinks {
+			references[link.Target] = append(references[link.Target], fmt.Sprintf("target of communication link %q of technical asset %q", linkTitle, title))
+		}
+
+		for _, id := range technicalAsset.VerifiesAtBoot {
+			references[id] = append(references[id], fmt.Sprintf("verified at boot by technical asset %q", title))
+		}
+	}
+
+	return references
+}
+
+// findTechnicalAsset returns the title (key) of the technical asset with the id or an empty string if there is none
+func (model *Model) findTechnicalAsset(id string) string {
+	for title, technicalAsset := range model.TechnicalAssets {
+		if technicalAsset.ID == id {
+			return title
+		}
+	}
+	return ""
+}
+
+// addNamespace prefixes the ids of all model elements (and all references to them) with the namespace, and the
+// titles of the model elements with the namespace followed by a colon
+func (model *Model) addNamespace(namespace string) {
+	dataAssets := make(map[string]DataAsset)
+	for title, dataAsset := range model.DataAssets {
+		dataAsset.ID = namespacedId(namespace, dataAsset.ID)
+		dataAssets[namespacedTitle(namespace, title)] = dataAsset
+	}
+	model.DataAssets = dataAssets
+
+	technicalAssets := make(map[string]TechnicalAsset)
+	for title, technicalAsset := range model.TechnicalAssets {
+		technicalAsset.ID = namespacedId(namespace, technicalAsset.ID)
+		technicalAsset.DataAssetsProcessed = namespacedIds(namespace, technicalAsset.DataAssetsProcessed)
+		technicalAsset.DataAssetsStored = namespacedIds(namespace, technicalAsset.DataAssetsStored)
+		technicalAsset.VerifiesAtBoot = namespacedIds(namespace, technicalAsset.VerifiesAtBoot)
+
+		communicationLinks := make(map[string]CommunicationLink)
+		for linkTitle, link := range technicalAsset.CommunicationLinks {
+			link.Target = namespacedId(namespace, link.Target)
+			link.DataAssetsSent = namespacedIds(namespace, link.DataAssetsSent)
+			link.DataAssetsReceived = namespacedIds(namespace, link.DataAssetsReceived)
+			communicationLinks[linkTitle] = link
+		}
+		technicalAsset.CommunicationLinks = communicationLinks
+		technicalAssets[namespacedTitle(namespace, title)] = technicalAsset
+	}
+	model.TechnicalAssets = technicalAssets
+
+	trustBoundaries := make(map[string]TrustBoundary)
+	for title, trustBoundary := range model.TrustBoundaries {
+		trustBoundary.ID = namespacedId(namespace, trustBoundary.ID)
+		trustBoundary.TechnicalAssetsInside = namespacedIds(namespace, trustBoundary.TechnicalAssetsInside)
+		trustBoundary.TrustBoundariesNested = namespacedIds(namespace, trustBoundary.TrustBoundariesNested)
+		trustBoundaries[namespacedTitle(namespace, title)] = trustBoundary
+	}
+	model.TrustBoundaries = trustBoundaries
+
+	sharedRuntimes := make(map[string]SharedRuntime)
+	for title, sharedRuntime := range model.SharedRuntimes {
+		sharedRuntime.ID = namespacedId(namespace, sharedRuntime.ID)
+		sharedRuntime.TechnicalAssetsRunning = namespacedIds(namespace, sharedRuntime.TechnicalAssetsRunning)
+		sharedRuntimes[namespacedTitle(namespace, title)] = sharedRuntime
+	}
+	model.SharedRuntimes = sharedRuntimes
+
+	for _, category := range model.CustomRiskCategories {
+		risksIdentified := make(map[string]RiskIdentified)
+		for title, risk := range category.RisksIdentified {
+			risk.DataBreachTechnicalAssets = namespacedIds(namespace, risk.DataBreachTechnicalAssets)
+			risk.MostRelevantDataAsset = namespacedId(namespace, risk.MostRelevantDataAsset)
+			risk.MostRelevantTechnicalAsset = namespacedId(namespace, risk.MostRelevantTechnicalAsset)
+			risk.MostRelevantCommunicationLink = namespacedId(namespace, risk.MostRelevantCommunicationLink)
+			risk.MostRelevantTrustBoundary = namespacedId(namespace, risk.MostRelevantTrustBoundary)
+			risk.MostRelevantSharedRuntime = namespacedId(namespace, risk.MostRelevantSharedRuntime)
+			risksIdentified[namespacedTitle(namespace, title)] = risk
+		}
+		category.RisksIdentified = risksIdentified
+	}
+
+	riskTracking := make(map[string]RiskTracking)
+	for syntheticRiskId, tracking := range model.RiskTracking {
+		riskTracking[namespacedSyntheticRiskId(namespace, syntheticRiskId)] = tracking
+	}
+	model.RiskTracking = riskTracking
+
+	damageScenarios := make(map[string]DamageScenario)
+	for title, damageScenario := range model.DamageScenarios {
+		damageScenario.ID = namespacedId(namespace, damageScenario.ID)
+		damageScenario.Risks = namespacedSyntheticRiskIds(namespace, damageScenario.Risks)
+		damageScenarios[namespacedTitle(namespace, title)] = damageScenario
+	}
+	model.DamageScenarios = damageScenarios
+
+	attackPotentials := make(map[string]AttackPotential)
+	for syntheticRiskId, attackPotential := range model.AttackPotentials {
+		attackPotentials[namespacedSyntheticRiskId(namespace, syntheticRiskId)] = attackPotential
+	}
+	model.AttackPotentials = attackPotentials
+
+	model.CybersecurityGoals = namespacedCybersecurityGoals(namespace, model.CybersecurityGoals)
+	model.CybersecurityClaims = namespacedCybersecurityGoals(namespace, model.CybersecurityClaims)
+
+	model.SecurityRequirements = namespacedTitles(namespace, model.SecurityRequirements)
+	model.Questions = namespacedTitles(namespace, model.Questions)
+	model.AbuseCases = namespacedTitles(namespace, model.AbuseCases)
+}
+
+// mergeSubModel merges the model elements of a namespaced sub-model into the model
+func (model *Model) mergeSubModel(subModel *Model) error {
+	var mergeError error
+	model.SecurityRequirements, mergeError = new(Strings).MergeMap(model.SecurityRequirements, subModel.SecurityRequirements)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge security requirements: %w", mergeError)
+	}
+
+	model.Questions, mergeError = new(Strings).MergeMap(model.Questions, subModel.Questions)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge questions: %w", mergeError)
+	}
+
+	model.AbuseCases, mergeError = new(Strings).MergeMap(model.AbuseCases, subModel.AbuseCases)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge abuse cases: %w", mergeError)
+	}
+
+	model.TagsAvailable = new(Strings).MergeUniqueSlice(model.TagsAvailable, subModel.TagsAvailable)
+
+	model.DataAssets, mergeError = new(DataAsset).MergeMap(model.DataAssets, subModel.DataAssets)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge data assets: %w", mergeError)
+	}
+
+	model.TechnicalAssets, mergeError = new(TechnicalAsset).MergeMap(model.TechnicalAssets, subModel.TechnicalAssets)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge technical assets: %w", mergeError)
+	}
+
+	model.TrustBoundaries, mergeError = new(TrustBoundary).MergeMap(model.TrustBoundaries, subModel.TrustBoundaries)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge trust boundaries: %w", mergeError)
+	}
+
+	model.SharedRuntimes, mergeError = new(SharedRuntime).MergeMap(model.SharedRuntimes, subModel.SharedRuntimes)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge shared runtimes: %w", mergeError)
+	}
+
+	// custom risk categories (like from a shared catalog) may be used by several sub-models
+	for _, category := range subModel.CustomRiskCategories {
+		merged := false
+		for _, existingCategory := range model.CustomRiskCategories {
+			if strings.EqualFold(existingCategory.ID, category.ID) {
+				mergeError = existingCategory.Merge(*category)
+				if mergeError != nil {
+					return fmt.Errorf("failed to merge risk category %q: %w", category.ID, mergeError)
+				}
+				merged = true
+			}
+		}
+
+		if !merged {
+			mergeError = model.CustomRiskCategories.Add(category)
+			if mergeError != nil {
+				return fmt.Errorf("failed to merge risk categories: %w", mergeError)
+			}
+		}
+	}
+
+	model.RiskTracking, mergeError = new(RiskTracking).MergeMap(model.RiskTracking, subModel.RiskTracking)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge risk tracking: %w", mergeError)
+	}
+
+	model.DamageScenarios, mergeError = new(DamageScenario).MergeMap(model.DamageScenarios, subModel.DamageScenarios)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge damage scenarios: %w", mergeError)
+	}
+
+	model.AttackPotentials, mergeError = new(AttackPotential).MergeMap(model.AttackPotentials, subModel.AttackPotentials)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge attack potentials: %w", mergeError)
+	}
+
+	model.CybersecurityGoals, mergeError = new(CybersecurityGoal).MergeMap(model.CybersecurityGoals, subModel.CybersecurityGoals)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge cybersecurity goals: %w", mergeError)
+	}
+
+	model.CybersecurityClaims, mergeError = new(CybersecurityGoal).MergeMap(model.CybersecurityClaims, subModel.CybersecurityClaims)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge cybersecurity claims: %w", mergeError)
+	}
+
+	return nil
+}
+
+func namespacedCybersecurityGoals(namespace string, goals map[string]CybersecurityGoal) map[string]CybersecurityGoal {
+	result := make(map[string]CybersecurityGoal)
+	for title, goal := range goals {
+		goal.ID = namespacedId(namespace, goal.ID)
+		goal.Risks = namespacedSyntheticRiskIds(namespace, goal.Risks)
+		result[namespacedTitle(namespace, title)] = goal
+	}
+	return result
+}
+
+func namespacedTitles(namespace string, items map[string]string) map[string]string {
+	result := make(map[string]string)
+	for title, value := range items {
+		result[namespacedTitle(namespace, title)] = value
+	}
+	return result
+}
+
+func namespacedTitle(namespace string, title string) string {
+	return namespace + ": " + title
+}
+
+func namespacedId(namespace string, id string) string {
+	id = strings.TrimSpace(id)
+	if len(id) == 0 {
+		return id
+	}
+	return namespace + "-" + id
+}
+
+func namespacedIds(namespace string, ids []string) []string {
+	if ids == nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, namespacedId(namespace, id))
+	}
+	return result
+}
+
+// literal parts of synthetic risk ids which are not ids of model elements (see the incomplete model rule)
+var literalSyntheticRiskIdParts = map[string]bool{
+	"cia-technical-asset": true,
+	"cia-data-asset":      true,
+}
+
+// namespacedSyntheticRiskId prefixes all parts of a synthetic risk id (or wildcard pattern) referring to model elements,
+// so that wildcards only match risks within the sub-model, leaving the risk category id and literal parts as they are
+func namespacedSyntheticRiskId(namespace string, syntheticRiskId string) string {
+	parts := strings.Split(strings.TrimSpace(syntheticRiskId), "@")
+	for index := 1; index < len(parts); index++ {
+		if !literalSyntheticRiskIdParts[strings.ToLower(strings.TrimSpace(parts[index]))] {
+			parts[index] = namespacedId(namespace, parts[index])
+		}
+	}
+	return strings.Join(parts, "@")
+}
+
+func namespacedSyntheticRiskIds(namespace string, syntheticRiskIds []string) []string {
+	result := make([]string, 0, len(syntheticRiskIds))
+	for _, syntheticRiskId := range syntheticRiskIds {
+		result = append(result, namespacedSyntheticRiskId(namespace, syntheticRiskId))
+	}
+	return result
+}
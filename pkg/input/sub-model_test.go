@@ -0,0 +1,141 @@
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testEcuModel = `
+data_assets:
+  Signals:
+    id: signals
+technical_assets:
+  Gateway:
+    id: gateway
+    data_assets_processed:
+      - signals
+    communication_links:
+      To Controller:
+        target: controller
+        data_assets_sent:
+          - signals
+  Controller:
+    id: controller
+    justification_cia_rating: TODO rate controller
+trust_boundaries:
+  ECU:
+    id: ecu
+    technical_assets_inside:
+      - gateway
+      - controller
+risk_tracking:
+  unencrypted-communication@*:
+    status: accepted
+  incomplete-model@cia-technical-asset@controller:
+    status: accepted
+`
+
+func writeTestModels(t *testing.T, vehicleModel string) string {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "ecu.yaml"), []byte(testEcuModel), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "vehicle.yaml"), []byte(vehicleModel), 0600))
+	return filepath.Join(dir, "vehicle.yaml")
+}
+
+func TestComposeSubModels(t *testing.T) {
+	filename := writeTestModels(t, `
+sub_models:
+  front:
+    model: ecu.yaml
+    exports:
+      - gateway
+  rear:
+    model: ecu.yaml
+    exports:
+      - gateway
+cross_model_links:
+  Vehicle CAN:
+    source: front-gateway
+    target: rear-gateway
+    protocol: can-bus
+    data_assets_sent:
+      - front-signals
+`)
+
+	model := new(Model).Defaults()
+	assert.NoError(t, model.load(filename))
+
+	assert.Len(t, model.TechnicalAssets, 4)
+	frontGateway := model.TechnicalAssets["front: Gateway"]
+	assert.Equal(t, "front-gateway", frontGateway.ID)
+	assert.Equal(t, []string{"front-signals"}, frontGateway.DataAssetsProcessed)
+	assert.Equal(t, "front-controller", frontGateway.CommunicationLinks["To Controller"].Target)
+	assert.Equal(t, "rear-gateway", frontGateway.CommunicationLinks["Vehicle CAN"].Target)
+	assert.Equal(t, []string{"front-signals"}, frontGateway.CommunicationLinks["Vehicle CAN"].DataAssetsSent)
+	assert.Equal(t, "rear-controller", model.TechnicalAssets["rear: Gateway"].CommunicationLinks["To Controller"].Target)
+
+	assert.Equal(t, []string{"rear-gateway", "rear-controller"}, model.TrustBoundaries["rear: ECU"].TechnicalAssetsInside)
+	assert.Equal(t, "rear-signals", model.DataAssets["rear: Signals"].ID)
+	assert.Contains(t, model.RiskTracking, "unencrypted-communication@front-*")
+	assert.Contains(t, model.RiskTracking, "unencrypted-communication@rear-*")
+	assert.Contains(t, model.RiskTracking, "incomplete-model@cia-technical-asset@front-controller")
+}
+
+func TestComposeSubModelsNotExported(t *testing.T) {
+	filename := writeTestModels(t, `
+sub_models:
+  front:
+    model: ecu.yaml
+    exports:
+      - gateway
+  rear:
+    model: ecu.yaml
+cross_model_links:
+  Vehicle CAN:
+    source: front-gateway
+    target: rear-controller
+`)
+
+	err := new(Model).Defaults().load(filename)
+	assert.ErrorContains(t, err, `technical asset "rear-controller" not exported by sub-model "rear"`)
+}
+
+func TestComposeSubModelsUnknownExport(t *testing.T) {
+	filename := writeTestModels(t, `
+sub_models:
+  front:
+    model: ecu.yaml
+    exports:
+      - unknown
+`)
+
+	err := new(Model).Defaults().load(filename)
+	assert.ErrorContains(t, err, `sub-model "front" exports unknown technical asset "unknown"`)
+}
+
+func TestComposeSubModelsCyclic(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "vehicle.yaml"), []byte(`
+sub_models:
+  ecu:
+    model: ecu.yaml
+`), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "ecu.yaml"), []byte(`
+sub_models:
+  vehicle:
+    model: vehicle.yaml
+`), 0600))
+
+	err := new(Model).Defaults().load(filepath.Join(dir, "vehicle.yaml"))
+	assert.ErrorContains(t, err, "cyclic sub-model")
+}
+
+func TestNamespacedSyntheticRiskId(t *testing.T) {
+	assert.Equal(t, "missing-vault", namespacedSyntheticRiskId("ecu", "missing-vault"))
+	assert.Equal(t, "unencrypted-asset@ecu-db", namespacedSyntheticRiskId("ecu", "unencrypted-asset@db"))
+	assert.Equal(t, "unencrypted-communication@ecu-client@ecu-client>api@ecu-*", namespacedSyntheticRiskId("ecu", "unencrypted-communication@client@client>api@*"))
+	assert.Equal(t, "incomplete-model@cia-data-asset@ecu-signals", namespacedSyntheticRiskId("ecu", "incomplete-model@cia-data-asset@signals"))
+}
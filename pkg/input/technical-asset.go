@@ -21,13 +21,16 @@ type TechnicalAsset struct {
 	Confidentiality         string                       `yaml:"confidentiality,omitempty" json:"confidentiality,omitempty"`
 	Integrity               string                       `yaml:"integrity,omitempty" json:"integrity,omitempty"`
 	Availability            string                       `yaml:"availability,omitempty" json:"availability,omitempty"`
+	SafetyIntegrityLevel    string                       `yaml:"safety_integrity_level,omitempty" json:"safety_integrity_level,omitempty"`
 	JustificationCiaRating  string                       `yaml:"justification_cia_rating,omitempty" json:"justification_cia_rating,omitempty"`
 	MultiTenant             bool                         `yaml:"multi_tenant,omitempty" json:"multi_tenant,omitempty"`
 	Redundant               bool                         `yaml:"redundant,omitempty" json:"redundant,omitempty"`
 	CustomDevelopedParts    bool                         `yaml:"custom_developed_parts,omitempty" json:"custom_developed_parts,omitempty"`
+	HardwareRootOfTrust     bool                         `yaml:"hardware_root_of_trust,omitempty" json:"hardware_root_of_trust,omitempty"`
 	DataAssetsProcessed     []string                     `yaml:"data_assets_processed,omitempty" json:"data_assets_processed,omitempty"`
 	DataAssetsStored        []string                     `yaml:"data_assets_stored,omitempty" json:"data_assets_stored,omitempty"`
 	DataFormatsAccepted     []string                     `yaml:"data_formats_accepted,omitempty" json:"data_formats_accepted,omitempty"`
+	VerifiesAtBoot          []string                     `yaml:"verifies_at_boot,omitempty" json:"verifies_at_boot,omitempty"`
 	DiagramTweakOrder       int                          `yaml:"diagram_tweak_order,omitempty" json:"diagram_tweak_order,omitempty"`
 	CommunicationLinks      map[string]CommunicationLink `yaml:"communication_links,omitempty" json:"communication_links,omitempty"`
 }
@@ -110,6 +113,11 @@ func (what *TechnicalAsset) Merge(other TechnicalAsset) error {
 		return fmt.Errorf("failed to merge availability: %w", mergeError)
 	}
 
+	what.SafetyIntegrityLevel, mergeError = new(Strings).MergeSingleton(what.SafetyIntegrityLevel, other.SafetyIntegrityLevel)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge safety integrity level: %w", mergeError)
+	}
+
 	what.JustificationCiaRating = new(Strings).MergeMultiline(what.JustificationCiaRating, other.JustificationCiaRating)
 
 	if !what.MultiTenant {
@@ -124,12 +132,18 @@ func (what *TechnicalAsset) Merge(other TechnicalAsset) error {
 		what.CustomDevelopedParts = other.CustomDevelopedParts
 	}
 
+	if !what.HardwareRootOfTrust {
+		what.HardwareRootOfTrust = other.HardwareRootOfTrust
+	}
+
 	what.DataAssetsProcessed = new(Strings).MergeUniqueSlice(what.DataAssetsProcessed, other.DataAssetsProcessed)
 
 	what.DataAssetsStored = new(Strings).MergeUniqueSlice(what.DataAssetsStored, other.DataAssetsStored)
 
 	what.DataFormatsAccepted = new(Strings).MergeUniqueSlice(what.DataFormatsAccepted, other.DataFormatsAccepted)
 
+	what.VerifiesAtBoot = new(Strings).MergeUniqueSlice(what.VerifiesAtBoot, other.VerifiesAtBoot)
+
 	if what.DiagramTweakOrder == 0 {
 		what.DiagramTweakOrder = other.DiagramTweakOrder
 	}
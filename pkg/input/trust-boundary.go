@@ -7,6 +7,7 @@ type TrustBoundary struct {
 	Description           string   `yaml:"description,omitempty" json:"description,omitempty"`
 	Type                  string   `yaml:"type,omitempty" json:"type,omitempty"`
 	Tags                  []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	SafetyIntegrityLevel  string   `yaml:"safety_integrity_level,omitempty" json:"safety_integrity_level,omitempty"`
 	TechnicalAssetsInside []string `yaml:"technical_assets_inside,omitempty" json:"technical_assets_inside,omitempty"`
 	TrustBoundariesNested []string `yaml:"trust_boundaries_nested,omitempty" json:"trust_boundaries_nested,omitempty"`
 }
@@ -30,6 +31,11 @@ func (what *TrustBoundary) Merge(other TrustBoundary) error {
 
 	what.Tags = new(Strings).MergeUniqueSlice(what.Tags, other.Tags)
 
+	what.SafetyIntegrityLevel, mergeError = new(Strings).MergeSingleton(what.SafetyIntegrityLevel, other.SafetyIntegrityLevel)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge safety integrity level: %w", mergeError)
+	}
+
 	what.TechnicalAssetsInside = new(Strings).MergeUniqueSlice(what.TechnicalAssetsInside, other.TechnicalAssetsInside)
 
 	what.TrustBoundariesNested = new(Strings).MergeUniqueSlice(what.TrustBoundariesNested, other.TrustBoundariesNested)
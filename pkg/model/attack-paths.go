@@ -0,0 +1,144 @@
+package model
+
+import (
+	"container/heap"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const (
+	maxAttackPathLength                  = 8 // in communication links
+	maxAttackPathsPerEntryPointAndTarget = 3
+)
+
+// applyAttackPathAnalysis searches the paths along the communication links from each entry point to each target
+// and keeps the easiest to follow (the lowest scored) of them for every pair of entry point and target
+func applyAttackPathAnalysis(parsedModel *types.Model, progressReporter types.ProgressReporter) {
+	progressReporter.Info("Applying attack path analysis")
+
+	parsedModel.AttackPaths = make([]*types.AttackPath, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		entryPoint := parsedModel.TechnicalAssets[id]
+		if !entryPoint.IsAttackPathEntryPoint() {
+			continue
+		}
+
+		parsedModel.AttackPaths = append(parsedModel.AttackPaths, searchAttackPaths(parsedModel, entryPoint)...)
+	}
+	sortAttackPaths(parsedModel.AttackPaths)
+}
+
+// searchAttackPaths follows the communication links from the entry point best first, always continuing the easiest
+// path found so far, so the first paths reaching a target are the easiest ones to it. To stay bounded on large and
+// densely linked models every technical asset is passed by at most maxAttackPathsPerEntryPointAndTarget paths: a
+// harder path to an asset only leads to an easier path beyond it where the easier ones would have to pass an asset twice.
+func searchAttackPaths(parsedModel *types.Model, entryPoint *types.TechnicalAsset) []*types.AttackPath {
+	attackPaths := make([]*types.AttackPath, 0)
+	passedByTechnicalAssetId := make(map[string]int)
+	candidates := &attackPathQueue{{
+		EntryPointId:         entryPoint.Id,
+		TargetId:             entryPoint.Id,
+		TechnicalAssetIds:    []string{entryPoint.Id},
+		CommunicationLinkIds: []string{},
+	}}
+	for candidates.Len() > 0 {
+		candidate := heap.Pop(candidates).(*types.AttackPath)
+		if passedByTechnicalAssetId[candidate.TargetId] >= maxAttackPathsPerEntryPointAndTarget {
+			continue
+		}
+		passedByTechnicalAssetId[candidate.TargetId]++
+
+		technicalAsset := parsedModel.TechnicalAssets[candidate.TargetId]
+		if len(candidate.CommunicationLinkIds) > 0 && technicalAsset.IsAttackPathTarget() {
+			attackPaths = append(attackPaths, candidate)
+		}
+		if len(candidate.CommunicationLinkIds) >= maxAttackPathLength {
+			continue
+		}
+
+		for _, communicationLink := range technicalAsset.CommunicationLinksSorted() {
+			target, ok := parsedModel.TechnicalAssets[communicationLink.TargetId]
+			if !ok || slices.Contains(candidate.TechnicalAssetIds, target.Id) {
+				continue
+			}
+
+			heap.Push(candidates, &types.AttackPath{
+				EntryPointId:         entryPoint.Id,
+				TargetId:             target.Id,
+				TechnicalAssetIds:    append(slices.Clone(candidate.TechnicalAssetIds), target.Id),
+				CommunicationLinkIds: append(slices.Clone(candidate.CommunicationLinkIds), communicationLink.Id),
+				Score:                candidate.Score + attackPathLinkScore(parsedModel, communicationLink),
+			})
+		}
+	}
+	return attackPaths
+}
+
+// attackPathQueue is a heap of the attack paths still to be followed, the easiest to follow on top
+type attackPathQueue []*types.AttackPath
+
+func (what attackPathQueue) Len() int           { return len(what) }
+func (what attackPathQueue) Less(i, j int) bool { return isEasierAttackPath(what[i], what[j]) }
+func (what attackPathQueue) Swap(i, j int)      { what[i], what[j] = what[j], what[i] }
+
+func (what *attackPathQueue) Push(item any) {
+	*what = append(*what, item.(*types.AttackPath))
+}
+
+func (what *attackPathQueue) Pop() any {
+	old := *what
+	item := old[len(old)-1]
+	*what = old[:len(old)-1]
+	return item
+}
+
+// attackPathLinkScore rates the protections an attacker has to overcome when following the communication link:
+// its authentication, its encryption and crossing a trust boundary
+func attackPathLinkScore(parsedModel *types.Model, communicationLink *types.CommunicationLink) int {
+	score := 0
+	switch communicationLink.Authentication {
+	case types.NoneAuthentication:
+	case types.ClientCertificate, types.TwoFactor:
+		score += 2
+	default:
+		score += 1
+	}
+	if communicationLink.Protocol.IsEncrypted() || communicationLink.VPN {
+		score++
+	}
+	if isTrustBoundaryCrossing(parsedModel, communicationLink) {
+		score++
+	}
+	return score
+}
+
+func isTrustBoundaryCrossing(parsedModel *types.Model, communicationLink *types.CommunicationLink) bool {
+	sourceTrustBoundary := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[communicationLink.SourceId]
+	targetTrustBoundary := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[communicationLink.TargetId]
+	if sourceTrustBoundary == nil || targetTrustBoundary == nil {
+		return sourceTrustBoundary != targetTrustBoundary
+	}
+	return sourceTrustBoundary.Id != targetTrustBoundary.Id
+}
+
+func sortAttackPaths(attackPaths []*types.AttackPath) {
+	sort.Slice(attackPaths, func(i, j int) bool {
+		return isEasierAttackPath(attackPaths[i], attackPaths[j])
+	})
+}
+
+func isEasierAttackPath(attackPath *types.AttackPath, otherAttackPath *types.AttackPath) bool {
+	if attackPath.Score != otherAttackPath.Score {
+		return attackPath.Score < otherAttackPath.Score
+	}
+	if len(attackPath.CommunicationLinkIds) != len(otherAttackPath.CommunicationLinkIds) {
+		return len(attackPath.CommunicationLinkIds) < len(otherAttackPath.CommunicationLinkIds)
+	}
+	if strings.Join(attackPath.TechnicalAssetIds, ">") != strings.Join(otherAttackPath.TechnicalAssetIds, ">") {
+		return strings.Join(attackPath.TechnicalAssetIds, ">") < strings.Join(otherAttackPath.TechnicalAssetIds, ">")
+	}
+	return strings.Join(attackPath.CommunicationLinkIds, ">") < strings.Join(otherAttackPath.CommunicationLinkIds, ">")
+}
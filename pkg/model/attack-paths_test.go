@@ -0,0 +1,156 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type silentProgressReporter struct{}
+
+func (silentProgressReporter) Info(a ...any)                  {}
+func (silentProgressReporter) Warn(a ...any)                  {}
+func (silentProgressReporter) Error(a ...any)                 {}
+func (silentProgressReporter) Infof(format string, a ...any)  {}
+func (silentProgressReporter) Warnf(format string, a ...any)  {}
+func (silentProgressReporter) Errorf(format string, a ...any) {}
+
+func createAttackPathTestModel() *types.Model {
+	parsedModel := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"cloud":     {Id: "cloud", Type: types.Process, Internet: true, Machine: types.Virtual, Integrity: types.Critical},
+			"tester":    {Id: "tester", Type: types.ExternalEntity, Machine: types.Physical},
+			"gateway":   {Id: "gateway", Type: types.Process, Machine: types.Physical, Integrity: types.Important},
+			"ecu":       {Id: "ecu", Type: types.Process, Machine: types.Physical, Integrity: types.MissionCritical},
+			"hsm":       {Id: "hsm", Type: types.Process, Machine: types.Physical, Integrity: types.MissionCritical},
+			"unrelated": {Id: "unrelated", Type: types.Process, Machine: types.Physical, Integrity: types.MissionCritical},
+		},
+		CommunicationLinks: map[string]*types.CommunicationLink{
+			"cloud>gateway":   {Id: "cloud>gateway", SourceId: "cloud", TargetId: "gateway", Protocol: types.HTTPS, Authentication: types.ClientCertificate},
+			"tester>gateway":  {Id: "tester>gateway", SourceId: "tester", TargetId: "gateway", Protocol: types.HTTP},
+			"gateway>ecu":     {Id: "gateway>ecu", SourceId: "gateway", TargetId: "ecu", Protocol: types.CAN},
+			"gateway>hsm":     {Id: "gateway>hsm", SourceId: "gateway", TargetId: "hsm", Protocol: types.IPC, Authentication: types.Token},
+			"ecu>hsm":         {Id: "ecu>hsm", SourceId: "ecu", TargetId: "hsm", Protocol: types.IPC},
+			"hsm>gateway":     {Id: "hsm>gateway", SourceId: "hsm", TargetId: "gateway", Protocol: types.IPC},
+			"unrelated>cloud": {Id: "unrelated>cloud", SourceId: "unrelated", TargetId: "cloud", Protocol: types.HTTPS},
+		},
+	}
+	for _, communicationLink := range parsedModel.CommunicationLinks {
+		source := parsedModel.TechnicalAssets[communicationLink.SourceId]
+		source.CommunicationLinks = append(source.CommunicationLinks, communicationLink)
+	}
+
+	vehicle := &types.TrustBoundary{Id: "vehicle", TechnicalAssetsInside: []string{"gateway", "ecu", "hsm"}}
+	parsedModel.TrustBoundaries = map[string]*types.TrustBoundary{"vehicle": vehicle}
+	parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId = map[string]*types.TrustBoundary{
+		"gateway": vehicle,
+		"ecu":     vehicle,
+		"hsm":     vehicle,
+	}
+	return parsedModel
+}
+
+func TestApplyAttackPathAnalysis(t *testing.T) {
+	parsedModel := createAttackPathTestModel()
+
+	applyAttackPathAnalysis(parsedModel, silentProgressReporter{})
+
+	paths := make([][]string, 0)
+	scores := make([]int, 0)
+	for _, attackPath := range parsedModel.AttackPaths {
+		paths = append(paths, attackPath.TechnicalAssetIds)
+		scores = append(scores, attackPath.Score)
+	}
+	assert.Equal(t, [][]string{
+		{"tester", "gateway", "ecu"},
+		{"tester", "gateway", "ecu", "hsm"},
+		{"tester", "gateway", "hsm"},
+		{"cloud", "gateway", "ecu"},
+		{"cloud", "gateway", "ecu", "hsm"},
+		{"cloud", "gateway", "hsm"},
+	}, paths)
+	assert.Equal(t, []int{1, 1, 2, 4, 4, 5}, scores)
+
+	assert.Equal(t, []string{"tester>gateway", "gateway>ecu"}, parsedModel.AttackPaths[0].CommunicationLinkIds)
+	assert.Equal(t, "tester", parsedModel.AttackPaths[0].EntryPointId)
+	assert.Equal(t, "ecu", parsedModel.AttackPaths[0].TargetId)
+	assert.Len(t, parsedModel.AttackPathsOfTarget("hsm"), 4)
+	assert.Empty(t, parsedModel.AttackPathsOfTarget("unrelated"))
+}
+
+func TestApplyAttackPathAnalysisKeepsEasiestPaths(t *testing.T) {
+	parsedModel := createAttackPathTestModel()
+	gateway := parsedModel.TechnicalAssets["gateway"]
+	for i := 0; i < maxAttackPathsPerEntryPointAndTarget+2; i++ {
+		id := "relay-" + string(rune('a'+i))
+		parsedModel.TechnicalAssets[id] = &types.TechnicalAsset{Id: id, Type: types.Process, Machine: types.Virtual}
+		gatewayToRelay := &types.CommunicationLink{Id: "gateway>" + id, SourceId: "gateway", TargetId: id, Protocol: types.HTTPS, Authentication: types.Credentials}
+		relayToHsm := &types.CommunicationLink{Id: id + ">hsm", SourceId: id, TargetId: "hsm", Protocol: types.HTTPS}
+		gateway.CommunicationLinks = append(gateway.CommunicationLinks, gatewayToRelay)
+		parsedModel.TechnicalAssets[id].CommunicationLinks = []*types.CommunicationLink{relayToHsm}
+		parsedModel.CommunicationLinks[gatewayToRelay.Id] = gatewayToRelay
+		parsedModel.CommunicationLinks[relayToHsm.Id] = relayToHsm
+	}
+
+	applyAttackPathAnalysis(parsedModel, silentProgressReporter{})
+
+	paths := make([][]string, 0)
+	scores := make([]int, 0)
+	for _, attackPath := range parsedModel.AttackPathsOfTarget("hsm") {
+		if attackPath.EntryPointId == "tester" {
+			paths = append(paths, attackPath.TechnicalAssetIds)
+			scores = append(scores, attackPath.Score)
+		}
+	}
+	assert.Equal(t, [][]string{
+		{"tester", "gateway", "ecu", "hsm"},
+		{"tester", "gateway", "hsm"},
+		{"tester", "gateway", "relay-a", "hsm"},
+	}, paths)
+	assert.Equal(t, []int{1, 2, 6}, scores)
+}
+
+func TestApplyAttackPathAnalysisDenselyLinked(t *testing.T) {
+	parsedModel := &types.Model{
+		TechnicalAssets:    make(map[string]*types.TechnicalAsset),
+		CommunicationLinks: make(map[string]*types.CommunicationLink),
+	}
+	ids := make([]string, 0)
+	for i := 0; i < 40; i++ {
+		id := fmt.Sprintf("asset-%02d", i)
+		ids = append(ids, id)
+		parsedModel.TechnicalAssets[id] = &types.TechnicalAsset{Id: id, Type: types.Process, Machine: types.Physical, Integrity: types.Critical}
+	}
+	parsedModel.TechnicalAssets["asset-00"].Internet = true
+	for _, sourceId := range ids {
+		for _, targetId := range ids {
+			if sourceId != targetId {
+				communicationLink := &types.CommunicationLink{Id: sourceId + ">" + targetId, SourceId: sourceId, TargetId: targetId, Protocol: types.HTTPS}
+				parsedModel.CommunicationLinks[communicationLink.Id] = communicationLink
+				parsedModel.TechnicalAssets[sourceId].CommunicationLinks = append(parsedModel.TechnicalAssets[sourceId].CommunicationLinks, communicationLink)
+			}
+		}
+	}
+
+	applyAttackPathAnalysis(parsedModel, silentProgressReporter{})
+
+	assert.Len(t, parsedModel.AttackPaths, (len(ids)-1)*maxAttackPathsPerEntryPointAndTarget)
+	attackPaths := parsedModel.AttackPathsOfTarget("asset-39")
+	assert.Len(t, attackPaths, maxAttackPathsPerEntryPointAndTarget)
+	assert.Equal(t, []string{"asset-00", "asset-39"}, attackPaths[0].TechnicalAssetIds)
+	assert.Equal(t, 1, attackPaths[0].Score)
+	assert.Equal(t, []string{"asset-00", "asset-01", "asset-39"}, attackPaths[1].TechnicalAssetIds)
+	assert.Equal(t, 2, attackPaths[1].Score)
+}
+
+func TestAttackPathLinkScore(t *testing.T) {
+	parsedModel := createAttackPathTestModel()
+
+	assert.Equal(t, 4, attackPathLinkScore(parsedModel, parsedModel.CommunicationLinks["cloud>gateway"]))
+	assert.Equal(t, 1, attackPathLinkScore(parsedModel, parsedModel.CommunicationLinks["tester>gateway"]))
+	assert.Equal(t, 0, attackPathLinkScore(parsedModel, parsedModel.CommunicationLinks["gateway>ecu"]))
+	assert.Equal(t, 1, attackPathLinkScore(parsedModel, parsedModel.CommunicationLinks["gateway>hsm"]))
+}
@@ -0,0 +1,222 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type explainRiskReporter interface {
+	Println(i ...any)
+}
+
+// ExplainRisk prints which risk rule flagged the risk with the synthetic id, the model elements it matched, why the
+// rule flagged and rated it and the risk tracking applied to it
+func (what ReadResult) ExplainRisk(risk string, reporter explainRiskReporter) error {
+	explanation, err := what.RiskExplanation(risk)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range explanation {
+		reporter.Println(line)
+	}
+
+	return nil
+}
+
+// RiskExplanation explains the risk with the synthetic id line by line
+func (what ReadResult) RiskExplanation(risk string) ([]string, error) {
+	parsedModel := what.ParsedModel
+	generatedRisk, ok := parsedModel.GeneratedRisksBySyntheticId[strings.ToLower(strings.TrimSpace(risk))]
+	if !ok {
+		return nil, fmt.Errorf("risk %q not found in the model", risk)
+	}
+
+	category := parsedModel.GetRiskCategory(generatedRisk.CategoryId)
+	if category == nil {
+		return nil, fmt.Errorf("risk category %q of risk %q not found in the model", generatedRisk.CategoryId, risk)
+	}
+
+	explanation := []string{
+		fmt.Sprintf("Risk %q: %v", generatedRisk.SyntheticId, removeFormattingTags(generatedRisk.Title)),
+		fmt.Sprintf("  - severity: %v (likelihood: %v, impact: %v)", generatedRisk.Severity, generatedRisk.ExploitationLikelihood, generatedRisk.ExploitationImpact),
+	}
+	if generatedRisk.Tara != nil {
+		explanation = append(explanation, fmt.Sprintf("  - TARA risk value: %v (impact: %v, attack feasibility: %v)",
+			generatedRisk.Tara.RiskValue, generatedRisk.Tara.Impact.Title(), generatedRisk.Tara.AttackFeasibility.Title()))
+	}
+
+	rule, origin := what.riskRule(generatedRisk.CategoryId)
+	explanation = append(explanation,
+		"",
+		fmt.Sprintf("Flagged by %v risk rule %q: %v", origin, category.ID, category.Title),
+		fmt.Sprintf("  - detection logic: %v", category.DetectionLogic),
+		fmt.Sprintf("  - risk assessment: %v", category.RiskAssessment),
+		"",
+		"Matched model elements:",
+	)
+	explanation = append(explanation, explainMatchedElements(parsedModel, generatedRisk)...)
+
+	explanation = append(explanation, "", "Why the risk was flagged:")
+	explainer, isExplainer := rule.(types.RiskExplainer)
+	switch {
+	case len(generatedRisk.RiskExplanation) > 0:
+		explanation = append(explanation, indent(generatedRisk.RiskExplanation)...)
+	case isExplainer && len(explainer.ExplainRisk(parsedModel, generatedRisk.SyntheticId)) > 0:
+		explanation = append(explanation, indent(explainer.ExplainRisk(parsedModel, generatedRisk.SyntheticId))...)
+	default:
+		explanation = append(explanation, indent(explainFlagging(parsedModel, category, generatedRisk))...)
+	}
+
+	explanation = append(explanation, "", "Why the risk was rated that way:")
+	if len(generatedRisk.RatingExplanation) > 0 {
+		explanation = append(explanation, indent(generatedRisk.RatingExplanation)...)
+	} else {
+		explanation = append(explanation, indent(explainRating(category, generatedRisk))...)
+	}
+
+	explanation = append(explanation, "", "Risk tracking:")
+	explanation = append(explanation, indent(explainRiskTracking(parsedModel, generatedRisk))...)
+
+	return explanation, nil
+}
+
+func (what ReadResult) riskRule(categoryId string) (types.RiskRule, string) {
+	if rule, ok := what.CustomRiskRules[categoryId]; ok {
+		return rule, "custom"
+	}
+	return what.BuiltinRiskRules[categoryId], "built-in"
+}
+
+func explainMatchedElements(parsedModel *types.Model, risk *types.Risk) []string {
+	explanation := make([]string, 0)
+	if technicalAsset, ok := parsedModel.TechnicalAssets[risk.MostRelevantTechnicalAssetId]; ok {
+		explanation = append(explanation, fmt.Sprintf("  - technical asset %q: %v", technicalAsset.Id, technicalAsset.Title))
+	}
+	if communicationLink, ok := parsedModel.CommunicationLinks[risk.MostRelevantCommunicationLinkId]; ok {
+		explanation = append(explanation, fmt.Sprintf("  - communication link %q: %v (from %q to %q)",
+			communicationLink.Id, communicationLink.Title, communicationLink.SourceId, communicationLink.TargetId))
+	}
+	if dataAsset, ok := parsedModel.DataAssets[risk.MostRelevantDataAssetId]; ok {
+		explanation = append(explanation, fmt.Sprintf("  - data asset %q: %v", dataAsset.Id, dataAsset.Title))
+	}
+	if trustBoundary, ok := parsedModel.TrustBoundaries[risk.MostRelevantTrustBoundaryId]; ok {
+		explanation = append(explanation, fmt.Sprintf("  - trust boundary %q: %v", trustBoundary.Id, trustBoundary.Title))
+	}
+	if sharedRuntime, ok := parsedModel.SharedRuntimes[risk.MostRelevantSharedRuntimeId]; ok {
+		explanation = append(explanation, fmt.Sprintf("  - shared runtime %q: %v", sharedRuntime.Id, sharedRuntime.Title))
+	}
+	if len(risk.DataBreachTechnicalAssetIDs) > 0 {
+		explanation = append(explanation, fmt.Sprintf("  - technical assets with data breach (%v): %v",
+			risk.DataBreachProbability, strings.Join(risk.DataBreachTechnicalAssetIDs, ", ")))
+	}
+	if len(explanation) == 0 {
+		explanation = append(explanation, "  - none (the risk concerns the model as a whole)")
+	}
+	return explanation
+}
+
+// explainFlagging explains risks of rules not explaining themselves by the detection logic of the rule and the
+// properties of the matched technical asset it is based on
+func explainFlagging(parsedModel *types.Model, category *types.RiskCategory, risk *types.Risk) []string {
+	technicalAsset, ok := parsedModel.TechnicalAssets[risk.MostRelevantTechnicalAssetId]
+	if !ok {
+		return []string{fmt.Sprintf("Risk '%v' has been flagged because the model matches the detection logic of the rule", category.Title)}
+	}
+
+	explanation := []string{
+		fmt.Sprintf("Risk '%v' has been flagged for technical asset '%v' because it matches the detection logic of the rule, with", category.Title, technicalAsset.Title),
+		fmt.Sprintf("  - out of scope: %v", technicalAsset.OutOfScope),
+		fmt.Sprintf("  - type: %v", technicalAsset.Type),
+		fmt.Sprintf("  - technologies: %v", technicalAsset.Technologies.String()),
+		fmt.Sprintf("  - internet: %v", technicalAsset.Internet),
+		fmt.Sprintf("  - confidentiality: %v, integrity: %v, availability: %v (highest processed: %v, %v, %v)",
+			technicalAsset.Confidentiality, technicalAsset.Integrity, technicalAsset.Availability,
+			parsedModel.HighestProcessedConfidentiality(technicalAsset), parsedModel.HighestProcessedIntegrity(technicalAsset),
+			parsedModel.HighestProcessedAvailability(technicalAsset)),
+		fmt.Sprintf("  - relative attacker attractiveness: %.0f %%", technicalAsset.RAA),
+	}
+	if len(technicalAsset.Tags) > 0 {
+		explanation = append(explanation, fmt.Sprintf("  - tags: %v", strings.Join(technicalAsset.Tags, ", ")))
+	}
+	if communicationLink, ok := parsedModel.CommunicationLinks[risk.MostRelevantCommunicationLinkId]; ok {
+		explanation = append(explanation,
+			fmt.Sprintf("  - communication link '%v' with protocol: %v, authentication: %v, authorization: %v",
+				communicationLink.Title, communicationLink.Protocol, communicationLink.Authentication, communicationLink.Authorization))
+	}
+	return explanation
+}
+
+func explainRating(category *types.RiskCategory, risk *types.Risk) []string {
+	return []string{
+		fmt.Sprintf("'Severity' is '%v' because 'Exploitation Likelihood' is '%v' and 'Exploitation Impact' is '%v'",
+			risk.Severity, risk.ExploitationLikelihood, risk.ExploitationImpact),
+		fmt.Sprintf("'Data Breach Probability' is '%v'", risk.DataBreachProbability),
+		fmt.Sprintf("according to the risk assessment of the rule: %v", category.RiskAssessment),
+	}
+}
+
+func explainRiskTracking(parsedModel *types.Model, risk *types.Risk) []string {
+	riskTracking := parsedModel.GetRiskTracking(risk)
+	if riskTracking == nil {
+		return []string{fmt.Sprintf("none, so the risk status is '%v'", types.Unchecked)}
+	}
+
+	explanation := make([]string, 0)
+	if len(riskTracking.WildcardPattern) > 0 {
+		explanation = append(explanation, fmt.Sprintf("wildcard entry %q matches, each '*' standing for one part of the synthetic id between '@' signs",
+			riskTracking.WildcardPattern))
+		if others := otherMatchingWildcardPatterns(parsedModel, risk.SyntheticId, riskTracking.WildcardPattern); len(others) > 0 {
+			explanation = append(explanation, fmt.Sprintf("  (also matching, but applied in sorted order after it: %v)", strings.Join(others, ", ")))
+		}
+	} else {
+		explanation = append(explanation, fmt.Sprintf("direct entry %q matches the synthetic id", riskTracking.SyntheticRiskId))
+	}
+
+	explanation = append(explanation, fmt.Sprintf("  - status: %v", riskTracking.Status))
+	if riskTracking.Treatment != types.UndecidedTreatment {
+		explanation = append(explanation, fmt.Sprintf("  - treatment: %v", riskTracking.Treatment))
+	}
+	if len(riskTracking.Justification) > 0 {
+		explanation = append(explanation, fmt.Sprintf("  - justification: %v", riskTracking.Justification))
+	}
+	if len(riskTracking.Ticket) > 0 {
+		explanation = append(explanation, fmt.Sprintf("  - ticket: %v", riskTracking.Ticket))
+	}
+	if len(riskTracking.CheckedBy) > 0 {
+		explanation = append(explanation, fmt.Sprintf("  - checked by: %v", riskTracking.CheckedBy))
+	}
+	if !riskTracking.Date.IsZero() {
+		explanation = append(explanation, fmt.Sprintf("  - date: %v", riskTracking.Date.Format("2006-01-02")))
+	}
+	return explanation
+}
+
+func otherMatchingWildcardPatterns(parsedModel *types.Model, syntheticRiskId string, appliedPattern string) []string {
+	others := make([]string, 0)
+	for pattern := range parsedModel.GetDeferredRiskTrackingDueToWildcardMatching() {
+		if pattern != appliedPattern && types.MatchesWildcardRiskId(pattern, syntheticRiskId) {
+			others = append(others, pattern)
+		}
+	}
+	sort.Strings(others)
+	return others
+}
+
+func indent(lines []string) []string {
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		result = append(result, "  "+line)
+	}
+	return result
+}
+
+func removeFormattingTags(content string) string {
+	result := strings.ReplaceAll(strings.ReplaceAll(content, "<b>", ""), "</b>", "")
+	result = strings.ReplaceAll(strings.ReplaceAll(result, "<i>", ""), "</i>", "")
+	result = strings.ReplaceAll(strings.ReplaceAll(result, "<u>", ""), "</u>", "")
+	return result
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func createExplainTestResult() ReadResult {
+	rule := &riskGenerationTestRule{id: "test-rule"}
+	parsedModel := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"asset-1": {Id: "asset-1", Title: "Asset 1", Type: types.Process},
+		},
+		CustomRiskCategories: []*types.RiskCategory{rule.Category()},
+		RiskTracking:         make(map[string]*types.RiskTracking),
+		GeneratedRisksBySyntheticId: map[string]*types.Risk{
+			"test-rule@asset-1": {
+				CategoryId:                   "test-rule",
+				Title:                        "<b>Test Risk</b> at <b>Asset 1</b>",
+				Severity:                     types.MediumSeverity,
+				SyntheticId:                  "test-rule@asset-1",
+				MostRelevantTechnicalAssetId: "asset-1",
+			},
+		},
+	}
+	return ReadResult{ParsedModel: parsedModel, CustomRiskRules: types.RiskRules{"test-rule": rule}}
+}
+
+func TestRiskExplanationUnknownRisk(t *testing.T) {
+	_, err := createExplainTestResult().RiskExplanation("unknown@asset-1")
+	assert.EqualError(t, err, `risk "unknown@asset-1" not found in the model`)
+}
+
+func TestRiskExplanationOfGoRule(t *testing.T) {
+	explanation, err := createExplainTestResult().RiskExplanation(" Test-Rule@Asset-1 ")
+	assert.NoError(t, err)
+
+	assert.Equal(t, `Risk "test-rule@asset-1": Test Risk at Asset 1`, explanation[0])
+	assert.Contains(t, explanation, `Flagged by custom risk rule "test-rule": test-rule`)
+	assert.Contains(t, explanation, `  - technical asset "asset-1": Asset 1`)
+	assert.Contains(t, explanation, "  Risk 'test-rule' has been flagged for technical asset 'Asset 1' because it matches the detection logic of the rule, with")
+	assert.Contains(t, explanation, "  none, so the risk status is 'unchecked'")
+}
+
+func TestRiskExplanationOfScriptRule(t *testing.T) {
+	result := createExplainTestResult()
+	risk := result.ParsedModel.GeneratedRisksBySyntheticId["test-rule@asset-1"]
+	risk.RiskExplanation = []string{"asset 'asset-1' is a process"}
+	risk.RatingExplanation = []string{"impact is medium"}
+
+	explanation, err := result.RiskExplanation("test-rule@asset-1")
+	assert.NoError(t, err)
+
+	assert.Contains(t, explanation, "  asset 'asset-1' is a process")
+	assert.Contains(t, explanation, "  impact is medium")
+	assert.NotContains(t, explanation, "  'Data Breach Probability' is 'unlikely'")
+}
+
+func TestRiskExplanationOfWildcardRiskTracking(t *testing.T) {
+	result := createExplainTestResult()
+	result.ParsedModel.RiskTracking["test-rule@*"] = &types.RiskTracking{SyntheticRiskId: "test-rule@*", Status: types.Accepted, Justification: "by design"}
+	result.ParsedModel.RiskTracking["*@asset-1"] = &types.RiskTracking{SyntheticRiskId: "*@asset-1", Status: types.Mitigated}
+	assert.NoError(t, result.ParsedModel.ApplyWildcardRiskTrackingEvaluation(true, silentProgressReporter{}))
+
+	explanation, err := result.RiskExplanation("test-rule@asset-1")
+	assert.NoError(t, err)
+
+	assert.Contains(t, explanation, `  wildcard entry "*@asset-1" matches, each '*' standing for one part of the synthetic id between '@' signs`)
+	assert.Contains(t, explanation, "    (also matching, but applied in sorted order after it: test-rule@*)")
+	assert.Contains(t, explanation, "    - status: mitigated")
+}
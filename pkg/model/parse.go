@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,16 +14,30 @@ import (
 
 type technologyMapConfigReader interface {
 	GetAppFolder() string
-	GetTechnologyFilename() string
+	GetTechnologyFiles() []string
 }
 
-func ParseModel(config technologyMapConfigReader, modelInput *input.Model, builtinRiskRules types.RiskRules, customRiskRules types.RiskRules) (*types.Model, error) {
+func ParseModel(config technologyMapConfigReader, modelInput *input.Model, builtinRiskRules types.RiskRules, customRiskRules types.RiskRules, progressReporter types.ProgressReporter) (*types.Model, error) {
 	technologies := make(types.TechnologyMap)
 	technologiesLoadError := technologies.LoadWithConfig(config, "technologies.yaml")
 	if technologiesLoadError != nil {
 		return nil, fmt.Errorf("error loading technologies: %w", technologiesLoadError)
 	}
 
+	unknownAttributes, technologiesCheckError := technologies.UnknownAttributes()
+	if technologiesCheckError != nil {
+		return nil, fmt.Errorf("error checking technologies: %w", technologiesCheckError)
+	}
+
+	technologyNames := make([]string, 0, len(unknownAttributes))
+	for name := range unknownAttributes {
+		technologyNames = append(technologyNames, name)
+	}
+	sort.Strings(technologyNames)
+	for _, name := range technologyNames {
+		progressReporter.Warnf("technology %q has attributes not evaluated by any built-in risk rule: %v", name, strings.Join(unknownAttributes[name], ", "))
+	}
+
 	technologies.PropagateAttributes()
 
 	businessCriticality, err := types.ParseCriticality(modelInput.BusinessCriticality)
@@ -216,6 +231,10 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 		if err != nil {
 			return nil, fmt.Errorf("unknown 'availability' value of technical asset %q: %v", title, asset.Availability)
 		}
+		safetyIntegrityLevel, err := types.ParseSafetyIntegrityLevel(asset.SafetyIntegrityLevel)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'safety_integrity_level' value of technical asset %q: %v", title, asset.SafetyIntegrityLevel)
+		}
 
 		dataFormatsAccepted := make([]types.DataFormat, 0)
 		if asset.DataFormatsAccepted != nil {
@@ -243,6 +262,14 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 				if err != nil {
 					return nil, fmt.Errorf("unknown 'authorization' value of technical asset %q communication link %q: %v", title, commLinkTitle, commLink.Authorization)
 				}
+				messageAuthentication, err := types.ParseMessageAuthentication(commLink.MessageAuthentication)
+				if err != nil {
+					return nil, fmt.Errorf("unknown 'message_authentication' value of technical asset %q communication link %q: %v", title, commLinkTitle, commLink.MessageAuthentication)
+				}
+				e2eProtection, err := types.ParseE2EProtection(commLink.E2EProtection)
+				if err != nil {
+					return nil, fmt.Errorf("unknown 'e2e_protection' value of technical asset %q communication link %q: %v", title, commLinkTitle, commLink.E2EProtection)
+				}
 				usage, err := types.ParseUsage(commLink.Usage)
 				if err != nil {
 					return nil, fmt.Errorf("unknown 'usage' value of technical asset %q communication link %q: %v", title, commLinkTitle, commLink.Usage)
@@ -310,6 +337,8 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 					Protocol:               protocol,
 					Authentication:         authentication,
 					Authorization:          authorization,
+					MessageAuthentication:  messageAuthentication,
+					E2EProtection:          e2eProtection,
 					Usage:                  usage,
 					Tags:                   tags,
 					VPN:                    commLink.VPN,
@@ -355,6 +384,7 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			MultiTenant:             asset.MultiTenant,
 			Redundant:               asset.Redundant,
 			CustomDevelopedParts:    asset.CustomDevelopedParts,
+			HardwareRootOfTrust:     asset.HardwareRootOfTrust,
 			UsedAsClientByHuman:     asset.UsedAsClientByHuman,
 			OutOfScope:              asset.OutOfScope,
 			JustificationOutOfScope: fmt.Sprintf("%v", asset.JustificationOutOfScope),
@@ -362,10 +392,12 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			Confidentiality:         confidentiality,
 			Integrity:               integrity,
 			Availability:            availability,
+			SafetyIntegrityLevel:    safetyIntegrityLevel,
 			JustificationCiaRating:  fmt.Sprintf("%v", asset.JustificationCiaRating),
 			DataAssetsProcessed:     dataAssetsProcessed,
 			DataAssetsStored:        dataAssetsStored,
 			DataFormatsAccepted:     dataFormatsAccepted,
+			VerifiesAtBoot:          lowerCaseAndTrim(asset.VerifiesAtBoot),
 			CommunicationLinks:      communicationLinks,
 			DiagramTweakOrder:       asset.DiagramTweakOrder,
 		}
@@ -454,6 +486,10 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 		if err != nil {
 			return nil, fmt.Errorf("unknown 'type' of trust boundary %q: %v", title, boundary.Type)
 		}
+		trustBoundarySafetyIntegrityLevel, err := types.ParseSafetyIntegrityLevel(boundary.SafetyIntegrityLevel)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'safety_integrity_level' value of trust boundary %q: %v", title, boundary.SafetyIntegrityLevel)
+		}
 		tags, err := parsedModel.CheckTags(lowerCaseAndTrim(boundary.Tags), fmt.Sprintf("trust boundary %q", title))
 		if err != nil {
 			return nil, err
@@ -464,6 +500,7 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			Description:           withDefault(fmt.Sprintf("%v", boundary.Description), title),
 			Type:                  trustBoundaryType,
 			Tags:                  tags,
+			SafetyIntegrityLevel:  trustBoundarySafetyIntegrityLevel,
 			TechnicalAssetsInside: technicalAssetsInside,
 			TrustBoundariesNested: trustBoundariesNested,
 		}
@@ -507,11 +544,16 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 		if err != nil {
 			return nil, err
 		}
+		sharedRuntimeSafetyIntegrityLevel, err := types.ParseSafetyIntegrityLevel(inputRuntime.SafetyIntegrityLevel)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'safety_integrity_level' value of shared runtime %q: %v", title, inputRuntime.SafetyIntegrityLevel)
+		}
 		sharedRuntime := &types.SharedRuntime{
 			Id:                     id,
 			Title:                  title, //fmt.Sprintf("%v", boundary["title"]),
 			Description:            withDefault(fmt.Sprintf("%v", inputRuntime.Description), title),
 			Tags:                   tags,
+			SafetyIntegrityLevel:   sharedRuntimeSafetyIntegrityLevel,
 			TechnicalAssetsRunning: technicalAssetsRunning,
 		}
 		err = checkIdSyntax(id)
@@ -692,6 +734,11 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			return nil, fmt.Errorf("unknown 'status' value of risk tracking %q: %v", syntheticRiskId, riskTracking.Status)
 		}
 
+		treatment, err := types.ParseRiskTreatment(riskTracking.Treatment)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'treatment' value of risk tracking %q: %v", syntheticRiskId, riskTracking.Treatment)
+		}
+
 		tracking := &types.RiskTracking{
 			SyntheticRiskId: strings.TrimSpace(syntheticRiskId),
 			Justification:   justification,
@@ -699,11 +746,125 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			Ticket:          ticket,
 			Date:            types.Date{Time: date},
 			Status:          status,
+			Treatment:       treatment,
 		}
 
 		parsedModel.RiskTracking[syntheticRiskId] = tracking
 	}
 
+	// Damage Scenarios ===============================================================================
+	parsedModel.DamageScenarios = make(map[string]*types.DamageScenario)
+	for title, damageScenario := range modelInput.DamageScenarios {
+		id := fmt.Sprintf("%v", damageScenario.ID)
+
+		safety, err := types.ParseDamageImpact(damageScenario.Safety)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'safety' value of damage scenario %q: %v", title, damageScenario.Safety)
+		}
+		financial, err := types.ParseDamageImpact(damageScenario.Financial)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'financial' value of damage scenario %q: %v", title, damageScenario.Financial)
+		}
+		operational, err := types.ParseDamageImpact(damageScenario.Operational)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'operational' value of damage scenario %q: %v", title, damageScenario.Operational)
+		}
+		privacy, err := types.ParseDamageImpact(damageScenario.Privacy)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'privacy' value of damage scenario %q: %v", title, damageScenario.Privacy)
+		}
+
+		err = checkIdSyntax(id)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := parsedModel.DamageScenarios[id]; exists {
+			return nil, fmt.Errorf("duplicate id used: %v", id)
+		}
+
+		riskIds := make([]string, 0, len(damageScenario.Risks))
+		for _, riskId := range damageScenario.Risks {
+			riskIds = append(riskIds, strings.TrimSpace(riskId))
+		}
+
+		parsedModel.DamageScenarios[id] = &types.DamageScenario{
+			Id:          id,
+			Title:       title,
+			Description: withDefault(fmt.Sprintf("%v", damageScenario.Description), title),
+			Safety:      safety,
+			Financial:   financial,
+			Operational: operational,
+			Privacy:     privacy,
+			RiskIds:     riskIds,
+		}
+	}
+
+	// Attack Potentials ===============================================================================
+	parsedModel.AttackPotentials = make(map[string]*types.AttackPotential)
+	for syntheticRiskId, attackPotential := range modelInput.AttackPotentials {
+		elapsedTime, err := types.ParseElapsedTime(attackPotential.ElapsedTime)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'elapsed_time' value of attack potential %q: %v", syntheticRiskId, attackPotential.ElapsedTime)
+		}
+		expertise, err := types.ParseExpertise(attackPotential.Expertise)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'expertise' value of attack potential %q: %v", syntheticRiskId, attackPotential.Expertise)
+		}
+		knowledgeOfItem, err := types.ParseKnowledgeOfItem(attackPotential.KnowledgeOfItem)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'knowledge_of_item' value of attack potential %q: %v", syntheticRiskId, attackPotential.KnowledgeOfItem)
+		}
+		windowOfOpportunity, err := types.ParseWindowOfOpportunity(attackPotential.WindowOfOpportunity)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'window_of_opportunity' value of attack potential %q: %v", syntheticRiskId, attackPotential.WindowOfOpportunity)
+		}
+		equipment, err := types.ParseEquipment(attackPotential.Equipment)
+		if err != nil {
+			return nil, fmt.Errorf("unknown 'equipment' value of attack potential %q: %v", syntheticRiskId, attackPotential.Equipment)
+		}
+
+		parsedModel.AttackPotentials[strings.TrimSpace(syntheticRiskId)] = &types.AttackPotential{
+			ElapsedTime:         elapsedTime,
+			Expertise:           expertise,
+			KnowledgeOfItem:     knowledgeOfItem,
+			WindowOfOpportunity: windowOfOpportunity,
+			Equipment:           equipment,
+			Justification:       fmt.Sprintf("%v", attackPotential.Justification),
+		}
+	}
+
+	// Cybersecurity Goals and Claims ===============================================================================
+	parsedModel.CybersecurityGoals = make(map[string]*types.CybersecurityGoal)
+	for _, goals := range []struct {
+		input map[string]input.CybersecurityGoal
+		claim bool
+	}{{modelInput.CybersecurityGoals, false}, {modelInput.CybersecurityClaims, true}} {
+		for title, goal := range goals.input {
+			id := fmt.Sprintf("%v", goal.ID)
+
+			err := checkIdSyntax(id)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := parsedModel.CybersecurityGoals[id]; exists {
+				return nil, fmt.Errorf("duplicate id used: %v", id)
+			}
+
+			riskIds := make([]string, 0, len(goal.Risks))
+			for _, riskId := range goal.Risks {
+				riskIds = append(riskIds, strings.TrimSpace(riskId))
+			}
+
+			parsedModel.CybersecurityGoals[id] = &types.CybersecurityGoal{
+				Id:          id,
+				Title:       title,
+				Description: withDefault(fmt.Sprintf("%v", goal.Description), title),
+				Claim:       goals.claim,
+				RiskIds:     riskIds,
+			}
+		}
+	}
+
 	// ====================== model consistency check (linking)
 	for _, technicalAsset := range parsedModel.TechnicalAssets {
 		for _, commLink := range technicalAsset.CommunicationLinks {
@@ -712,6 +873,12 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 				return nil, err
 			}
 		}
+		for _, verifiedAssetId := range technicalAsset.VerifiesAtBoot {
+			err := parsedModel.CheckTechnicalAssetExists(verifiedAssetId, "boot verification of technical asset '"+technicalAsset.Title+"'", false)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	/*
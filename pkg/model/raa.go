@@ -1,19 +1,36 @@
 package model
 
 import (
-	"sort"
+	"math"
 
 	"github.com/threagile/threagile/pkg/types"
 )
 
-func applyRAA(input *types.Model, progressReporter types.ProgressReporter) string {
+func applyRAA(input *types.Model, attractiveness types.Attractiveness, progressReporter types.ProgressReporter) string {
 	progressReporter.Infof("Applying RAA calculation")
 
-	for techAssetID, techAsset := range input.TechnicalAssets {
-		aa := calculateAttackerAttractiveness(input, techAsset)
-		aa += calculatePivotingNeighbourEffectAdjustment(input, techAsset)
-		techAsset.RAA = calculateRelativeAttackerAttractiveness(input, aa)
-		input.TechnicalAssets[techAssetID] = techAsset
+	// score each technical asset only once and determine the min/max of all scores
+	input.AttackerAttractiveness = make(map[string]*types.AttackerAttractiveness)
+	attackerAttractivenessMinimum, attackerAttractivenessMaximum := math.MaxFloat64, -math.MaxFloat64
+	for _, techAsset := range input.TechnicalAssets {
+		breakdown := calculateAttackerAttractiveness(input, attractiveness, techAsset)
+		input.AttackerAttractiveness[techAsset.Id] = breakdown
+		attackerAttractivenessMinimum = min(attackerAttractivenessMinimum, breakdown.Score)
+		attackerAttractivenessMaximum = max(attackerAttractivenessMaximum, breakdown.Score)
+	}
+	if !(attackerAttractivenessMinimum < attackerAttractivenessMaximum) {
+		attackerAttractivenessMaximum = attackerAttractivenessMinimum + 1
+	}
+
+	for _, breakdown := range input.AttackerAttractiveness {
+		breakdown.Relative = calculateRelativeAttackerAttractiveness(breakdown.Score, attackerAttractivenessMinimum, attackerAttractivenessMaximum)
+		breakdown.RAA = breakdown.Relative
+	}
+
+	applyPivotingNeighbourEffect(input, attractiveness.GetPivotingDepth())
+
+	for _, techAsset := range input.TechnicalAssets {
+		techAsset.RAA = input.AttackerAttractiveness[techAsset.Id].RAA
 	}
 	// return intro text (for reporting etc., can be short summary-like)
 	return "For each technical asset the <b>\"Relative Attacker Attractiveness\"</b> (RAA) value was calculated " +
@@ -26,122 +43,100 @@ func applyRAA(input *types.Model, progressReporter types.ProgressReporter) strin
 }
 
 // set the concrete value in relation to the minimum and maximum of all
-func calculateRelativeAttackerAttractiveness(input *types.Model, attractiveness float64) float64 {
-	var attackerAttractivenessMinimum, attackerAttractivenessMaximum, spread float64 = 0, 0, 0
-	if attackerAttractivenessMinimum == 0 || attackerAttractivenessMaximum == 0 {
-		attackerAttractivenessMinimum, attackerAttractivenessMaximum = 9223372036854775807, -9223372036854775808
-		// determine (only one time required) the min/max of all
-		// first create them in memory (see the link replacement below for nested trust boundaries) - otherwise in Go ranging over map is random order
-		// range over them in sorted (hence re-producible) way:
-		keys := make([]string, 0)
-		for k := range input.TechnicalAssets {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-		for _, key := range keys {
-			techAsset := input.TechnicalAssets[key]
-			if calculateAttackerAttractiveness(input, techAsset) > attackerAttractivenessMaximum {
-				attackerAttractivenessMaximum = calculateAttackerAttractiveness(input, techAsset)
-			}
-			if calculateAttackerAttractiveness(input, techAsset) < attackerAttractivenessMinimum {
-				attackerAttractivenessMinimum = calculateAttackerAttractiveness(input, techAsset)
-			}
-		}
-		if !(attackerAttractivenessMinimum < attackerAttractivenessMaximum) {
-			attackerAttractivenessMaximum = attackerAttractivenessMinimum + 1
-		}
-		spread = attackerAttractivenessMaximum - attackerAttractivenessMinimum
-	}
+func calculateRelativeAttackerAttractiveness(attractiveness float64, minimum float64, maximum float64) float64 {
 	// calculate the percent value of the value within the defined min/max range
-	value := attractiveness - attackerAttractivenessMinimum
-	percent := value / spread * 100
+	percent := (attractiveness - minimum) / (maximum - minimum) * 100
 	if percent <= 0 {
 		percent = 1 // since 0 suggests no attacks at all
 	}
 	return percent
 }
 
-// increase the RAA (relative attacker attractiveness) by one third (1/3) of the delta to the highest outgoing neighbour (if positive delta)
-func calculatePivotingNeighbourEffectAdjustment(input *types.Model, techAsset *types.TechnicalAsset) float64 {
-	if techAsset.OutOfScope {
-		return 0
-	}
-	adjustment := 0.0
-	for _, commLink := range techAsset.CommunicationLinks {
-		outgoingNeighbour := input.TechnicalAssets[commLink.TargetId]
-		//if outgoingNeighbour.getTrustBoundary() == techAsset.getTrustBoundary() { // same trust boundary
-		delta := calculateRelativeAttackerAttractiveness(input, calculateAttackerAttractiveness(input, outgoingNeighbour)) - calculateRelativeAttackerAttractiveness(input, calculateAttackerAttractiveness(input, techAsset))
-		if delta > 0 {
-			potentialIncrease := delta / 3
-			//fmt.Println("Positive delta from", techAsset.ID, "to", outgoingNeighbour.ID, "is", delta, "yields to pivoting neighbour effect of an increase of", potentialIncrease)
-			if potentialIncrease > adjustment {
-				adjustment = potentialIncrease
+// increase the RAA (relative attacker attractiveness) by one third (1/3) of the delta to the highest outgoing neighbour (if positive delta),
+// each round takes the increases of the neighbours of the previous round into account and so reaches one hop further
+func applyPivotingNeighbourEffect(input *types.Model, depth int) {
+	for round := 0; round < depth; round++ {
+		neighbourRAA := make(map[string]float64, len(input.AttackerAttractiveness))
+		for id, breakdown := range input.AttackerAttractiveness {
+			neighbourRAA[id] = breakdown.RAA
+		}
+
+		for _, techAsset := range input.TechnicalAssets {
+			if techAsset.OutOfScope {
+				continue
 			}
+			breakdown := input.AttackerAttractiveness[techAsset.Id]
+			for _, commLink := range techAsset.CommunicationLinks {
+				outgoingNeighbourRAA, ok := neighbourRAA[commLink.TargetId]
+				if !ok {
+					continue
+				}
+				potentialIncrease := (outgoingNeighbourRAA - breakdown.Relative) / 3
+				if potentialIncrease <= 0 || potentialIncrease < breakdown.PivotingAdjustment {
+					continue
+				}
+				// prefer the lowest id on equal increases to be independent of the order of the communication links
+				if potentialIncrease > breakdown.PivotingAdjustment || commLink.TargetId < breakdown.PivotingTarget {
+					breakdown.PivotingAdjustment = potentialIncrease
+					breakdown.PivotingTarget = commLink.TargetId
+				}
+			}
+			breakdown.RAA = breakdown.Relative + breakdown.PivotingAdjustment
 		}
-		//}
 	}
-	return adjustment
 }
 
-// The sum of all CIAs of the asset itself (fibonacci scale) plus the sum of the comm-links' transferred CIAs
+// The sum of all CIAs of the asset itself (fibonacci scale) plus the sum of the processed or stored data's and the comm-links' transferred CIAs
 // Multiplied by the quantity values of the data asset for C and I (not A)
-func calculateAttackerAttractiveness(input *types.Model, techAsset *types.TechnicalAsset) float64 {
+func calculateAttackerAttractiveness(input *types.Model, attractiveness types.Attractiveness, techAsset *types.TechnicalAsset) *types.AttackerAttractiveness {
+	breakdown := &types.AttackerAttractiveness{TechnicalAssetId: techAsset.Id, TechnologyFactor: 1, MultiTenantFactor: 1}
 	if techAsset.OutOfScope {
-		return 0
-	}
-	var score = 0.0
-	score += techAsset.Confidentiality.AttackerAttractivenessForAsset()
-	score += techAsset.Integrity.AttackerAttractivenessForAsset()
-	score += techAsset.Availability.AttackerAttractivenessForAsset()
-	for _, dataAssetProcessed := range techAsset.DataAssetsProcessed {
-		dataAsset := input.DataAssets[dataAssetProcessed]
-		score += dataAsset.Confidentiality.AttackerAttractivenessForProcessedOrStoredData() * dataAsset.Quantity.QuantityFactor()
-		score += dataAsset.Integrity.AttackerAttractivenessForProcessedOrStoredData() * dataAsset.Quantity.QuantityFactor()
-		score += dataAsset.Availability.AttackerAttractivenessForProcessedOrStoredData()
+		return breakdown
 	}
-	// NOTE: Assuming all stored data is also processed, this effectively scores stored data twice
-	for _, dataAssetStored := range techAsset.DataAssetsStored {
-		dataAsset := input.DataAssets[dataAssetStored]
-		score += dataAsset.Confidentiality.AttackerAttractivenessForProcessedOrStoredData() * dataAsset.Quantity.QuantityFactor()
-		score += dataAsset.Integrity.AttackerAttractivenessForProcessedOrStoredData() * dataAsset.Quantity.QuantityFactor()
-		score += dataAsset.Availability.AttackerAttractivenessForProcessedOrStoredData()
+
+	breakdown.Asset = attractiveness.AssetScore(techAsset)
+	// NOTE: stored data is usually also processed, so each data asset is scored only once
+	scoredDataAssets := make(map[string]bool)
+	for _, dataAssetIds := range [][]string{techAsset.DataAssetsProcessed, techAsset.DataAssetsStored} {
+		for _, dataAssetId := range dataAssetIds {
+			if scoredDataAssets[dataAssetId] {
+				continue
+			}
+			scoredDataAssets[dataAssetId] = true
+			breakdown.ProcessedOrStoredData += attractiveness.ProcessedOrStoredDataScore(input.DataAssets[dataAssetId])
+		}
 	}
 	// NOTE: To send or receive data effectively is processing that data and it's questionable if the attractiveness increases further
 	for _, dataFlow := range techAsset.CommunicationLinks {
 		for _, dataAssetSent := range dataFlow.DataAssetsSent {
-			dataAsset := input.DataAssets[dataAssetSent]
-			score += dataAsset.Confidentiality.AttackerAttractivenessForInOutTransferredData() * dataAsset.Quantity.QuantityFactor()
-			score += dataAsset.Integrity.AttackerAttractivenessForInOutTransferredData() * dataAsset.Quantity.QuantityFactor()
-			score += dataAsset.Availability.AttackerAttractivenessForInOutTransferredData()
+			breakdown.TransferredData += attractiveness.TransferredDataScore(input.DataAssets[dataAssetSent])
 		}
 
 		for _, dataAssetReceived := range dataFlow.DataAssetsReceived {
-			dataAsset := input.DataAssets[dataAssetReceived]
-			score += dataAsset.Confidentiality.AttackerAttractivenessForInOutTransferredData() * dataAsset.Quantity.QuantityFactor()
-			score += dataAsset.Integrity.AttackerAttractivenessForInOutTransferredData() * dataAsset.Quantity.QuantityFactor()
-			score += dataAsset.Availability.AttackerAttractivenessForInOutTransferredData()
+			breakdown.TransferredData += attractiveness.TransferredDataScore(input.DataAssets[dataAssetReceived])
 		}
 	}
 
 	if techAsset.Technologies.GetAttribute(types.LoadBalancer, types.ReverseProxy) {
-		score = score / 5.5
+		breakdown.TechnologyFactor = 1 / 5.5
 	} else if techAsset.Technologies.GetAttribute(types.Monitoring) {
-		score = score / 5
+		breakdown.TechnologyFactor = 1.0 / 5
 	} else if techAsset.Technologies.GetAttribute(types.ContainerPlatform) {
-		score = score * 5
+		breakdown.TechnologyFactor = 5
 	} else if techAsset.Technologies.GetAttribute(types.Vault) {
-		score = score * 2
+		breakdown.TechnologyFactor = 2
 	} else if techAsset.Technologies.GetAttribute(types.BuildPipeline, types.SourcecodeRepository, types.ArtifactRegistry) {
-		score = score * 2
+		breakdown.TechnologyFactor = 2
 	} else if techAsset.Technologies.GetAttribute(types.IdentityProvider, types.IdentityStoreDatabase, types.IdentityStoreLDAP) {
-		score = score * 2.5
+		breakdown.TechnologyFactor = 2.5
 	} else if techAsset.Type == types.Datastore {
-		score = score * 2
+		breakdown.TechnologyFactor = 2
 	}
 
 	if techAsset.MultiTenant {
-		score = score * 1.5
+		breakdown.MultiTenantFactor = 1.5
 	}
 
-	return score
+	breakdown.Score = (breakdown.Asset + breakdown.ProcessedOrStoredData + breakdown.TransferredData) * breakdown.TechnologyFactor * breakdown.MultiTenantFactor
+	return breakdown
 }
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func createRAATestModel() *types.Model {
+	customerData := &types.DataAsset{Id: "customer-data", Confidentiality: types.Confidential, Integrity: types.Critical, Availability: types.Important, Quantity: types.Many}
+	return &types.Model{
+		DataAssets: map[string]*types.DataAsset{"customer-data": customerData},
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"client": {Id: "client", CommunicationLinks: []*types.CommunicationLink{{TargetId: "gateway"}}},
+			"gateway": {Id: "gateway", CommunicationLinks: []*types.CommunicationLink{
+				{TargetId: "database", DataAssetsSent: []string{"customer-data"}},
+			}},
+			"database": {Id: "database", Type: types.Datastore, Confidentiality: types.StrictlyConfidential,
+				DataAssetsProcessed: []string{"customer-data"}, DataAssetsStored: []string{"customer-data"}},
+			"archive": {Id: "archive", OutOfScope: true, DataAssetsStored: []string{"customer-data"}},
+		},
+	}
+}
+
+func TestApplyRAA(t *testing.T) {
+	parsedModel := createRAATestModel()
+	applyRAA(parsedModel, types.Attractiveness{}, silentProgressReporter{})
+
+	database := parsedModel.AttackerAttractiveness["database"]
+	assert.Equal(t, float64(55+5+5), database.Asset)
+	assert.Equal(t, float64(21*3+13*3+8), database.ProcessedOrStoredData) // stored data is scored only once
+	assert.Equal(t, float64(2), database.TechnologyFactor)
+	assert.Equal(t, (database.Asset+database.ProcessedOrStoredData)*2, database.Score)
+	assert.Equal(t, float64(100), parsedModel.TechnicalAssets["database"].RAA)
+
+	archive := parsedModel.AttackerAttractiveness["archive"]
+	assert.Equal(t, float64(0), archive.Score)
+	assert.Equal(t, float64(1), archive.RAA)
+
+	gateway := parsedModel.AttackerAttractiveness["gateway"]
+	assert.Equal(t, "database", gateway.PivotingTarget)
+	assert.InDelta(t, (100-gateway.Relative)/3, gateway.PivotingAdjustment, 0.0001)
+	assert.Equal(t, gateway.RAA, parsedModel.TechnicalAssets["gateway"].RAA)
+
+	// the client only pivots to the gateway's own attractiveness with the default depth of one hop
+	client := parsedModel.AttackerAttractiveness["client"]
+	assert.InDelta(t, (gateway.Relative-client.Relative)/3, client.PivotingAdjustment, 0.0001)
+
+	assert.Equal(t, []string{"database", "gateway", "client", "archive"}, sortedTechnicalAssetIds(parsedModel))
+}
+
+func TestApplyRAAPivotingDepth(t *testing.T) {
+	parsedModel := createRAATestModel()
+	applyRAA(parsedModel, types.Attractiveness{PivotingDepth: 2}, silentProgressReporter{})
+
+	gateway := parsedModel.AttackerAttractiveness["gateway"]
+	client := parsedModel.AttackerAttractiveness["client"]
+	assert.Equal(t, "gateway", client.PivotingTarget)
+	assert.InDelta(t, (gateway.RAA-client.Relative)/3, client.PivotingAdjustment, 0.0001)
+}
+
+func TestApplyRAAWeights(t *testing.T) {
+	parsedModel := createRAATestModel()
+	applyRAA(parsedModel, types.Attractiveness{Confidentiality: types.AttackerFocus{Asset: 1}}, silentProgressReporter{})
+
+	assert.Equal(t, float64(89+5+5), parsedModel.AttackerAttractiveness["database"].Asset)
+}
+
+func sortedTechnicalAssetIds(parsedModel *types.Model) []string {
+	ids := make([]string, 0)
+	for _, breakdown := range parsedModel.SortedAttackerAttractiveness() {
+		ids = append(ids, breakdown.TechnicalAssetId)
+	}
+	return ids
+}
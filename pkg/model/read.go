@@ -5,7 +5,7 @@ import (
 	"gopkg.in/yaml.v3"
 	"os"
 	"path/filepath"
-	"strings"
+	"time"
 
 	"github.com/threagile/threagile/pkg/input"
 	"github.com/threagile/threagile/pkg/types"
@@ -19,16 +19,6 @@ type ReadResult struct {
 	CustomRiskRules  types.RiskRules
 }
 
-type explainRiskConfig interface {
-}
-
-type explainRiskReporter interface {
-}
-
-func (what ReadResult) ExplainRisk(cfg explainRiskConfig, risk string, reporter explainRiskReporter) error {
-	return fmt.Errorf("not implemented")
-}
-
 // TODO: consider about splitting this function into smaller ones for better reusability
 
 type configReader interface {
@@ -55,9 +45,13 @@ type configReader interface {
 	GetJsonTechnicalAssetsFilename() string
 	GetJsonStatsFilename() string
 	GetTemplateFilename() string
-	GetTechnologyFilename() string
+	GetTechnologyFiles() []string
 	GetRiskRulePlugins() []string
+	GetXsamCatalogs() []string
+	GetXsamMappingFile() string
 	GetSkipRiskRules() []string
+	GetRiskRuleWorkers() int
+	GetRiskRuleTimeout() int
 	GetExecuteModelMacro() string
 	GetRiskExcelConfigHideColumns() []string
 	GetRiskExcelConfigSortByColumns() []string
@@ -72,6 +66,9 @@ type configReader interface {
 	GetAddLegend() bool
 	GetKeepDiagramSourceFiles() bool
 	GetIgnoreOrphanedRiskTracking() bool
+	GetSkipAttackPathAnalysis() bool
+	GetTaraRiskMatrix() map[string]map[string]int
+	GetAttractiveness() types.Attractiveness
 	GetThreagileVersion() string
 	GetProgressReporter() types.ProgressReporter
 }
@@ -80,7 +77,7 @@ func ReadAndAnalyzeModel(config configReader, builtinRiskRules types.RiskRules,
 	progressReporter.Infof("Writing into output directory: %v", config.GetOutputFolder())
 	progressReporter.Infof("Parsing model: %v", config.GetInputFile())
 
-	customRiskRules := LoadCustomRiskRules(config.GetPluginFolder(), config.GetRiskRulePlugins(), progressReporter)
+	customRiskRules := LoadCustomAndXsamRiskRules(config, builtinRiskRules, progressReporter)
 
 	modelInput := new(input.Model).Defaults()
 	loadError := modelInput.Load(config.GetInputFile())
@@ -98,14 +95,20 @@ func ReadAndAnalyzeModel(config configReader, builtinRiskRules types.RiskRules,
 
 func AnalyzeModel(modelInput *input.Model, config configReader, builtinRiskRules types.RiskRules, customRiskRules types.RiskRules, progressReporter types.ProgressReporter) (*ReadResult, error) {
 
-	parsedModel, parseError := ParseModel(config, modelInput, builtinRiskRules, customRiskRules)
+	parsedModel, parseError := ParseModel(config, modelInput, builtinRiskRules, customRiskRules, progressReporter)
 	if parseError != nil {
 		return nil, fmt.Errorf("unable to parse model yaml: %w", parseError)
 	}
 
-	introTextRAA := applyRAA(parsedModel, progressReporter)
+	introTextRAA := applyRAA(parsedModel, config.GetAttractiveness(), progressReporter)
+	if !config.GetSkipAttackPathAnalysis() {
+		applyAttackPathAnalysis(parsedModel, progressReporter)
+	}
 
-	applyRiskGeneration(parsedModel, builtinRiskRules.Merge(customRiskRules), config.GetSkipRiskRules(), progressReporter)
+	// custom risk rules overriding built-in ones have been warned about when loading them
+	riskRules := make(types.RiskRules).Merge(builtinRiskRules).Merge(customRiskRules)
+	applyRiskGeneration(parsedModel, riskRules, config.GetSkipRiskRules(),
+		config.GetRiskRuleWorkers(), time.Duration(config.GetRiskRuleTimeout())*time.Second, progressReporter)
 	err := parsedModel.ApplyWildcardRiskTrackingEvaluation(config.GetIgnoreOrphanedRiskTracking(), progressReporter)
 	if err != nil {
 		return nil, fmt.Errorf("unable to apply wildcard risk tracking evaluation: %w", err)
@@ -116,6 +119,17 @@ func AnalyzeModel(modelInput *input.Model, config configReader, builtinRiskRules
 		return nil, fmt.Errorf("unable to check risk tracking: %w", err)
 	}
 
+	err = parsedModel.CheckCybersecurityGoals(config.GetIgnoreOrphanedRiskTracking(), progressReporter)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check cybersecurity goals: %w", err)
+	}
+
+	riskMatrix, err := types.ParseRiskMatrix(config.GetTaraRiskMatrix())
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply TARA rating: %w", err)
+	}
+	parsedModel.ApplyTaraRating(riskMatrix, progressReporter)
+
 	return &ReadResult{
 		ModelInput:       modelInput,
 		ParsedModel:      parsedModel,
@@ -125,57 +139,6 @@ func AnalyzeModel(modelInput *input.Model, config configReader, builtinRiskRules
 	}, nil
 }
 
-func applyRiskGeneration(parsedModel *types.Model, rules types.RiskRules,
-	skipRiskRules []string,
-	progressReporter types.ProgressReporter) {
-	progressReporter.Info("Applying risk generation")
-
-	skippedRules := make(map[string]bool)
-	if len(skipRiskRules) > 0 {
-		for _, id := range skipRiskRules {
-			skippedRules[id] = true
-		}
-	}
-
-	for id, rule := range rules {
-		_, ok := skippedRules[id]
-		if ok {
-			progressReporter.Infof("Skipping risk rule: %v", id)
-			delete(skippedRules, id)
-			continue
-		}
-
-		parsedModel.AddToListOfSupportedTags(rule.SupportedTags())
-		newRisks, riskError := rule.GenerateRisks(parsedModel)
-		if riskError != nil {
-			progressReporter.Warnf("Error generating risks for %q: %v", id, riskError)
-			continue
-		}
-
-		if len(newRisks) > 0 {
-			parsedModel.GeneratedRisksByCategory[id] = newRisks
-		}
-	}
-
-	if len(skippedRules) > 0 {
-		keys := make([]string, 0)
-		for k := range skippedRules {
-			keys = append(keys, k)
-		}
-		if len(keys) > 0 {
-			progressReporter.Infof("Unknown risk rules to skip: %v", keys)
-		}
-	}
-
-	// save also in map keyed by synthetic risk-id
-	for _, category := range parsedModel.SortedRiskCategories() {
-		someRisks := parsedModel.SortedRisksOfCategory(category)
-		for _, risk := range someRisks {
-			parsedModel.GeneratedRisksBySyntheticId[strings.ToLower(risk.SyntheticId)] = risk
-		}
-	}
-}
-
 func writeToFile(name string, item any, filename string, progressReporter types.ProgressReporter) {
 	if item == nil {
 		return
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+var errRiskRuleTimedOut = errors.New("risk rule timed out")
+
+type riskGenerationResult struct {
+	risks []*types.Risk
+	err   error
+}
+
+// applyRiskGeneration evaluates the risk rules concurrently by the given number of workers (all CPUs if less than one),
+// each rule for at most the given timeout (no timeout if zero). The generated risks and the evaluations of the rules
+// are stored independently of the order the rules finish in.
+func applyRiskGeneration(parsedModel *types.Model, rules types.RiskRules,
+	skipRiskRules []string, workers int, timeout time.Duration,
+	progressReporter types.ProgressReporter) {
+	progressReporter.Info("Applying risk generation")
+
+	skippedRules := make(map[string]bool)
+	for _, id := range skipRiskRules {
+		skippedRules[id] = true
+	}
+
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		if skippedRules[id] {
+			delete(skippedRules, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range skipRiskRules {
+		if _, ok := rules[id]; ok {
+			progressReporter.Infof("Skipping risk rule: %v", id)
+		}
+	}
+
+	if len(skippedRules) > 0 {
+		keys := make([]string, 0)
+		for k := range skippedRules {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		progressReporter.Infof("Unknown risk rules to skip: %v", keys)
+	}
+
+	for _, id := range ids {
+		parsedModel.AddToListOfSupportedTags(rules[id].SupportedTags())
+	}
+
+	// a rule timing out keeps running in the background, so with a timeout the rules evaluate a snapshot of the model
+	// instead of the model modified by the later stages of the analysis (like applying the risk tracking)
+	evaluatedModel := parsedModel
+	if timeout > 0 {
+		snapshot, snapshotError := snapshotModel(parsedModel)
+		if snapshotError != nil {
+			progressReporter.Warnf("Evaluating risk rules without timeout: %v", snapshotError)
+			timeout = 0
+		} else {
+			evaluatedModel = snapshot
+		}
+	}
+
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	workers = min(workers, len(ids))
+
+	generatedRisks := make([][]*types.Risk, len(ids))
+	evaluations := make([]*types.RiskRuleEvaluation, len(ids))
+	jobs := make(chan int)
+	var waitGroup sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for index := range jobs {
+				generatedRisks[index], evaluations[index] = evaluateRiskRule(evaluatedModel, ids[index], rules[ids[index]], timeout)
+			}
+		}()
+	}
+	for index := range ids {
+		jobs <- index
+	}
+	close(jobs)
+	waitGroup.Wait()
+
+	for index, evaluation := range evaluations {
+		switch {
+		case evaluation.TimedOut:
+			progressReporter.Warnf("Timeout generating risks for %q after %v", evaluation.RiskRuleId, timeout)
+		case len(evaluation.Error) > 0:
+			progressReporter.Warnf("Error generating risks for %q: %v", evaluation.RiskRuleId, evaluation.Error)
+		default:
+			progressReporter.Infof("Risk rule %q generated %d risks in %v", evaluation.RiskRuleId, evaluation.Risks, evaluation.Duration)
+		}
+
+		if len(generatedRisks[index]) > 0 {
+			parsedModel.GeneratedRisksByCategory[evaluation.RiskRuleId] = generatedRisks[index]
+		}
+	}
+	parsedModel.RiskRuleEvaluations = evaluations
+
+	// save also in map keyed by synthetic risk-id
+	for _, category := range parsedModel.SortedRiskCategories() {
+		someRisks := parsedModel.SortedRisksOfCategory(category)
+		for _, risk := range someRisks {
+			parsedModel.GeneratedRisksBySyntheticId[strings.ToLower(risk.SyntheticId)] = risk
+		}
+	}
+}
+
+// evaluateRiskRule generates the risks of a rule sorted by their synthetic id
+func evaluateRiskRule(parsedModel *types.Model, id string, rule types.RiskRule, timeout time.Duration) ([]*types.Risk, *types.RiskRuleEvaluation) {
+	evaluation := &types.RiskRuleEvaluation{RiskRuleId: id}
+
+	start := time.Now()
+	risks, riskError := generateRisks(parsedModel, rule, timeout)
+	evaluation.Duration = time.Since(start)
+
+	if riskError != nil {
+		evaluation.TimedOut = errors.Is(riskError, errRiskRuleTimedOut)
+		evaluation.Error = riskError.Error()
+		return nil, evaluation
+	}
+
+	sort.SliceStable(risks, func(i, j int) bool {
+		return risks[i].SyntheticId < risks[j].SyntheticId
+	})
+	evaluation.Risks = len(risks)
+
+	return risks, evaluation
+}
+
+// snapshotModel copies the model by a JSON round trip (like custom risk rule plugins receive it), the generated risks
+// only refer to the ids of the model elements and therefore apply to the model as well
+func snapshotModel(parsedModel *types.Model) (*types.Model, error) {
+	data, marshalError := json.Marshal(parsedModel)
+	if marshalError != nil {
+		return nil, fmt.Errorf("unable to take snapshot of model: %w", marshalError)
+	}
+
+	snapshot := new(types.Model)
+	unmarshalError := json.Unmarshal(data, snapshot)
+	if unmarshalError != nil {
+		return nil, fmt.Errorf("unable to take snapshot of model: %w", unmarshalError)
+	}
+	return snapshot, nil
+}
+
+// generateRisks gives up waiting for the rule after the timeout, a rule timing out keeps running in the background
+// until it returns though (on a snapshot of the model, see applyRiskGeneration)
+func generateRisks(parsedModel *types.Model, rule types.RiskRule, timeout time.Duration) ([]*types.Risk, error) {
+	if timeout <= 0 {
+		return rule.GenerateRisks(parsedModel)
+	}
+
+	done := make(chan riskGenerationResult, 1)
+	go func() {
+		risks, err := rule.GenerateRisks(parsedModel)
+		done <- riskGenerationResult{risks: risks, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-done:
+		return result.risks, result.err
+	case <-timer.C:
+		return nil, errRiskRuleTimedOut
+	}
+}
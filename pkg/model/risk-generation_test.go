@@ -0,0 +1,127 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type riskGenerationTestRule struct {
+	id     string
+	risks  int
+	delay  time.Duration
+	err    error
+	models chan<- *types.Model
+}
+
+func (what *riskGenerationTestRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{ID: what.id, Title: what.id}
+}
+
+func (what *riskGenerationTestRule) SupportedTags() []string {
+	return []string{what.id + "-tag"}
+}
+
+func (what *riskGenerationTestRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	if what.models != nil {
+		what.models <- parsedModel
+	}
+	time.Sleep(what.delay)
+	if what.err != nil {
+		return nil, what.err
+	}
+
+	risks := make([]*types.Risk, 0)
+	for i := what.risks; i > 0; i-- {
+		risks = append(risks, &types.Risk{
+			CategoryId:  what.id,
+			Severity:    types.MediumSeverity,
+			SyntheticId: fmt.Sprintf("%v@asset-%d", what.id, i),
+		})
+	}
+	return risks, nil
+}
+
+func createRiskGenerationTestModel(rules types.RiskRules) *types.Model {
+	parsedModel := &types.Model{
+		AllSupportedTags:            make(map[string]bool),
+		GeneratedRisksByCategory:    make(map[string][]*types.Risk),
+		GeneratedRisksBySyntheticId: make(map[string]*types.Risk),
+	}
+	for _, rule := range rules {
+		parsedModel.CustomRiskCategories = append(parsedModel.CustomRiskCategories, rule.Category())
+	}
+	return parsedModel
+}
+
+func TestApplyRiskGenerationIsDeterministic(t *testing.T) {
+	rules := types.RiskRules{
+		"slow":    &riskGenerationTestRule{id: "slow", risks: 3, delay: 20 * time.Millisecond},
+		"fast":    &riskGenerationTestRule{id: "fast", risks: 2},
+		"none":    &riskGenerationTestRule{id: "none"},
+		"failing": &riskGenerationTestRule{id: "failing", err: errors.New("broken plugin")},
+		"skipped": &riskGenerationTestRule{id: "skipped", risks: 1},
+	}
+
+	for _, workers := range []int{0, 1, 4} {
+		parsedModel := createRiskGenerationTestModel(rules)
+		applyRiskGeneration(parsedModel, rules, []string{"skipped", "unknown"}, workers, 0, silentProgressReporter{})
+
+		assert.Len(t, parsedModel.GeneratedRisksByCategory, 2)
+		syntheticIds := make([]string, 0)
+		for _, risk := range parsedModel.GeneratedRisksByCategory["slow"] {
+			syntheticIds = append(syntheticIds, risk.SyntheticId)
+		}
+		assert.Equal(t, []string{"slow@asset-1", "slow@asset-2", "slow@asset-3"}, syntheticIds)
+		assert.Len(t, parsedModel.GeneratedRisksBySyntheticId, 5)
+		assert.Equal(t, map[string]bool{"slow-tag": true, "fast-tag": true, "none-tag": true, "failing-tag": true}, parsedModel.AllSupportedTags)
+
+		ids := make([]string, 0)
+		for _, evaluation := range parsedModel.RiskRuleEvaluations {
+			ids = append(ids, evaluation.RiskRuleId)
+		}
+		assert.Equal(t, []string{"failing", "fast", "none", "slow"}, ids)
+		assert.Equal(t, "broken plugin", parsedModel.RiskRuleEvaluations[0].Error)
+		assert.Equal(t, 2, parsedModel.RiskRuleEvaluations[1].Risks)
+		assert.GreaterOrEqual(t, parsedModel.RiskRuleEvaluations[3].Duration, 20*time.Millisecond)
+	}
+}
+
+func TestApplyRiskGenerationTimeout(t *testing.T) {
+	rules := types.RiskRules{
+		"hanging": &riskGenerationTestRule{id: "hanging", risks: 1, delay: 2 * time.Second},
+		"fast":    &riskGenerationTestRule{id: "fast", risks: 1},
+	}
+
+	parsedModel := createRiskGenerationTestModel(rules)
+	applyRiskGeneration(parsedModel, rules, nil, 2, 50*time.Millisecond, silentProgressReporter{})
+
+	assert.Contains(t, parsedModel.GeneratedRisksByCategory, "fast")
+	assert.NotContains(t, parsedModel.GeneratedRisksByCategory, "hanging")
+	assert.False(t, parsedModel.RiskRuleEvaluations[0].TimedOut)
+	assert.True(t, parsedModel.RiskRuleEvaluations[1].TimedOut)
+	assert.Less(t, parsedModel.RiskRuleEvaluations[1].Duration, time.Second)
+}
+
+func TestApplyRiskGenerationTimeoutEvaluatesSnapshot(t *testing.T) {
+	models := make(chan *types.Model, 1)
+	rules := types.RiskRules{
+		"hanging": &riskGenerationTestRule{id: "hanging", delay: 200 * time.Millisecond, models: models},
+	}
+
+	parsedModel := createRiskGenerationTestModel(rules)
+	parsedModel.TechnicalAssets = map[string]*types.TechnicalAsset{"asset": {Id: "asset", Title: "Asset"}}
+	applyRiskGeneration(parsedModel, rules, nil, 1, 50*time.Millisecond, silentProgressReporter{})
+
+	// the later stages of the analysis modify the model while the rule timed out keeps running
+	parsedModel.TechnicalAssets["asset"].Title = "Modified"
+
+	evaluatedModel := <-models
+	assert.NotSame(t, parsedModel, evaluatedModel)
+	assert.Equal(t, "Asset", evaluatedModel.TechnicalAssets["asset"].Title)
+	assert.True(t, parsedModel.RiskRuleEvaluations[0].TimedOut)
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/risks/xsam"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func LoadXsamRiskRules(catalogs []string, mappingFile string, reporter types.ProgressReporter) types.RiskRules {
+	xsamRiskRules := make(types.RiskRules)
+	catalogList := make([]string, 0)
+	for _, catalog := range catalogs {
+		if len(strings.TrimSpace(catalog)) > 0 {
+			catalogList = append(catalogList, catalog)
+		}
+	}
+
+	if len(catalogList) > 0 {
+		reporter.Info("Loading XSAM threat catalogs:", strings.Join(catalogList, ", "))
+
+		rules, loadError := xsam.LoadRiskRules(catalogList, mappingFile, reporter)
+		if loadError != nil {
+			reporter.Warnf("XSAM threat catalogs not loaded: %v", loadError)
+			return xsamRiskRules
+		}
+
+		xsamRiskRules.Merge(rules)
+		reporter.Infof("Loaded %d risk rules from XSAM threat catalogs", len(xsamRiskRules))
+	}
+
+	return xsamRiskRules
+}
+
+type riskRulesConfigReader interface {
+	GetPluginFolder() string
+	GetRiskRulePlugins() []string
+	GetXsamCatalogs() []string
+	GetXsamMappingFile() string
+}
+
+// LoadCustomAndXsamRiskRules loads the custom risk rules of the plugins and the risk rules of the XSAM threat catalogs,
+// warning once about each of them overriding a built-in or custom risk rule with the same id (the analysis of models
+// merges them silently)
+func LoadCustomAndXsamRiskRules(config riskRulesConfigReader, builtinRiskRules types.RiskRules, reporter types.ProgressReporter) types.RiskRules {
+	customRiskRules := LoadCustomRiskRules(config.GetPluginFolder(), config.GetRiskRulePlugins(), reporter)
+	customRiskRules = MergeRiskRules(customRiskRules, "custom", LoadXsamRiskRules(config.GetXsamCatalogs(), config.GetXsamMappingFile(), reporter), "XSAM", reporter)
+	warnAboutOverriddenRiskRules(builtinRiskRules, "built-in", customRiskRules, "custom", reporter)
+	return customRiskRules
+}
+
+// MergeRiskRules merges the other risk rules (of the other kind, like "XSAM") into the risk rules, warning about each
+// risk rule overriding one with the same id
+func MergeRiskRules(riskRules types.RiskRules, kind string, otherRules types.RiskRules, otherKind string, reporter types.ProgressReporter) types.RiskRules {
+	warnAboutOverriddenRiskRules(riskRules, kind, otherRules, otherKind, reporter)
+	return riskRules.Merge(otherRules)
+}
+
+func warnAboutOverriddenRiskRules(riskRules types.RiskRules, kind string, otherRules types.RiskRules, otherKind string, reporter types.ProgressReporter) {
+	ids := make([]string, 0, len(otherRules))
+	for id := range otherRules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if _, exists := riskRules[id]; exists {
+			reporter.Warnf("%v risk rule %q overrides %v risk rule with the same id", otherKind, id, kind)
+		}
+	}
+}
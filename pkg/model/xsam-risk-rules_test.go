@@ -0,0 +1,63 @@
+package model
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type warningsProgressReporter struct {
+	silentProgressReporter
+	warnings []string
+}
+
+func (what *warningsProgressReporter) Warnf(format string, a ...any) {
+	what.warnings = append(what.warnings, fmt.Sprintf(format, a...))
+}
+
+func TestMergeRiskRules(t *testing.T) {
+	builtin := new(CustomRiskCategory)
+	xsam := new(CustomRiskCategory)
+	other := new(CustomRiskCategory)
+	reporter := new(warningsProgressReporter)
+
+	riskRules := MergeRiskRules(types.RiskRules{"rule": builtin}, "built-in", types.RiskRules{"rule": xsam, "other": other}, "XSAM", reporter)
+
+	assert.Len(t, riskRules, 2)
+	assert.Same(t, xsam, riskRules["rule"])
+	assert.Same(t, other, riskRules["other"])
+	assert.Equal(t, []string{`XSAM risk rule "rule" overrides built-in risk rule with the same id`}, reporter.warnings)
+}
+
+type riskRulesTestConfig struct {
+	xsamCatalogs []string
+}
+
+func (what *riskRulesTestConfig) GetPluginFolder() string      { return "" }
+func (what *riskRulesTestConfig) GetRiskRulePlugins() []string { return nil }
+func (what *riskRulesTestConfig) GetXsamCatalogs() []string    { return what.xsamCatalogs }
+func (what *riskRulesTestConfig) GetXsamMappingFile() string   { return "" }
+
+func TestLoadCustomAndXsamRiskRules(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "catalog.xsam")
+	assert.NoError(t, os.WriteFile(filename, []byte(`<RootNodes>
+	<ThreatsCatalog name="test">
+		<ThreatClasses>
+			<ThreatClass name="T.S.001" title="Identity spoofing"/>
+			<ThreatClass name="T.D.001" title="Flooding"/>
+		</ThreatClasses>
+	</ThreatsCatalog>
+</RootNodes>`), 0600))
+	builtinRiskRules := types.RiskRules{"test-t-s-001": new(CustomRiskCategory)}
+	reporter := new(warningsProgressReporter)
+
+	customRiskRules := LoadCustomAndXsamRiskRules(&riskRulesTestConfig{xsamCatalogs: []string{"test=" + filename}}, builtinRiskRules, reporter)
+
+	assert.Len(t, customRiskRules, 2)
+	assert.Len(t, builtinRiskRules, 1)
+	assert.Equal(t, []string{`custom risk rule "test-t-s-001" overrides built-in risk rule with the same id`}, reporter.warnings)
+}
@@ -232,6 +232,10 @@ func (adoc adocReport) WriteReport(model *types.Model,
 	if err != nil {
 		return err
 	}
+	err = adoc.writeModelDiff()
+	if err != nil {
+		return fmt.Errorf("error creating model changes: %w", err)
+	}
 
 	err = adoc.writeImpactInitialRisks()
 	if err != nil {
@@ -267,6 +271,18 @@ func (adoc adocReport) WriteReport(model *types.Model,
 	if err != nil {
 		return fmt.Errorf("error creating abuse cases: %w", err)
 	}
+	err = adoc.writeTara()
+	if err != nil {
+		return fmt.Errorf("error creating TARA damage scenarios: %w", err)
+	}
+	err = adoc.writeCybersecurityGoals()
+	if err != nil {
+		return fmt.Errorf("error creating cybersecurity goals: %w", err)
+	}
+	err = adoc.writeR155Compliance()
+	if err != nil {
+		return fmt.Errorf("error creating UN R155 compliance: %w", err)
+	}
 	err = adoc.writeTagListing()
 	if err != nil {
 		return fmt.Errorf("error creating tag listing: %w", err)
@@ -283,6 +299,10 @@ func (adoc adocReport) WriteReport(model *types.Model,
 	if err != nil {
 		return fmt.Errorf("error creating RAA: %w", err)
 	}
+	err = adoc.writeAttackPaths()
+	if err != nil {
+		return fmt.Errorf("error creating attack paths: %w", err)
+	}
 	err = adoc.writeDataRiskMapping(dataAssetDiagramFilenamePNG)
 	if err != nil {
 		return fmt.Errorf("error creating data risk mapping: %w", err)
@@ -1035,6 +1055,170 @@ func (adoc adocReport) writeAbuseCases() error {
 	return nil
 }
 
+func taraRiskValueText(risk *types.Risk) string {
+	if risk.Tara == nil {
+		return ""
+	}
+	return " TARA risk value is _" + strconv.Itoa(risk.Tara.RiskValue) + "_ with _" + risk.Tara.AttackFeasibility.Title() + "_ attack feasibility."
+}
+
+func (adoc adocReport) tara(f *os.File) {
+	writeLine(f, "= TARA Damage Scenarios")
+	writeLine(f, "This chapter lists the damage scenarios of the threat analysis and risk assessment (TARA) according to ISO/SAE 21434. "+
+		"The impact of a damage scenario is the highest of its safety, financial, operational and privacy impact ratings. "+
+		"The risk value (1-5) of each risk linked to a damage scenario is taken from the risk matrix by impact and attack feasibility.")
+	writeLine(f, "\n")
+	for _, damageScenario := range adoc.model.SortedDamageScenarios() {
+		writeLine(f, damageScenario.Title+" ("+damageScenario.Id+")::")
+		if len(damageScenario.Description) > 0 {
+			writeLine(f, "  "+damageScenario.Description)
+			writeLine(f, "+")
+		}
+		writeLine(f, "  Impact is _"+damageScenario.Impact().Title()+"_ (safety: "+damageScenario.Safety.Title()+
+			", financial: "+damageScenario.Financial.Title()+", operational: "+damageScenario.Operational.Title()+
+			", privacy: "+damageScenario.Privacy.Title()+").")
+		risks := adoc.model.RisksOfDamageScenario(damageScenario)
+		if len(risks) == 0 {
+			writeLine(f, "+")
+			writeLine(f, "  [GreyText]#No risks are linked to this damage scenario.#")
+		}
+		for _, risk := range risks {
+			writeLine(f, "  * Risk value *"+strconv.Itoa(risk.Tara.RiskValue)+"* ("+risk.Tara.AttackFeasibility.Title()+
+				" attack feasibility): "+fixBasicHtml(risk.Title))
+		}
+		writeLine(f, "")
+	}
+}
+
+func (adoc adocReport) writeTara() error {
+	if !adoc.model.IsTaraMode() {
+		return nil
+	}
+
+	filename := "085_TARA.adoc"
+	ta, err := os.Create(filepath.Join(adoc.targetDirectory, filename))
+	defer func() { _ = ta.Close() }()
+	if err != nil {
+		return err
+	}
+
+	adoc.tara(ta)
+	adoc.writeMainLine("<<<")
+	adoc.writeMainLine("include::" + filename + "[leveloffset=+1]")
+	return nil
+}
+
+func (adoc adocReport) cybersecurityGoals(f *os.File) {
+	writeLine(f, "= Cybersecurity Goals and Claims")
+	writeLine(f, "This chapter lists the cybersecurity goals derived from risks treated by reduction and the cybersecurity claims "+
+		"stating the rationale for risks being shared or retained according to ISO/SAE 21434, each with the risks covered by it.")
+	writeLine(f, "\n")
+	for _, goal := range adoc.model.SortedCybersecurityGoals() {
+		kind := "Goal"
+		if goal.Claim {
+			kind = "Claim"
+		}
+		writeLine(f, kind+": "+goal.Title+" ("+goal.Id+")::")
+		if len(goal.Description) > 0 {
+			writeLine(f, "  "+goal.Description)
+		}
+		risks := adoc.model.RisksOfCybersecurityGoal(goal)
+		if len(risks) == 0 {
+			writeLine(f, "+")
+			writeLine(f, "  [GreyText]#No risks are covered by this "+goal.Kind()+".#")
+		}
+		for _, risk := range risks {
+			tracking := adoc.model.GetRiskTrackingWithDefault(risk)
+			writeLine(f, "  * _"+risk.Severity.Title()+"_ risk ("+tracking.Status.Title()+", treatment "+
+				tracking.Treatment.Title()+"): "+fixBasicHtml(risk.Title))
+		}
+		writeLine(f, "")
+	}
+}
+
+func (adoc adocReport) writeCybersecurityGoals() error {
+	if len(adoc.model.CybersecurityGoals) == 0 {
+		return nil
+	}
+
+	filename := "087_CybersecurityGoals.adoc"
+	cg, err := os.Create(filepath.Join(adoc.targetDirectory, filename))
+	defer func() { _ = cg.Close() }()
+	if err != nil {
+		return err
+	}
+
+	adoc.cybersecurityGoals(cg)
+	adoc.writeMainLine("<<<")
+	adoc.writeMainLine("include::" + filename + "[leveloffset=+1]")
+	return nil
+}
+
+func (adoc adocReport) r155Compliance(f *os.File, catalog *types.R155Catalog) {
+	coverage := adoc.model.R155Coverage(catalog)
+	uncovered := 0
+	for _, threatCoverage := range coverage {
+		if !threatCoverage.IsCovered() {
+			uncovered++
+		}
+	}
+
+	writeLine(f, "= UN R155 Annex 5 Compliance")
+	writeLine(f, "This chapter lists the threats of UN Regulation No. 155 Annex 5 (part A) with the mitigations to them (parts B and C), "+
+		"each with the risks identified by the risk rules mapped to the threat and their status from the risk tracking. "+
+		"Of the *"+strconv.Itoa(len(coverage))+"* threats, *"+strconv.Itoa(uncovered)+"* are not covered by any evaluated risk rule "+
+		"and need to be considered separately.")
+	writeLine(f, "\n")
+	for _, threatCoverage := range coverage {
+		threat := threatCoverage.Threat
+		if threatCoverage.IsCovered() {
+			writeLine(f, threat.Id+". "+threat.Title+"::")
+		} else {
+			writeLine(f, "[ModelFailure]#"+threat.Id+". "+threat.Title+"#::")
+		}
+		writeLine(f, "  [GreyText]#"+threat.Group+"#")
+		for _, mitigation := range threat.Mitigations {
+			writeLine(f, "+")
+			writeLine(f, "  _"+mitigation+"_: "+catalog.Mitigations[mitigation])
+		}
+		if !threatCoverage.IsCovered() {
+			writeLine(f, "+")
+			writeLine(f, "  [ModelFailure]#Not covered by any evaluated risk rule.#")
+		} else if len(threatCoverage.Risks) == 0 {
+			writeLine(f, "+")
+			writeLine(f, "  [GreyText]#No risks identified by "+strconv.Itoa(len(threatCoverage.RiskCategories))+" evaluated risk rules.#")
+		}
+		for _, risk := range threatCoverage.Risks {
+			tracking := adoc.model.GetRiskTrackingWithDefault(risk)
+			writeLine(f, "  * _"+risk.Severity.Title()+"_ risk ("+tracking.Status.Title()+"): "+fixBasicHtml(risk.Title))
+		}
+		writeLine(f, "")
+	}
+}
+
+func (adoc adocReport) writeR155Compliance() error {
+	if !adoc.model.IsR155Mode() {
+		return nil
+	}
+
+	catalog, err := types.LoadR155Annex5()
+	if err != nil {
+		return err
+	}
+
+	filename := "088_R155Compliance.adoc"
+	rc, err := os.Create(filepath.Join(adoc.targetDirectory, filename))
+	defer func() { _ = rc.Close() }()
+	if err != nil {
+		return err
+	}
+
+	adoc.r155Compliance(rc, catalog)
+	adoc.writeMainLine("<<<")
+	adoc.writeMainLine("include::" + filename + "[leveloffset=+1]")
+	return nil
+}
+
 func (adoc adocReport) tagListing(f *os.File) {
 	writeLine(f, "= Tag Listing")
 
@@ -1296,6 +1480,42 @@ func (adoc adocReport) writeRAA(introTextRAA string) error {
 	return nil
 }
 
+func (adoc adocReport) attackPaths(f *os.File) int {
+	writeLine(f, "= Attack Paths")
+	writeLine(f, ":fn-attack-paths: footnote:attackpath[Attack path paragraphs are clickable and link to the corresponding chapter of the target.]")
+	writeLine(f, "")
+	writeLine(f, attackPathsIntroText+"{fn-attack-paths}")
+	writeLine(f, "")
+
+	if len(adoc.model.AttackPaths) == 0 {
+		writeLine(f, "[GreyText]#No attack paths from entry points to technical assets with high integrity requirements have been found.#")
+	}
+	for _, attackPath := range adoc.model.AttackPaths {
+		writeLine(f, "<<"+attackPath.TargetId+",*"+attackPathTitle(adoc.model, attackPath)+"*: score "+strconv.Itoa(attackPath.Score)+">>::")
+		for _, communicationLinkId := range attackPath.CommunicationLinkIds {
+			writeLine(f, "  * "+attackPathLinkText(adoc.model, communicationLinkId))
+		}
+		writeLine(f, "")
+	}
+	return len(adoc.model.AttackPaths)
+}
+
+func (adoc adocReport) writeAttackPaths() error {
+	filename := "125_AttackPaths.adoc"
+	f, err := os.Create(filepath.Join(adoc.targetDirectory, filename))
+	defer func() { _ = f.Close() }()
+	if err != nil {
+		return err
+	}
+
+	nAttackPaths := adoc.attackPaths(f)
+	if nAttackPaths > 0 || !adoc.hideEmptyChapter {
+		adoc.writeMainLine("<<<")
+		adoc.writeMainLine("include::" + filename + "[leveloffset=+1]")
+	}
+	return nil
+}
+
 func (adoc adocReport) dataRiskMapping(f *os.File, diagramFilenamePNG string) {
 	writeLine(f, "= Data Mapping")
 
@@ -1521,6 +1741,10 @@ func (adoc adocReport) riskTrackingStatus(f *os.File, risk *types.Risk) {
 		if len(ticket) == 0 {
 			ticket = "-"
 		}
+		treatment := ""
+		if tracking.Treatment != types.UndecidedTreatment {
+			treatment = "\n4+|[.GreyText.small]#Treatment: " + tracking.Treatment.Title() + "#"
+		}
 		writeLine(f, `
 [cols="a,c,c,2c",frame=none,grid=none,options="unbreakable"]
 |===
@@ -1529,7 +1753,7 @@ func (adoc adocReport) riskTrackingStatus(f *os.File, risk *types.Risk) {
 | [.GreyText.small]#`+tracking.CheckedBy+`#
 | [.GreyText.small]#`+ticket+`#
 
-4+|[.small]#`+justificationStr+`#
+4+|[.small]#`+justificationStr+`#`+treatment+`
 |===
 `)
 	} else {
@@ -1655,7 +1879,7 @@ func (adoc adocReport) riskCategories(f *os.File) {
 				colorPrefix = ""
 				colorSuffix = ""
 			}
-			writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+colorSuffix)
+			writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+taraRiskValueText(risk)+colorSuffix)
 			linkId := ""
 			if len(risk.MostRelevantSharedRuntimeId) > 0 {
 				linkId = risk.MostRelevantSharedRuntimeId
@@ -1779,7 +2003,7 @@ func (adoc adocReport) technicalAssets(f *os.File) {
 					colorSuffix = ""
 				}
 				writeLine(f, "\n==== "+colorPrefix+titleOfSeverity(risk.Severity)+colorSuffix+"\n")
-				writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+colorSuffix)
+				writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+taraRiskValueText(risk)+colorSuffix)
 				writeLine(f, "")
 
 				writeLine(f, "<<"+risk.CategoryId+",[SmallGrey]#"+risk.SyntheticId+"#>>")
@@ -1831,8 +2055,11 @@ func (adoc adocReport) technicalAssets(f *os.File) {
 | Owner:             | `+technicalAsset.Owner+`
 | Confidentiality:   | `+technicalAsset.Confidentiality.String()+`<<ref-confidentiality-values,*>>
 | Integrity:         | `+technicalAsset.Integrity.String()+`<<ref-criticality-values,*>>
-| Availability:      | `+technicalAsset.Availability.String()+`<<ref-criticality-values,*>>
-| CIA-Justification: | `+technicalAsset.JustificationCiaRating)
+| Availability:      | `+technicalAsset.Availability.String()+`<<ref-criticality-values,*>>`)
+		if safetyIntegrityLevel := adoc.model.SafetyIntegrityLevelOfTechnicalAsset(technicalAsset); safetyIntegrityLevel != types.QM {
+			writeLine(f, "| Safety Integrity:  | "+safetyIntegrityLevel.Title())
+		}
+		writeLine(f, "| CIA-Justification: | "+technicalAsset.JustificationCiaRating)
 		if technicalAsset.OutOfScope {
 			writeLine(f, "| Asset Out-of-Scope Justification: 2+| "+technicalAsset.JustificationOutOfScope)
 		}
@@ -0,0 +1,93 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// CheckResult compares the risks of a model with the risks of a baseline. The check fails if new risks not yet
+// covered by risk tracking have at least the severity to fail on.
+type CheckResult struct {
+	types.RiskDelta
+	Baseline       string             `json:"baseline"`
+	FailOnSeverity types.RiskSeverity `json:"fail_on_severity"`
+	Failing        []*types.Risk      `json:"failing"`
+	Passed         bool               `json:"passed"`
+}
+
+func Check(parsedModel *types.Model, baselineFilename string, baseline []*types.Risk, failOnSeverity types.RiskSeverity) *CheckResult {
+	risks := make([]*types.Risk, 0)
+	for _, categoryRisks := range parsedModel.GeneratedRisksByCategoryWithCurrentStatus() {
+		risks = append(risks, categoryRisks...)
+	}
+
+	result := &CheckResult{
+		RiskDelta:      *types.CompareRisks(baseline, risks),
+		Baseline:       baselineFilename,
+		FailOnSeverity: failOnSeverity,
+		Failing:        make([]*types.Risk, 0),
+	}
+	for _, risk := range result.New {
+		if risk.RiskStatus == types.Unchecked && risk.Severity >= failOnSeverity {
+			result.Failing = append(result.Failing, risk)
+		}
+	}
+	result.Passed = len(result.Failing) == 0
+
+	return result
+}
+
+// Summary describes the result of the check line by line
+func (what *CheckResult) Summary() []string {
+	summary := []string{
+		fmt.Sprintf("Risks compared to baseline %q: %d new, %d resolved, %d rerated",
+			what.Baseline, len(what.New), len(what.Resolved), len(what.Rerated)),
+	}
+
+	if len(what.New) > 0 {
+		summary = append(summary, "", "New risks:")
+		for _, risk := range what.New {
+			summary = append(summary, fmt.Sprintf("  - [%v, %v] %v: %v", risk.Severity, risk.RiskStatus, risk.SyntheticId, removeFormattingTags(risk.Title)))
+		}
+	}
+
+	if len(what.Resolved) > 0 {
+		summary = append(summary, "", "Resolved risks:")
+		for _, risk := range what.Resolved {
+			summary = append(summary, fmt.Sprintf("  - [%v] %v: %v", risk.Severity, risk.SyntheticId, removeFormattingTags(risk.Title)))
+		}
+	}
+
+	if len(what.Rerated) > 0 {
+		summary = append(summary, "", "Rerated risks:")
+		for _, change := range what.Rerated {
+			summary = append(summary, fmt.Sprintf("  - [%v -> %v] %v: %v", change.BaselineSeverity, change.Severity, change.SyntheticId, removeFormattingTags(change.Title)))
+		}
+	}
+
+	summary = append(summary, "")
+	if what.Passed {
+		summary = append(summary, fmt.Sprintf("PASSED: no new unchecked risks with severity %v or above", what.FailOnSeverity))
+	} else {
+		summary = append(summary, fmt.Sprintf("FAILED: %d new unchecked risks with severity %v or above", len(what.Failing), what.FailOnSeverity))
+	}
+
+	return summary
+}
+
+func WriteCheckJSON(result *CheckResult, filename string) error {
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal check result to JSON: %w", err)
+	}
+	_ = os.MkdirAll(filepath.Dir(filename), 0750)
+	err = os.WriteFile(filename, jsonBytes, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write check result to JSON file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const modelDiffIntroText = "This chapter lists the changes of the model compared to its previous version: the technical assets, " +
+	"communication links, data assets and trust boundaries added, removed or modified (matched by their id) " +
+	"as well as the risks identified additionally, not anymore or with a different severity (matched by their synthetic id)."
+
+// ModelDiffSummary describes the changes between two versions of a model line by line
+func ModelDiffSummary(diff *types.ModelDiff) []string {
+	summary := []string{fmt.Sprintf("Changes from %q to %q:", diff.From, diff.To)}
+	summary = append(summary, elementsDiffSummary("Technical assets", diff.TechnicalAssets)...)
+	summary = append(summary, elementsDiffSummary("Communication links", diff.CommunicationLinks)...)
+	summary = append(summary, elementsDiffSummary("Data assets", diff.DataAssets)...)
+	summary = append(summary, elementsDiffSummary("Trust boundaries", diff.TrustBoundaries)...)
+
+	summary = append(summary, "", fmt.Sprintf("Risks: %d new, %d resolved, %d rerated",
+		len(diff.Risks.New), len(diff.Risks.Resolved), len(diff.Risks.Rerated)))
+	for _, risk := range diff.Risks.New {
+		summary = append(summary, fmt.Sprintf("  + [%v] %v: %v", risk.Severity, risk.SyntheticId, removeFormattingTags(risk.Title)))
+	}
+	for _, risk := range diff.Risks.Resolved {
+		summary = append(summary, fmt.Sprintf("  - [%v] %v: %v", risk.Severity, risk.SyntheticId, removeFormattingTags(risk.Title)))
+	}
+	for _, change := range diff.Risks.Rerated {
+		summary = append(summary, fmt.Sprintf("  ~ [%v -> %v] %v: %v", change.BaselineSeverity, change.Severity, change.SyntheticId, removeFormattingTags(change.Title)))
+	}
+
+	return summary
+}
+
+func elementsDiffSummary(kind string, diff *types.ElementsDiff) []string {
+	summary := []string{"", fmt.Sprintf("%v: %d added, %d removed, %d modified", kind, len(diff.Added), len(diff.Removed), len(diff.Modified))}
+	for _, id := range diff.Added {
+		summary = append(summary, "  + "+id)
+	}
+	for _, id := range diff.Removed {
+		summary = append(summary, "  - "+id)
+	}
+	for _, change := range diff.Modified {
+		summary = append(summary, fmt.Sprintf("  ~ %v: %v", change.Id, change.Title))
+		for _, property := range change.Properties {
+			summary = append(summary, "      "+propertyChangeText(property))
+		}
+	}
+	return summary
+}
+
+func propertyChangeText(change *types.PropertyChange) string {
+	return change.Property + ": " + propertyValueText(change.From) + " -> " + propertyValueText(change.To)
+}
+
+func propertyValueText(value string) string {
+	if len(value) == 0 {
+		return "(unset)"
+	}
+	return value
+}
+
+func WriteModelDiffJSON(diff *types.ModelDiff, filename string) error {
+	jsonBytes, err := json.Marshal(diff)
+	if err != nil {
+		return fmt.Errorf("failed to marshal model diff to JSON: %w", err)
+	}
+	_ = os.MkdirAll(filepath.Dir(filename), 0750)
+	err = os.WriteFile(filename, jsonBytes, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write model diff to JSON file: %w", err)
+	}
+	return nil
+}
+
+func (r *pdfReporter) createModelDiff(parsedModel *types.Model) {
+	diff := parsedModel.ModelDiff
+	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
+	r.pdf.SetTextColor(0, 0, 0)
+	chapTitle := "Model Changes"
+	r.addHeadline(chapTitle, false)
+	r.defineLinkTarget("{model-changes}")
+	r.currentChapterTitleBreadcrumb = chapTitle
+
+	html := r.pdf.HTMLBasicNew()
+	html.Write(5, modelDiffIntroText+"<br><br>Compared versions: <b>"+uni(diff.From)+"</b> and <b>"+uni(diff.To)+"</b>")
+	r.pdfColorBlack()
+	if diff.IsEmpty() {
+		r.pdfColorLightGray()
+		html.Write(5, "<br><br>No changes have been found.")
+		r.pdfColorBlack()
+		return
+	}
+
+	r.addModelDiffElements("Technical Assets", diff.TechnicalAssets)
+	r.addModelDiffElements("Communication Links", diff.CommunicationLinks)
+	r.addModelDiffElements("Data Assets", diff.DataAssets)
+	r.addModelDiffElements("Trust Boundaries", diff.TrustBoundaries)
+
+	lines := make([]string, 0)
+	for _, risk := range diff.Risks.New {
+		lines = append(lines, "New <i>"+risk.Severity.Title()+"</i> risk: "+uni(risk.Title))
+	}
+	for _, risk := range diff.Risks.Resolved {
+		lines = append(lines, "Resolved <i>"+risk.Severity.Title()+"</i> risk: "+uni(risk.Title))
+	}
+	for _, change := range diff.Risks.Rerated {
+		lines = append(lines, "Rerated risk from <i>"+change.BaselineSeverity.Title()+"</i> to <i>"+change.Severity.Title()+"</i>: "+uni(change.Title))
+	}
+	r.addModelDiffSection("Risks: "+strconv.Itoa(len(diff.Risks.New))+" new, "+strconv.Itoa(len(diff.Risks.Resolved))+" resolved, "+
+		strconv.Itoa(len(diff.Risks.Rerated))+" rerated", lines)
+}
+
+func (r *pdfReporter) addModelDiffElements(kind string, diff *types.ElementsDiff) {
+	if diff.IsEmpty() {
+		return
+	}
+
+	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
+	lines := make([]string, 0)
+	for _, id := range diff.Added {
+		lines = append(lines, "Added: <b>"+uni(id)+"</b>")
+	}
+	for _, id := range diff.Removed {
+		lines = append(lines, "Removed: <b>"+uni(id)+"</b>")
+	}
+	for _, change := range diff.Modified {
+		lines = append(lines, "Modified: <b>"+uni(change.Id)+"</b> ("+uni(change.Title)+")")
+		for _, property := range change.Properties {
+			lines = append(lines, "    "+uni(propertyChangeText(property)))
+		}
+	}
+	r.addModelDiffSection(kind+": "+strconv.Itoa(len(diff.Added))+" added, "+strconv.Itoa(len(diff.Removed))+" removed, "+
+		strconv.Itoa(len(diff.Modified))+" modified", lines)
+}
+
+func (r *pdfReporter) addModelDiffSection(title string, lines []string) {
+	html := r.pdf.HTMLBasicNew()
+	if r.pdf.GetY() > 250 {
+		r.pageBreak()
+		r.pdf.SetY(36)
+	} else {
+		html.Write(5, "<br><br><br>")
+	}
+	html.Write(5, "<b>"+title+"</b>")
+	for _, line := range lines {
+		if r.pdf.GetY() > 270 {
+			r.pageBreak()
+			r.pdf.SetY(36)
+		} else {
+			html.Write(5, "<br>")
+		}
+		html.Write(5, line)
+	}
+}
+
+func (adoc adocReport) modelDiff(f *os.File) {
+	diff := adoc.model.ModelDiff
+	writeLine(f, "= Model Changes")
+	writeLine(f, modelDiffIntroText)
+	writeLine(f, "")
+	writeLine(f, "Compared versions: *"+diff.From+"* and *"+diff.To+"*")
+	writeLine(f, "")
+	if diff.IsEmpty() {
+		writeLine(f, "[GreyText]#No changes have been found.#")
+		return
+	}
+
+	adoc.modelDiffElements(f, "Technical Assets", diff.TechnicalAssets)
+	adoc.modelDiffElements(f, "Communication Links", diff.CommunicationLinks)
+	adoc.modelDiffElements(f, "Data Assets", diff.DataAssets)
+	adoc.modelDiffElements(f, "Trust Boundaries", diff.TrustBoundaries)
+
+	writeLine(f, "== Risks: "+strconv.Itoa(len(diff.Risks.New))+" new, "+strconv.Itoa(len(diff.Risks.Resolved))+" resolved, "+
+		strconv.Itoa(len(diff.Risks.Rerated))+" rerated")
+	writeLine(f, "")
+	for _, risk := range diff.Risks.New {
+		writeLine(f, "* New _"+risk.Severity.Title()+"_ risk: "+fixBasicHtml(risk.Title))
+	}
+	for _, risk := range diff.Risks.Resolved {
+		writeLine(f, "* Resolved _"+risk.Severity.Title()+"_ risk: "+fixBasicHtml(risk.Title))
+	}
+	for _, change := range diff.Risks.Rerated {
+		writeLine(f, "* Rerated risk from _"+change.BaselineSeverity.Title()+"_ to _"+change.Severity.Title()+"_: "+fixBasicHtml(change.Title))
+	}
+	writeLine(f, "")
+}
+
+func (adoc adocReport) modelDiffElements(f *os.File, kind string, diff *types.ElementsDiff) {
+	if diff.IsEmpty() {
+		return
+	}
+
+	writeLine(f, "== "+kind+": "+strconv.Itoa(len(diff.Added))+" added, "+strconv.Itoa(len(diff.Removed))+" removed, "+
+		strconv.Itoa(len(diff.Modified))+" modified")
+	writeLine(f, "")
+	for _, id := range diff.Added {
+		writeLine(f, "* Added: *"+id+"*")
+	}
+	for _, id := range diff.Removed {
+		writeLine(f, "* Removed: *"+id+"*")
+	}
+	for _, change := range diff.Modified {
+		writeLine(f, "* Modified: *"+change.Id+"* ("+change.Title+")")
+		for _, property := range change.Properties {
+			writeLine(f, "** `"+propertyChangeText(property)+"`")
+		}
+	}
+	writeLine(f, "")
+}
+
+func (adoc adocReport) writeModelDiff() error {
+	if adoc.model.ModelDiff == nil {
+		return nil
+	}
+
+	filename := "015_ModelChanges.adoc"
+	md, err := os.Create(filepath.Join(adoc.targetDirectory, filename))
+	defer func() { _ = md.Close() }()
+	if err != nil {
+		return err
+	}
+
+	adoc.modelDiff(md)
+	adoc.writeMainLine("<<<")
+	adoc.writeMainLine("include::" + filename + "[leveloffset=+1]")
+	return nil
+}
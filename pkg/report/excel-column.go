@@ -34,6 +34,30 @@ func (what *ExcelColumns) GetColumns() ExcelColumns {
 	return *what
 }
 
+// GetTaraColumns returns the columns of the TARA worksheet (ISO/SAE 21434)
+func (what *ExcelColumns) GetTaraColumns() ExcelColumns {
+	*what = map[string]ExcelColumn{
+		"A": {Title: "Asset", Width: 35},
+		"B": {Title: "Cybersecurity Property", Width: 18},
+		"C": {Title: "Damage Scenario", Width: 45},
+		"D": {Title: "Safety Impact", Width: 12},
+		"E": {Title: "Financial Impact", Width: 12},
+		"F": {Title: "Operational Impact", Width: 12},
+		"G": {Title: "Privacy Impact", Width: 12},
+		"H": {Title: "Impact", Width: 12},
+		"I": {Title: "Threat Scenario", Width: 75},
+		"J": {Title: "Attack Path", Width: 50},
+		"K": {Title: "Attack Feasibility", Width: 12},
+		"L": {Title: "Risk Value", Width: 10},
+		"M": {Title: "Treatment", Width: 12},
+		"N": {Title: "Cybersecurity Goal", Width: 45},
+		"O": {Title: "Status", Width: 18},
+		"P": {Title: "ID", Width: 10},
+	}
+
+	return *what
+}
+
 func (what *ExcelColumns) FindColumnNameByTitle(title string) string {
 	for column, excelColumn := range *what {
 		if strings.EqualFold(excelColumn.Title, title) {
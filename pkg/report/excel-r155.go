@@ -0,0 +1,145 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+	"github.com/xuri/excelize/v2"
+)
+
+const r155SheetName = "UN R155 Annex 5"
+
+var r155Columns = []struct {
+	Title string
+	Width float64
+}{
+	{"Threat", 10},
+	{"Threat Group", 35},
+	{"Threat Title", 50},
+	{"Mitigations", 15},
+	{"Coverage", 20},
+	{"Severity", 12},
+	{"Risk Status", 15},
+	{"Risk Title", 60},
+	{"Risk ID", 50},
+}
+
+// writeR155Sheet adds a sheet listing the threats of UN R155 Annex 5 with the risks identified for each of them (one
+// row per risk), or their coverage status if no risks were identified
+func writeR155Sheet(excel *excelize.File, parsedModel *types.Model, cellStyles *ExcelStyles) error {
+	catalog, err := types.LoadR155Annex5()
+	if err != nil {
+		return err
+	}
+
+	_, err = excel.NewSheet(r155SheetName)
+	if err != nil {
+		return fmt.Errorf("failed to add sheet: %w", err)
+	}
+
+	for columnIndex, column := range r155Columns {
+		columnName, columnNameError := excelize.ColumnNumberToName(columnIndex + 1)
+		if columnNameError != nil {
+			return fmt.Errorf("failed to get cell coordinates from column [%d]: %w", columnIndex+1, columnNameError)
+		}
+
+		err = excel.SetCellValue(r155SheetName, columnName+"1", column.Title)
+		if err != nil {
+			return fmt.Errorf("unable to set cell value: %w", err)
+		}
+
+		err = excel.SetColWidth(r155SheetName, columnName, columnName, column.Width)
+		if err != nil {
+			return fmt.Errorf("unable to set column width: %w", err)
+		}
+	}
+
+	excelRow := 1
+	for _, coverage := range parsedModel.R155Coverage(catalog) {
+		threat := coverage.Threat
+		threatColumns := []string{threat.Id, threat.Group, threat.Title, strings.Join(threat.Mitigations, ", ")}
+		if !coverage.IsCovered() {
+			excelRow++
+			err = writeR155Row(excel, excelRow, append(threatColumns, "Not covered"), cellStyles.blackLeft, cellStyles.redCenter)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(coverage.Risks) == 0 {
+			excelRow++
+			err = writeR155Row(excel, excelRow, append(threatColumns, "No risks identified"), cellStyles.blackLeft, cellStyles.grayCenter)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, risk := range coverage.Risks {
+			excelRow++
+			riskTracking := parsedModel.GetRiskTrackingWithDefault(risk)
+			riskColumns := append(append(make([]string, 0, len(r155Columns)), threatColumns...), "Risks identified",
+				risk.Severity.Title(), riskTracking.Status.Title(), removeFormattingTags(risk.Title), risk.SyntheticId)
+			err = writeR155Row(excel, excelRow, riskColumns, cellStyles.blackLeft, cellStyles.blackCenter)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	lastColumn, err := excelize.ColumnNumberToName(len(r155Columns))
+	if err != nil {
+		return fmt.Errorf("failed to get last column name: %w", err)
+	}
+
+	err = excel.SetCellStyle(r155SheetName, "A1", lastColumn+"1", cellStyles.headCenterBoldItalic)
+	if err != nil {
+		return fmt.Errorf("unable to set cell style: %w", err)
+	}
+
+	err = excel.SetPanes(r155SheetName, &excelize.Panes{
+		Freeze:      true,
+		Split:       false,
+		XSplit:      0,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to freeze header: %w", err)
+	}
+
+	err = excel.AutoFilter(r155SheetName, fmt.Sprintf("A1:%s%d", lastColumn, excelRow), []excelize.AutoFilterOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to add autofilter: %w", err)
+	}
+
+	return nil
+}
+
+func writeR155Row(excel *excelize.File, excelRow int, columns []string, textStyle int, coverageStyle int) error {
+	for columnIndex, column := range columns {
+		cellName, coordinatesToCellNameError := excelize.CoordinatesToCellName(columnIndex+1, excelRow)
+		if coordinatesToCellNameError != nil {
+			return fmt.Errorf("failed to get cell coordinates from [%d, %d]: %w", columnIndex+1, excelRow, coordinatesToCellNameError)
+		}
+
+		err := excel.SetCellValue(r155SheetName, cellName, column)
+		if err != nil {
+			return fmt.Errorf("unable to write row: %w", err)
+		}
+
+		style := textStyle
+		if columnIndex == 4 {
+			style = coverageStyle
+		}
+		err = excel.SetCellStyle(r155SheetName, cellName, cellName, style)
+		if err != nil {
+			return fmt.Errorf("unable to write row: %w", err)
+		}
+	}
+
+	return nil
+}
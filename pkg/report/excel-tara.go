@@ -0,0 +1,311 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+	"github.com/xuri/excelize/v2"
+)
+
+const taraSheetName = "TARA"
+
+// WriteTaraExcelToFile writes the TARA worksheet (ISO/SAE 21434) with one row per asset, cybersecurity property and
+// threat scenario (identified risk), only for models declaring damage scenarios (TARA mode)
+func WriteTaraExcelToFile(parsedModel *types.Model, filename string, config reportConfigReader) error {
+	columns := new(ExcelColumns).GetTaraColumns()
+	excel := excelize.NewFile()
+
+	setDocPropsError := excel.SetDocProps(&excelize.DocProperties{
+		Category:       "Threat Analysis and Risk Assessment",
+		ContentStatus:  "Final",
+		Creator:        parsedModel.Author.Name,
+		Description:    parsedModel.Title + " via Threagile",
+		Identifier:     "xlsx",
+		Keywords:       "TARA",
+		LastModifiedBy: parsedModel.Author.Name,
+		Revision:       "0",
+		Subject:        parsedModel.Title,
+		Title:          parsedModel.Title,
+		Language:       "en-US",
+		Version:        "1.0.0",
+	})
+	if setDocPropsError != nil {
+		return fmt.Errorf("failed to set doc properties: %w", setDocPropsError)
+	}
+
+	sheetIndex, newSheetError := excel.NewSheet(taraSheetName)
+	if newSheetError != nil {
+		return fmt.Errorf("failed to add sheet: %w", newSheetError)
+	}
+
+	deleteSheetError := excel.DeleteSheet("Sheet1")
+	if deleteSheetError != nil {
+		return fmt.Errorf("failed to delete sheet: %w", deleteSheetError)
+	}
+
+	orientation := "landscape"
+	size := 9 // A4
+	setPageLayoutError := excel.SetPageLayout(taraSheetName, &excelize.PageLayoutOptions{Orientation: &orientation, Size: &size})
+	if setPageLayoutError != nil {
+		return fmt.Errorf("unable to set page layout: %w", setPageLayoutError)
+	}
+
+	// set header row
+	for columnLetter, column := range columns {
+		setCellValueError := excel.SetCellValue(taraSheetName, columnLetter+"1", column.Title)
+		if setCellValueError != nil {
+			return fmt.Errorf("unable to set cell value: %w", setCellValueError)
+		}
+	}
+
+	cellStyles, createCellStylesError := new(ExcelStyles).Init(excel, config)
+	if createCellStylesError != nil {
+		return fmt.Errorf("unable to create cell styles: %w", createCellStylesError)
+	}
+
+	// get sorted risks
+	riskItems := make([]RiskItem, 0)
+	for _, category := range parsedModel.SortedRiskCategories() {
+		for _, risk := range parsedModel.SortedRisksOfCategory(category) {
+			riskItems = append(riskItems, taraRiskItem(parsedModel, category, risk))
+		}
+	}
+
+	// group risks by the configured columns of the TARA worksheet
+	sortByColumns := make([]string, 0)
+	for _, title := range config.GetRiskExcelConfigSortByColumns() {
+		if columns.FindColumnIndexByTitle(title) >= 0 {
+			sortByColumns = append(sortByColumns, title)
+		}
+	}
+	groupedRisk, groupedRiskError := new(RiskGroup).Make(riskItems, columns, sortByColumns)
+	if groupedRiskError != nil {
+		return fmt.Errorf("failed to group risks: %w", groupedRiskError)
+	}
+
+	// write data
+	for rowIndex, item := range groupedRisk.SortedItems() {
+		for columnIndex, value := range item.Columns {
+			cellName, coordinatesToCellNameError := excelize.CoordinatesToCellName(columnIndex+1, rowIndex+2)
+			if coordinatesToCellNameError != nil {
+				return fmt.Errorf("failed to get cell coordinates from [%d, %d]: %w", columnIndex+1, rowIndex+2, coordinatesToCellNameError)
+			}
+
+			setCellValueError := excel.SetCellValue(taraSheetName, cellName, value)
+			if setCellValueError != nil {
+				return fmt.Errorf("unable to set cell value: %w", setCellValueError)
+			}
+
+			columnName, columnNameError := excelize.ColumnNumberToName(columnIndex + 1)
+			if columnNameError != nil {
+				return fmt.Errorf("failed to get cell coordinates from column [%d]: %w", columnIndex+1, columnNameError)
+			}
+
+			setCellStyleError := excel.SetCellStyle(taraSheetName, cellName, cellName, taraCellStyle(cellStyles, columns[columnName].Title, item, value))
+			if setCellStyleError != nil {
+				return fmt.Errorf("failed to set cell style: %w", setCellStyleError)
+			}
+		}
+	}
+
+	lastColumn, err := excelize.ColumnNumberToName(len(columns))
+	if err != nil {
+		return fmt.Errorf("failed to get last column name: %w", err)
+	}
+
+	// set header style
+	setCellStyleError := excel.SetCellStyle(taraSheetName, "A1", lastColumn+"1", cellStyles.headCenterBoldItalic)
+	if setCellStyleError != nil {
+		return fmt.Errorf("unable to set cell style: %w", setCellStyleError)
+	}
+
+	// set column width and hide some columns
+	for columnLetter, column := range columns {
+		width, widthOk := config.GetRiskExcelConfigWidthOfColumns()[column.Title]
+		if !widthOk {
+			width = column.Width
+		}
+
+		setColWidthError := excel.SetColWidth(taraSheetName, columnLetter, columnLetter, width)
+		if setColWidthError != nil {
+			return fmt.Errorf("unable to set column width: %w", setColWidthError)
+		}
+
+		for _, hiddenColumn := range config.GetRiskExcelConfigHideColumns() {
+			if strings.EqualFold(hiddenColumn, column.Title) {
+				hideColumnError := excel.SetColVisible(taraSheetName, columnLetter, false)
+				if hideColumnError != nil {
+					return fmt.Errorf("unable to hide column: %w", hideColumnError)
+				}
+			}
+		}
+	}
+
+	// freeze header
+	freezeError := excel.SetPanes(taraSheetName, &excelize.Panes{
+		Freeze:      true,
+		Split:       false,
+		XSplit:      0,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	})
+	if freezeError != nil {
+		return fmt.Errorf("unable to freeze header: %w", freezeError)
+	}
+
+	excel.SetActiveSheet(sheetIndex)
+
+	filterRange := fmt.Sprintf("A1:%s%d", lastColumn, len(riskItems)+1)
+	err = excel.AutoFilter(taraSheetName, filterRange, []excelize.AutoFilterOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to add autofilter: %w", err)
+	}
+
+	// save file
+	saveAsError := excel.SaveAs(filename)
+	if saveAsError != nil {
+		return fmt.Errorf("unable to save excel file: %w", saveAsError)
+	}
+
+	return nil
+}
+
+func taraRiskItem(parsedModel *types.Model, category *types.RiskCategory, risk *types.Risk) RiskItem {
+	assetTitle := ""
+	if techAsset := parsedModel.TechnicalAssets[risk.MostRelevantTechnicalAssetId]; techAsset != nil {
+		assetTitle = techAsset.Title
+	}
+
+	tara := risk.Tara
+	if tara == nil {
+		tara = &types.TaraRating{}
+	}
+
+	// impact ratings per category are the highest of the damage scenarios linked to the risk
+	damageScenarioTitles := make([]string, 0)
+	impacts := make([]types.DamageImpact, 4)
+	for _, damageScenarioId := range tara.DamageScenarioIds {
+		damageScenario := parsedModel.DamageScenarios[damageScenarioId]
+		if damageScenario == nil {
+			continue
+		}
+
+		damageScenarioTitles = append(damageScenarioTitles, damageScenario.Title)
+		for index, impact := range []types.DamageImpact{damageScenario.Safety, damageScenario.Financial, damageScenario.Operational, damageScenario.Privacy} {
+			if impact > impacts[index] {
+				impacts[index] = impact
+			}
+		}
+	}
+
+	impactTitles := []string{"", "", "", ""}
+	if len(damageScenarioTitles) > 0 {
+		for index, impact := range impacts {
+			impactTitles[index] = impact.Title()
+		}
+	}
+
+	goalTitles := make([]string, 0)
+	for _, goal := range parsedModel.CybersecurityGoalsOfRisk(risk) {
+		goalTitles = append(goalTitles, goal.Title)
+	}
+
+	riskValue := ""
+	if tara.RiskValue > 0 {
+		riskValue = strconv.Itoa(tara.RiskValue)
+	}
+
+	riskTracking := parsedModel.GetRiskTrackingWithDefault(risk)
+	return RiskItem{
+		Columns: []string{
+			assetTitle,
+			category.STRIDE.CybersecurityProperty(),
+			strings.Join(damageScenarioTitles, ", "),
+			impactTitles[0],
+			impactTitles[1],
+			impactTitles[2],
+			impactTitles[3],
+			tara.Impact.Title(),
+			removeFormattingTags(risk.Title),
+			taraAttackPath(parsedModel, risk),
+			tara.AttackFeasibility.Title(),
+			riskValue,
+			riskTracking.Treatment.Title(),
+			strings.Join(goalTitles, ", "),
+			riskTracking.Status.Title(),
+			risk.SyntheticId,
+		},
+		Status:   riskTracking.Status,
+		Severity: risk.Severity,
+	}
+}
+
+// taraAttackPath describes the easiest attack path to the technical asset of the risk, or the communication link of
+// the risk if there is no attack path to the asset
+func taraAttackPath(parsedModel *types.Model, risk *types.Risk) string {
+	for _, attackPath := range parsedModel.AttackPaths {
+		if attackPath.TargetId != risk.MostRelevantTechnicalAssetId {
+			continue
+		}
+
+		titles := make([]string, 0, len(attackPath.TechnicalAssetIds))
+		for _, id := range attackPath.TechnicalAssetIds {
+			if techAsset := parsedModel.TechnicalAssets[id]; techAsset != nil {
+				titles = append(titles, techAsset.Title)
+			}
+		}
+		return strings.Join(titles, " > ")
+	}
+
+	if commLink := parsedModel.CommunicationLinks[risk.MostRelevantCommunicationLinkId]; commLink != nil {
+		return commLink.Title
+	}
+
+	return ""
+}
+
+func taraCellStyle(cellStyles *ExcelStyles, columnTitle string, item RiskItem, value string) int {
+	switch columnTitle {
+	case "Asset":
+		return cellStyles.blackLeftBold
+
+	case "Damage Scenario", "Attack Path", "Cybersecurity Goal":
+		return cellStyles.blackLeft
+
+	case "Threat Scenario":
+		return cellStyles.blackSmall
+
+	case "Risk Value":
+		if !item.Status.IsStillAtRisk() {
+			return cellStyles.blackCenter
+		}
+
+		switch value {
+		case "5":
+			return cellStyles.severityCriticalCenter
+
+		case "4":
+			return cellStyles.severityHighCenter
+
+		case "3":
+			return cellStyles.severityElevatedCenter
+
+		case "2":
+			return cellStyles.severityMediumCenter
+
+		default:
+			return cellStyles.severityLowCenter
+		}
+
+	case "Status":
+		return cellStyles.Get("P", item.Status, item.Severity)
+
+	case "ID":
+		return cellStyles.graySmall
+	}
+
+	return cellStyles.blackCenter
+}
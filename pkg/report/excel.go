@@ -15,7 +15,7 @@ import (
 func WriteRisksExcelToFile(parsedModel *types.Model, filename string, config reportConfigReader) error {
 	columns := new(ExcelColumns).GetColumns()
 	excel := excelize.NewFile()
-	sheetName := parsedModel.Title
+	sheetName := excelSheetName(parsedModel.Title, "Risks")
 
 	setDocPropsError := excel.SetDocProps(&excelize.DocProperties{
 		Category:       "Threat Model Risks Summary",
@@ -248,6 +248,13 @@ func WriteRisksExcelToFile(parsedModel *types.Model, filename string, config rep
 		return fmt.Errorf("failed to add autofilter: %w", err)
 	}
 
+	if parsedModel.IsR155Mode() {
+		err = writeR155Sheet(excel, parsedModel, cellStyles)
+		if err != nil {
+			return fmt.Errorf("failed to write UN R155 Annex 5 sheet: %w", err)
+		}
+	}
+
 	// save file
 	saveAsError := excel.SaveAs(filename)
 	if saveAsError != nil {
@@ -261,7 +268,7 @@ func WriteRisksExcelToFile(parsedModel *types.Model, filename string, config rep
 func WriteTagsExcelToFile(parsedModel *types.Model, filename string, config reportConfigReader) error {
 	excelRow := 0
 	excel := excelize.NewFile()
-	sheetName := parsedModel.Title
+	sheetName := excelSheetName(parsedModel.Title, "Tags")
 	err := excel.SetDocProps(&excelize.DocProperties{
 		Category:       "Tag Matrix",
 		ContentStatus:  "Final",
@@ -472,3 +479,25 @@ func removeFormattingTags(content string) string {
 	result = strings.ReplaceAll(strings.ReplaceAll(result, "<u>", ""), "</u>", "")
 	return result
 }
+
+// excelSheetName makes a valid sheet name of the model title: Excel does not allow blank sheet names, names longer
+// than 31 characters, the characters []:*?/\ and leading or trailing apostrophes
+func excelSheetName(title string, defaultName string) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '-'
+		}
+		return r
+	}, title)
+
+	if utf8.RuneCountInString(name) > 31 {
+		name = string([]rune(name)[:31])
+	}
+
+	name = strings.TrimSpace(strings.Trim(name, "'"))
+	if len(name) == 0 {
+		return defaultName
+	}
+
+	return name
+}
@@ -14,28 +14,38 @@ import (
 )
 
 type GenerateCommands struct {
-	DataFlowDiagram     bool
-	DataAssetDiagram    bool
-	RisksJSON           bool
-	TechnicalAssetsJSON bool
-	StatsJSON           bool
-	RisksExcel          bool
-	TagsExcel           bool
-	ReportPDF           bool
-	ReportADOC          bool
+	DataFlowDiagram            bool
+	DataAssetDiagram           bool
+	SecureBootDiagram          bool
+	RisksJSON                  bool
+	TechnicalAssetsJSON        bool
+	StatsJSON                  bool
+	AttackPathsJSON            bool
+	CybersecurityGoalsJSON     bool
+	AttackerAttractivenessJSON bool
+	RisksExcel                 bool
+	TagsExcel                  bool
+	TaraExcel                  bool
+	ReportPDF                  bool
+	ReportADOC                 bool
 }
 
 func (c *GenerateCommands) Defaults() *GenerateCommands {
 	*c = GenerateCommands{
-		DataFlowDiagram:     true,
-		DataAssetDiagram:    true,
-		RisksJSON:           true,
-		TechnicalAssetsJSON: true,
-		StatsJSON:           true,
-		RisksExcel:          true,
-		TagsExcel:           true,
-		ReportPDF:           true,
-		ReportADOC:          true,
+		DataFlowDiagram:            true,
+		DataAssetDiagram:           true,
+		SecureBootDiagram:          true,
+		RisksJSON:                  true,
+		TechnicalAssetsJSON:        true,
+		StatsJSON:                  true,
+		AttackPathsJSON:            true,
+		CybersecurityGoalsJSON:     true,
+		AttackerAttractivenessJSON: true,
+		RisksExcel:                 true,
+		TagsExcel:                  true,
+		TaraExcel:                  true,
+		ReportPDF:                  true,
+		ReportADOC:                 true,
 	}
 	return c
 }
@@ -53,12 +63,18 @@ type reportConfigReader interface {
 	GetDataAssetDiagramFilenamePNG() string
 	GetDataFlowDiagramFilenameDOT() string
 	GetDataAssetDiagramFilenameDOT() string
+	GetSecureBootDiagramFilenamePNG() string
+	GetSecureBootDiagramFilenameDOT() string
 	GetReportFilename() string
 	GetExcelRisksFilename() string
 	GetExcelTagsFilename() string
+	GetExcelTaraFilename() string
 	GetJsonRisksFilename() string
 	GetJsonTechnicalAssetsFilename() string
 	GetJsonStatsFilename() string
+	GetJsonAttackPathsFilename() string
+	GetJsonCybersecurityGoalsFilename() string
+	GetJsonAttackerAttractivenessFilename() string
 	GetTemplateFilename() string
 	GetReportLogoImagePath() string
 
@@ -155,6 +171,27 @@ func Generate(config reportConfigReader, readResult *model.ReadResult, commands
 			progressReporter.Warn(err)
 		}
 	}
+	// Secure Boot Diagram rendering (only for models describing boot verifications)
+	if commands.SecureBootDiagram && readResult.ParsedModel.HasBootVerifications() {
+		gvFile := filepath.Join(config.GetOutputFolder(), config.GetSecureBootDiagramFilenameDOT())
+		if !config.GetKeepDiagramSourceFiles() {
+			tmpFile, err := os.CreateTemp(config.GetTempFolder(), config.GetSecureBootDiagramFilenameDOT())
+			if err != nil {
+				return err
+			}
+			gvFile = tmpFile.Name()
+			defer func() { _ = os.Remove(gvFile) }()
+		}
+		dotFile, err := WriteSecureBootDiagramGraphvizDOT(readResult.ParsedModel, gvFile, diagramDPI, progressReporter)
+		if err != nil {
+			return fmt.Errorf("error while generating secure boot diagram: %w", err)
+		}
+		err = GenerateSecureBootDiagramGraphvizImage(dotFile, config.GetOutputFolder(),
+			config.GetTempFolder(), config.GetSecureBootDiagramFilenamePNG(), progressReporter)
+		if err != nil {
+			progressReporter.Warn(err)
+		}
+	}
 
 	// risks as risks json
 	if commands.RisksJSON {
@@ -183,6 +220,33 @@ func Generate(config reportConfigReader, readResult *model.ReadResult, commands
 		}
 	}
 
+	// attack paths json
+	if commands.AttackPathsJSON {
+		progressReporter.Info("Writing attack paths json")
+		err := WriteAttackPathsJSON(readResult.ParsedModel, filepath.Join(config.GetOutputFolder(), config.GetJsonAttackPathsFilename()))
+		if err != nil {
+			return fmt.Errorf("error while writing attack paths json: %w", err)
+		}
+	}
+
+	// cybersecurity goals json
+	if commands.CybersecurityGoalsJSON {
+		progressReporter.Info("Writing cybersecurity goals json")
+		err := WriteCybersecurityGoalsJSON(readResult.ParsedModel, filepath.Join(config.GetOutputFolder(), config.GetJsonCybersecurityGoalsFilename()))
+		if err != nil {
+			return fmt.Errorf("error while writing cybersecurity goals json: %w", err)
+		}
+	}
+
+	// attacker attractiveness json
+	if commands.AttackerAttractivenessJSON {
+		progressReporter.Info("Writing attacker attractiveness json")
+		err := WriteAttackerAttractivenessJSON(readResult.ParsedModel, filepath.Join(config.GetOutputFolder(), config.GetJsonAttackerAttractivenessFilename()))
+		if err != nil {
+			return fmt.Errorf("error while writing attacker attractiveness json: %w", err)
+		}
+	}
+
 	// risks Excel
 	if commands.RisksExcel {
 		progressReporter.Info("Writing risks excel")
@@ -201,6 +265,15 @@ func Generate(config reportConfigReader, readResult *model.ReadResult, commands
 		}
 	}
 
+	// TARA Excel
+	if commands.TaraExcel && readResult.ParsedModel.IsTaraMode() {
+		progressReporter.Info("Writing TARA excel")
+		err := WriteTaraExcelToFile(readResult.ParsedModel, filepath.Join(config.GetOutputFolder(), config.GetExcelTaraFilename()), config)
+		if err != nil {
+			return err
+		}
+	}
+
 	if commands.ReportPDF {
 		// hash the YAML input file
 		f, err := os.Open(config.GetInputFile())
@@ -607,6 +607,87 @@ func WriteDataAssetDiagramGraphvizDOT(parsedModel *types.Model, diagramFilenameD
 	return file, nil
 }
 
+func WriteSecureBootDiagramGraphvizDOT(parsedModel *types.Model, diagramFilenameDOT string, dpi int,
+	progressReporter progressReporter) (*os.File, error) {
+	progressReporter.Info("Writing secure boot diagram input")
+
+	var dotContent strings.Builder
+	dotContent.WriteString("digraph generatedModel { concentrate=true \n")
+
+	// Metadata init ===============================================================================
+	dotContent.WriteString(`	graph [
+		dpi=` + strconv.Itoa(dpi) + `
+		fontname="Verdana"
+		labelloc="c"
+		fontsize="20"
+		splines=false
+		rankdir="LR"
+		nodesep=1.0
+		ranksep=3.0
+        outputorder="nodesfirst"
+	];
+	node [
+		fontcolor="white"
+		fontname="Verdana"
+		fontsize="20"
+	];
+	edge [
+		shape="none"
+		fontname="Verdana"
+		fontsize="18"
+	];
+`)
+
+	// Technical Assets ===============================================================================
+	techAssets := make([]*types.TechnicalAsset, 0)
+	for _, techAsset := range parsedModel.TechnicalAssets {
+		techAssets = append(techAssets, techAsset)
+	}
+	sort.Sort(types.ByOrderAndIdSort(techAssets))
+	for _, technicalAsset := range techAssets {
+		if technicalAsset.HardwareRootOfTrust || len(technicalAsset.VerifiesAtBoot) > 0 ||
+			len(parsedModel.BootVerifiersOfTechnicalAsset(technicalAsset)) > 0 {
+			dotContent.WriteString(makeTechAssetNode(parsedModel, technicalAsset, true))
+			dotContent.WriteString("\n")
+		}
+		if technicalAsset.HardwareRootOfTrust { // hardware roots of trust are drawn with a double border
+			dotContent.WriteString("  " + hash(technicalAsset.Id) + ` [ peripheries="2" ];`)
+			dotContent.WriteString("\n")
+		}
+	}
+
+	// Verifier to verified Tech Asset links ===============================================================================
+	covered := parsedModel.TechnicalAssetIDsCoveredBySecureBoot()
+	for _, technicalAsset := range techAssets {
+		for _, targetId := range technicalAsset.VerifiesAtBoot {
+			sourceId := technicalAsset.Id
+			dotContent.WriteString("\n")
+			if covered[sourceId] { // part of a chain of trust rooted in hardware
+				dotContent.WriteString(hash(sourceId) + " -> " + hash(targetId) +
+					` [ color="blue" style="solid" ];`)
+			} else {
+				dotContent.WriteString(hash(sourceId) + " -> " + hash(targetId) +
+					` [ color="#666666" style="dashed" ];`)
+			}
+			dotContent.WriteString("\n")
+		}
+	}
+
+	dotContent.WriteString("}")
+
+	// Write the DOT file
+	file, err := os.Create(filepath.Clean(diagramFilenameDOT))
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s: %w", diagramFilenameDOT, err)
+	}
+	defer func() { _ = file.Close() }()
+	_, err = fmt.Fprintln(file, dotContent.String())
+	if err != nil {
+		return nil, fmt.Errorf("error writing %s: %w", diagramFilenameDOT, err)
+	}
+	return file, nil
+}
+
 func sortByDataAssetDataBreachProbabilityAndTitle(parsedModel *types.Model, assets []*types.DataAsset) {
 	sort.Slice(assets, func(i, j int) bool {
 		highestDataBreachProbabilityLeft := parsedModel.IdentifiedDataBreachProbability(assets[i])
@@ -821,6 +902,16 @@ func determineTechnicalAssetLabelColor(ta *types.TechnicalAsset, model *types.Mo
 func GenerateDataAssetDiagramGraphvizImage(dotFile *os.File, targetDir string,
 	tempFolder, dataAssetDiagramFilenamePNG string, progressReporter progressReporter) error { // TODO dedupe with other render...() method here
 	progressReporter.Info("Rendering data asset diagram input")
+	return renderDiagramGraphvizImage(dotFile, targetDir, tempFolder, dataAssetDiagramFilenamePNG)
+}
+
+func GenerateSecureBootDiagramGraphvizImage(dotFile *os.File, targetDir string,
+	tempFolder, secureBootDiagramFilenamePNG string, progressReporter progressReporter) error {
+	progressReporter.Info("Rendering secure boot diagram input")
+	return renderDiagramGraphvizImage(dotFile, targetDir, tempFolder, secureBootDiagramFilenamePNG)
+}
+
+func renderDiagramGraphvizImage(dotFile *os.File, targetDir string, tempFolder, diagramFilenamePNG string) error {
 	// tmp files
 	tmpFileDOT, err := os.CreateTemp(tempFolder, "diagram-*-.gv")
 	if err != nil {
@@ -857,9 +948,9 @@ func GenerateDataAssetDiagramGraphvizImage(dotFile *os.File, targetDir string,
 	if err != nil {
 		return fmt.Errorf("failed to copy to file %s: %w", tmpFilePNG.Name(), err)
 	}
-	err = os.WriteFile(filepath.Join(targetDir, dataAssetDiagramFilenamePNG), inputPNG, 0600)
+	err = os.WriteFile(filepath.Join(targetDir, diagramFilenamePNG), inputPNG, 0600)
 	if err != nil {
-		return fmt.Errorf("failed to create %s: %w", filepath.Join(targetDir, dataAssetDiagramFilenamePNG), err)
+		return fmt.Errorf("failed to create %s: %w", filepath.Join(targetDir, diagramFilenamePNG), err)
 	}
 	return nil
 }
@@ -20,6 +20,20 @@ func WriteRisksJSON(parsedModel *types.Model, filename string) error {
 	return nil
 }
 
+// ReadRisksJSON reads the risks as written by WriteRisksJSON, e.g. as baseline to compare the risks of a model with
+func ReadRisksJSON(filename string) ([]*types.Risk, error) {
+	jsonBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read risks JSON file: %w", err)
+	}
+	risks := make([]*types.Risk, 0)
+	err = json.Unmarshal(jsonBytes, &risks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal risks from JSON file %q: %w", filename, err)
+	}
+	return risks, nil
+}
+
 // TODO: also a "data assets" json?
 
 func WriteTechnicalAssetsJSON(parsedModel *types.Model, filename string) error {
@@ -46,6 +60,43 @@ func WriteStatsJSON(parsedModel *types.Model, filename string) error {
 	return nil
 }
 
+func WriteAttackPathsJSON(parsedModel *types.Model, filename string) error {
+	jsonBytes, err := json.Marshal(parsedModel.AttackPaths)
+	if err != nil {
+		return fmt.Errorf("failed to marshal attack paths to JSON: %w", err)
+	}
+	err = os.WriteFile(filename, jsonBytes, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write attack paths to JSON file: %w", err)
+	}
+	return nil
+}
+
+// WriteAttackerAttractivenessJSON writes the breakdown of the RAA of the technical assets, the most attacker attractive first
+func WriteAttackerAttractivenessJSON(parsedModel *types.Model, filename string) error {
+	jsonBytes, err := json.Marshal(parsedModel.SortedAttackerAttractiveness())
+	if err != nil {
+		return fmt.Errorf("failed to marshal attacker attractiveness to JSON: %w", err)
+	}
+	err = os.WriteFile(filename, jsonBytes, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write attacker attractiveness to JSON file: %w", err)
+	}
+	return nil
+}
+
+func WriteCybersecurityGoalsJSON(parsedModel *types.Model, filename string) error {
+	jsonBytes, err := json.Marshal(parsedModel.CybersecurityGoalCoverages())
+	if err != nil {
+		return fmt.Errorf("failed to marshal cybersecurity goals to JSON: %w", err)
+	}
+	err = os.WriteFile(filename, jsonBytes, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write cybersecurity goals to JSON file: %w", err)
+	}
+	return nil
+}
+
 func overallRiskStatistics(parsedModel *types.Model) riskStatistics {
 	result := riskStatistics{}
 	result.Risks = make(map[string]map[string]int)
@@ -89,10 +140,29 @@ func overallRiskStatistics(parsedModel *types.Model) riskStatistics {
 			result.Risks[risk.Severity.String()][risk.RiskStatus.String()]++
 		}
 	}
+	result.RiskRules = make([]riskRuleStatistics, 0, len(parsedModel.RiskRuleEvaluations))
+	for _, evaluation := range parsedModel.RiskRuleEvaluations {
+		result.RiskRules = append(result.RiskRules, riskRuleStatistics{
+			Id:           evaluation.RiskRuleId,
+			DurationInMs: float64(evaluation.Duration.Microseconds()) / 1000,
+			Risks:        evaluation.Risks,
+			TimedOut:     evaluation.TimedOut,
+			Error:        evaluation.Error,
+		})
+	}
 	return result
 }
 
 type riskStatistics struct {
 	// TODO add also some more like before / after (i.e. with mitigation applied)
-	Risks map[string]map[string]int `yaml:"risks" json:"risks"`
+	Risks     map[string]map[string]int `yaml:"risks" json:"risks"`
+	RiskRules []riskRuleStatistics      `yaml:"risk_rules" json:"risk_rules"` // sorted by id
+}
+
+type riskRuleStatistics struct {
+	Id           string  `yaml:"id" json:"id"`
+	DurationInMs float64 `yaml:"duration_ms" json:"duration_ms"`
+	Risks        int     `yaml:"risks" json:"risks"`
+	TimedOut     bool    `yaml:"timed_out,omitempty" json:"timed_out,omitempty"`
+	Error        string  `yaml:"error,omitempty" json:"error,omitempty"`
 }
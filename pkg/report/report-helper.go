@@ -2,6 +2,7 @@ package report
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/threagile/threagile/pkg/types"
 )
@@ -145,3 +146,22 @@ func identifiedDataBreachProbabilityStillAtRisk(parsedModel *types.Model, dataAs
 	}
 	return highestProbability
 }
+
+const attackPathsIntroText = "This chapter lists the attack paths along the communication links from the entry points (internet-facing or " +
+	"physically accessible technical assets) to the technical assets with high integrity requirements. Each path is scored by the " +
+	"protections of its communication links: authentication, encryption and crossing a trust boundary. The lower the score, the easier " +
+	"it is for an attacker to follow the path. For each pair of entry point and target only the easiest paths are listed, " +
+	"sorted from the lowest (easiest) to the highest score."
+
+func attackPathTitle(parsedModel *types.Model, attackPath *types.AttackPath) string {
+	titles := make([]string, 0, len(attackPath.TechnicalAssetIds))
+	for _, id := range attackPath.TechnicalAssetIds {
+		titles = append(titles, parsedModel.TechnicalAssets[id].Title)
+	}
+	return strings.Join(titles, " -> ")
+}
+
+func attackPathLinkText(parsedModel *types.Model, communicationLinkId string) string {
+	communicationLink := parsedModel.CommunicationLinks[communicationLinkId]
+	return communicationLink.Title + " (" + communicationLink.Protocol.String() + ", authentication: " + communicationLink.Authentication.String() + ")"
+}
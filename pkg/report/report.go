@@ -82,6 +82,9 @@ func (r *pdfReporter) WriteReportPDF(reportFilename string,
 	if err != nil {
 		return fmt.Errorf("error creating management summary: %w", err)
 	}
+	if model.ModelDiff != nil {
+		r.createModelDiff(model)
+	}
 	r.createImpactInitialRisks(model)
 	err = r.createRiskMitigationStatus(model, tempFolder)
 	if err != nil {
@@ -98,10 +101,23 @@ func (r *pdfReporter) WriteReportPDF(reportFilename string,
 	r.embedDataFlowDiagram(dataFlowDiagramFilenamePNG, tempFolder)
 	r.createSecurityRequirements(model)
 	r.createAbuseCases(model)
+	if model.IsTaraMode() {
+		r.createTara(model)
+	}
+	if len(model.CybersecurityGoals) > 0 {
+		r.createCybersecurityGoals(model)
+	}
+	if model.IsR155Mode() {
+		err = r.createR155Compliance(model)
+		if err != nil {
+			return fmt.Errorf("error creating UN R155 compliance: %w", err)
+		}
+	}
 	r.createTagListing(model)
 	r.createSTRIDE(model)
 	r.createAssignmentByFunction(model)
 	r.createRAA(model, introTextRAA)
+	r.createAttackPaths(model)
 	r.embedDataRiskMapping(dataAssetDiagramFilenamePNG, tempFolder)
 	//createDataRiskQuickWins()
 	r.createOutOfScopeAssets(model)
@@ -234,6 +250,14 @@ func (r *pdfReporter) createTableOfContents(parsedModel *types.Model) {
 	r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
 	r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
 
+	if parsedModel.ModelDiff != nil {
+		y += 6
+		r.pdf.Text(11, y, "    "+"Model Changes")
+		r.pdf.Text(175, y, "{model-changes}")
+		r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
+		r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
+	}
+
 	risksStr := "Risks"
 	catStr := "Categories"
 	count, catCount := totalRiskCount(parsedModel), len(parsedModel.GeneratedRisksByCategory)
@@ -300,6 +324,30 @@ func (r *pdfReporter) createTableOfContents(parsedModel *types.Model) {
 	r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
 	r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
 
+	if parsedModel.IsTaraMode() {
+		y += 6
+		r.pdf.Text(11, y, "    "+"TARA Damage Scenarios")
+		r.pdf.Text(175, y, "{tara}")
+		r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
+		r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
+	}
+
+	if len(parsedModel.CybersecurityGoals) > 0 {
+		y += 6
+		r.pdf.Text(11, y, "    "+"Cybersecurity Goals and Claims")
+		r.pdf.Text(175, y, "{cybersecurity-goals}")
+		r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
+		r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
+	}
+
+	if parsedModel.IsR155Mode() {
+		y += 6
+		r.pdf.Text(11, y, "    "+"UN R155 Annex 5 Compliance")
+		r.pdf.Text(175, y, "{r155}")
+		r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
+		r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
+	}
+
 	y += 6
 	r.pdf.Text(11, y, "    "+"Tag Listing")
 	r.pdf.Text(175, y, "{tag-listing}")
@@ -324,6 +372,12 @@ func (r *pdfReporter) createTableOfContents(parsedModel *types.Model) {
 	r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
 	r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
 
+	y += 6
+	r.pdf.Text(11, y, "    "+"Attack Paths")
+	r.pdf.Text(175, y, "{attack-paths}")
+	r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
+	r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
+
 	y += 6
 	r.pdf.Text(11, y, "    "+"Data Mapping")
 	r.pdf.Text(175, y, "{data-risk-mapping}")
@@ -1699,6 +1753,44 @@ func (r *pdfReporter) createRAA(parsedModel *types.Model, introTextRAA string) {
 	r.pdf.SetDashPattern([]float64{}, 0)
 }
 
+func (r *pdfReporter) createAttackPaths(parsedModel *types.Model) {
+	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
+	r.pdf.SetTextColor(0, 0, 0)
+	chapTitle := "Attack Paths"
+	r.addHeadline(chapTitle, false)
+	r.defineLinkTarget("{attack-paths}")
+	r.currentChapterTitleBreadcrumb = chapTitle
+
+	html := r.pdf.HTMLBasicNew()
+	html.Write(5, attackPathsIntroText)
+	r.pdf.SetFont("Helvetica", "", fontSizeSmall)
+	r.pdfColorGray()
+	html.Write(5, "<br>Attack path paragraphs are clickable and link to the corresponding chapter of the target.")
+	r.pdf.SetFont("Helvetica", "", fontSizeBody)
+	r.pdfColorBlack()
+
+	if len(parsedModel.AttackPaths) == 0 {
+		r.pdfColorLightGray()
+		html.Write(5, "<br><br>")
+		html.Write(5, "No attack paths from entry points to technical assets with high integrity requirements have been found.")
+		r.pdfColorBlack()
+	}
+	for _, attackPath := range parsedModel.AttackPaths {
+		if r.pdf.GetY() > 250 {
+			r.pageBreak()
+			r.pdf.SetY(36)
+		} else {
+			html.Write(5, "<br><br>")
+		}
+		posY := r.pdf.GetY()
+		html.Write(5, "<b>"+uni(attackPathTitle(parsedModel, attackPath))+"</b>: score "+strconv.Itoa(attackPath.Score)+"<br>")
+		for _, communicationLinkId := range attackPath.CommunicationLinkIds {
+			html.Write(5, uni(attackPathLinkText(parsedModel, communicationLinkId))+"<br>")
+		}
+		r.pdf.Link(9, posY, 190, r.pdf.GetY()-posY, r.tocLinkIdByAssetId[attackPath.TargetId])
+	}
+}
+
 /*
 func createDataRiskQuickWins() {
 	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
@@ -2378,6 +2470,151 @@ func (r *pdfReporter) createAbuseCases(parsedModel *types.Model) {
 		"taken into account as well. Also custom individual abuse cases might exist for the project.</i>")
 }
 
+func (r *pdfReporter) createTara(parsedModel *types.Model) {
+	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
+	r.pdf.SetTextColor(0, 0, 0)
+	chapTitle := "TARA Damage Scenarios"
+	r.addHeadline(chapTitle, false)
+	r.defineLinkTarget("{tara}")
+	r.currentChapterTitleBreadcrumb = chapTitle
+
+	html := r.pdf.HTMLBasicNew()
+	html.Write(5, "This chapter lists the damage scenarios of the threat analysis and risk assessment (TARA) according to ISO/SAE 21434. "+
+		"The impact of a damage scenario is the highest of its safety, financial, operational and privacy impact ratings. "+
+		"The risk value (1-5) of each risk linked to a damage scenario is taken from the risk matrix by impact and attack feasibility.")
+	r.pdfColorBlack()
+	for _, damageScenario := range parsedModel.SortedDamageScenarios() {
+		if r.pdf.GetY() > 250 {
+			r.pageBreak()
+			r.pdf.SetY(36)
+		} else {
+			html.Write(5, "<br><br><br>")
+		}
+		html.Write(5, "<b>"+uni(damageScenario.Title)+"</b> ("+damageScenario.Id+")<br>")
+		if len(damageScenario.Description) > 0 {
+			html.Write(5, uni(damageScenario.Description)+"<br>")
+		}
+		html.Write(5, "Impact is <i>"+damageScenario.Impact().Title()+"</i> (safety: "+damageScenario.Safety.Title()+
+			", financial: "+damageScenario.Financial.Title()+", operational: "+damageScenario.Operational.Title()+
+			", privacy: "+damageScenario.Privacy.Title()+").<br>")
+		risks := parsedModel.RisksOfDamageScenario(damageScenario)
+		if len(risks) == 0 {
+			r.pdfColorLightGray()
+			html.Write(5, "No risks are linked to this damage scenario.")
+			r.pdfColorBlack()
+		}
+		for _, risk := range risks {
+			html.Write(5, "<br>Risk value <b>"+strconv.Itoa(risk.Tara.RiskValue)+"</b> ("+risk.Tara.AttackFeasibility.Title()+
+				" attack feasibility): "+uni(risk.Title))
+		}
+	}
+}
+
+func (r *pdfReporter) createCybersecurityGoals(parsedModel *types.Model) {
+	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
+	r.pdf.SetTextColor(0, 0, 0)
+	chapTitle := "Cybersecurity Goals and Claims"
+	r.addHeadline(chapTitle, false)
+	r.defineLinkTarget("{cybersecurity-goals}")
+	r.currentChapterTitleBreadcrumb = chapTitle
+
+	html := r.pdf.HTMLBasicNew()
+	html.Write(5, "This chapter lists the cybersecurity goals derived from risks treated by reduction and the cybersecurity claims "+
+		"stating the rationale for risks being shared or retained according to ISO/SAE 21434, each with the risks covered by it.")
+	r.pdfColorBlack()
+	for _, goal := range parsedModel.SortedCybersecurityGoals() {
+		if r.pdf.GetY() > 250 {
+			r.pageBreak()
+			r.pdf.SetY(36)
+		} else {
+			html.Write(5, "<br><br><br>")
+		}
+		kind := "Goal"
+		if goal.Claim {
+			kind = "Claim"
+		}
+		html.Write(5, kind+": <b>"+uni(goal.Title)+"</b> ("+goal.Id+")<br>")
+		if len(goal.Description) > 0 {
+			html.Write(5, uni(goal.Description)+"<br>")
+		}
+		risks := parsedModel.RisksOfCybersecurityGoal(goal)
+		if len(risks) == 0 {
+			r.pdfColorLightGray()
+			html.Write(5, "No risks are covered by this "+goal.Kind()+".")
+			r.pdfColorBlack()
+		}
+		for _, risk := range risks {
+			tracking := parsedModel.GetRiskTrackingWithDefault(risk)
+			html.Write(5, "<br><i>"+risk.Severity.Title()+"</i> risk ("+tracking.Status.Title()+", treatment "+
+				tracking.Treatment.Title()+"): "+uni(risk.Title))
+		}
+	}
+}
+
+func (r *pdfReporter) createR155Compliance(parsedModel *types.Model) error {
+	catalog, err := types.LoadR155Annex5()
+	if err != nil {
+		return err
+	}
+
+	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
+	r.pdf.SetTextColor(0, 0, 0)
+	chapTitle := "UN R155 Annex 5 Compliance"
+	r.addHeadline(chapTitle, false)
+	r.defineLinkTarget("{r155}")
+	r.currentChapterTitleBreadcrumb = chapTitle
+
+	coverage := parsedModel.R155Coverage(catalog)
+	uncovered := 0
+	for _, threatCoverage := range coverage {
+		if !threatCoverage.IsCovered() {
+			uncovered++
+		}
+	}
+
+	html := r.pdf.HTMLBasicNew()
+	html.Write(5, "This chapter lists the threats of UN Regulation No. 155 Annex 5 (part A) with the mitigations to them (parts B and C), "+
+		"each with the risks identified by the risk rules mapped to the threat and their status from the risk tracking. "+
+		"Of the <b>"+strconv.Itoa(len(coverage))+"</b> threats, <b>"+strconv.Itoa(uncovered)+"</b> are not covered by any evaluated risk rule "+
+		"and need to be considered separately.")
+	r.pdfColorBlack()
+	for _, threatCoverage := range coverage {
+		threat := threatCoverage.Threat
+		if r.pdf.GetY() > 250 {
+			r.pageBreak()
+			r.pdf.SetY(36)
+		} else {
+			html.Write(5, "<br><br><br>")
+		}
+		if !threatCoverage.IsCovered() {
+			colorModelFailure(r.pdf)
+		}
+		html.Write(5, "<b>"+threat.Id+". "+uni(threat.Title)+"</b><br>")
+		r.pdfColorGray()
+		html.Write(5, uni(threat.Group)+"<br>")
+		r.pdfColorBlack()
+		for _, mitigation := range threat.Mitigations {
+			html.Write(5, "<i>"+mitigation+"</i>: "+uni(catalog.Mitigations[mitigation])+"<br>")
+		}
+		if !threatCoverage.IsCovered() {
+			colorModelFailure(r.pdf)
+			html.Write(5, "Not covered by any evaluated risk rule.")
+			r.pdfColorBlack()
+			continue
+		}
+		if len(threatCoverage.Risks) == 0 {
+			r.pdfColorLightGray()
+			html.Write(5, "No risks identified by "+strconv.Itoa(len(threatCoverage.RiskCategories))+" evaluated risk rules.")
+			r.pdfColorBlack()
+		}
+		for _, risk := range threatCoverage.Risks {
+			tracking := parsedModel.GetRiskTrackingWithDefault(risk)
+			html.Write(5, "<br><i>"+risk.Severity.Title()+"</i> risk ("+tracking.Status.Title()+"): "+uni(risk.Title))
+		}
+	}
+	return nil
+}
+
 func sortedKeysOfAbuseCases(parsedModel *types.Model) []string {
 	keys := make([]string, 0)
 	for k := range parsedModel.AbuseCases {
@@ -2727,6 +2964,9 @@ func (r *pdfReporter) createRiskCategories(parsedModel *types.Model) {
 			r.pdf.SetLeftMargin(oldLeft + 10)
 			r.pdf.SetFont("Helvetica", "", fontSizeBody)
 			text.WriteString(uni(risk.Title) + ": Exploitation likelihood is <i>" + risk.ExploitationLikelihood.Title() + "</i> with <i>" + risk.ExploitationImpact.Title() + "</i> impact.")
+			if risk.Tara != nil {
+				text.WriteString(" TARA risk value is <i>" + strconv.Itoa(risk.Tara.RiskValue) + "</i> with <i>" + risk.Tara.AttackFeasibility.Title() + "</i> attack feasibility.")
+			}
 			text.WriteString("<br>")
 			html.Write(5, text.String())
 			text.Reset()
@@ -2786,6 +3026,9 @@ func (r *pdfReporter) writeRiskTrackingStatus(parsedModel *types.Model, risk *ty
 		r.pdf.CellFormat(20, 4, dateStr, "0", 0, "B", false, 0, "")
 		r.pdf.CellFormat(35, 4, uni(tracking.CheckedBy), "0", 0, "B", false, 0, "")
 		r.pdf.CellFormat(35, 4, uni(tracking.Ticket), "0", 0, "B", false, 0, "")
+		if tracking.Treatment != types.UndecidedTreatment {
+			r.pdf.CellFormat(35, 4, "Treatment: "+tracking.Treatment.Title(), "0", 0, "B", false, 0, "")
+		}
 		r.pdf.Ln(-1)
 		r.pdfColorBlack()
 		r.pdf.CellFormat(10, 4, "", "0", 0, "", false, 0, "")
@@ -2951,6 +3194,9 @@ func (r *pdfReporter) createTechnicalAssets(parsedModel *types.Model) {
 				r.pdf.SetLeftMargin(oldLeft + 10)
 				r.pdf.SetFont("Helvetica", "", fontSizeBody)
 				text.WriteString(uni(risk.Title) + ": Exploitation likelihood is <i>" + risk.ExploitationLikelihood.Title() + "</i> with <i>" + risk.ExploitationImpact.Title() + "</i> impact.")
+				if risk.Tara != nil {
+					text.WriteString(" TARA risk value is <i>" + strconv.Itoa(risk.Tara.RiskValue) + "</i> with <i>" + risk.Tara.AttackFeasibility.Title() + "</i> attack feasibility.")
+				}
 				text.WriteString("<br>")
 				html.Write(5, text.String())
 				text.Reset()
@@ -3242,6 +3488,17 @@ func (r *pdfReporter) createTechnicalAssets(parsedModel *types.Model) {
 			r.pageBreak()
 			r.pdf.SetY(36)
 		}
+		if safetyIntegrityLevel := parsedModel.SafetyIntegrityLevelOfTechnicalAsset(technicalAsset); safetyIntegrityLevel != types.QM {
+			r.pdfColorGray()
+			r.pdf.CellFormat(5, 6, "", "0", 0, "", false, 0, "")
+			r.pdf.CellFormat(40, 6, "Safety Integrity:", "0", 0, "", false, 0, "")
+			r.pdfColorBlack()
+			r.pdf.MultiCell(145, 6, safetyIntegrityLevel.Title(), "0", "0", false)
+			if r.pdf.GetY() > 270 {
+				r.pageBreak()
+				r.pdf.SetY(36)
+			}
+		}
 		r.pdfColorGray()
 		r.pdf.CellFormat(5, 6, "", "0", 0, "", false, 0, "")
 		r.pdf.CellFormat(40, 6, "CIA-Justification:", "0", 0, "", false, 0, "")
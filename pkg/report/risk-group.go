@@ -84,6 +84,20 @@ func (what *RiskGroup) Write(excel *excelize.File, sheetName string, cellStyles
 	return writeError
 }
 
+// SortedItems lists the items ordered by the (nested) groups
+func (what *RiskGroup) SortedItems() []RiskItem {
+	if len(what.Groups) == 0 {
+		return what.Items
+	}
+
+	items := make([]RiskItem, 0, len(what.Items))
+	for _, group := range what.SortedGroups() {
+		items = append(items, what.Groups[group].SortedItems()...)
+	}
+
+	return items
+}
+
 func (what *RiskGroup) writeGroup(excel *excelize.File, sheetName string, cellStyles *ExcelStyles, excelRow int) (int, error) {
 	for _, risk := range what.Items {
 		excelRow++
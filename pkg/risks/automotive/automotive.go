@@ -0,0 +1,17 @@
+package automotive
+
+import (
+	"github.com/threagile/threagile/pkg/risks/xsam"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// Namespace of the risk category IDs of the built-in automotive threat catalog (generated by cmd/xsam-parser)
+const Namespace = "automotive"
+
+func GetAllAutomotiveRisks() []types.RiskRule {
+	rules := make([]types.RiskRule, 0, len(threats))
+	for _, threat := range threats {
+		rules = append(rules, xsam.NewRiskRule(Namespace, threat))
+	}
+	return rules
+}
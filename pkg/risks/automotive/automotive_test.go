@@ -0,0 +1,29 @@
+package automotive
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/risks/xsam"
+)
+
+// every threat of the catalog has to be restricted to the vehicle components it applies to, as threats without
+// applicability are raised for any "automotive" tagged technical asset
+func TestThreatsHaveApplicability(t *testing.T) {
+	mapping, err := xsam.LoadThreatClassMapping("")
+	assert.NoError(t, err)
+
+	for _, threat := range threats {
+		assert.NotEmpty(t, threat.Applicability, threat.ID)
+
+		_, parseError := xsam.ParseApplicability(threat.Applicability)
+		assert.NoError(t, parseError, threat.ID)
+
+		// the generated threats are in sync with the built-in mapping (threat class names are dot separated)
+		classification, _, classifyError := mapping.Classify(xsam.ThreatClass{Name: strings.ReplaceAll(threat.ID, "-", ".")})
+		assert.NoError(t, classifyError, threat.ID)
+		assert.Equal(t, classification.Applicability, threat.Applicability, threat.ID)
+	}
+}
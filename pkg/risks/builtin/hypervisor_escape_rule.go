@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"sort"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type HypervisorEscapeRule struct{}
+
+func NewHypervisorEscapeRule() *HypervisorEscapeRule {
+	return &HypervisorEscapeRule{}
+}
+
+func (*HypervisorEscapeRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "hypervisor-escape",
+		Title: "Hypervisor Escape",
+		Description: "Hypervisors are especially interesting targets for attackers as they host all virtual machines (VMs) of a system. " +
+			"Attackers having compromised a less protected VM (like a QM Linux VM with connectivity) might exploit a vulnerability of the " +
+			"hypervisor (like in virtual device emulation, paravirtualized drivers or hypercall handlers) to escape the VM. " +
+			"Owning the hypervisor equals to owning every VM hosted by it, including safety relevant ones.",
+		Impact: "If this risk is unmitigated, attackers which have successfully compromised a VM (via other vulnerabilities) " +
+			"might be able to read or tamper with the memory of all co-hosted VMs and deeply persist in the hypervisor itself.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Attack_Surface_Analysis_Cheat_Sheet.html",
+		Action:     "Hypervisor Hardening",
+		Mitigation: "Use a small (ideally formally verified or safety certified) Type-1 hypervisor or separation kernel, " +
+			"keep its attack surface minimal by avoiding emulated devices and unnecessary hypercalls, " +
+			"enforce memory and I/O isolation via the stage-2 MMU and IOMMU (SMMU), and apply security updates to the hypervisor " +
+			"as fast as to the VMs. Do not let VMs of different trust levels share devices without a mediating, hardened driver domain.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope hypervisors (technologies with the " + types.Hypervisor + " attribute). The virtual machines hosted " +
+			"by a hypervisor are the " + types.ExecutionEnvironment.String() + " trust boundaries nested inside the trust boundary " +
+			"directly containing the hypervisor.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality and integrity of the technical assets " +
+			"(and the data assets processed by them) running in the co-hosted virtual machines and of the hypervisor itself.",
+		FalsePositives: "Hypervisors hosting only virtual machines of the same trust level can be considered " +
+			"as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        1189,
+	}
+}
+
+func (*HypervisorEscapeRule) SupportedTags() []string {
+	return []string{"xen", "kvm", "pikeos"}
+}
+
+func (r *HypervisorEscapeRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		technicalAsset := input.TechnicalAssets[id]
+		if technicalAsset.OutOfScope || !technicalAsset.Technologies.GetAttribute(types.Hypervisor) {
+			continue
+		}
+		risks = append(risks, r.createRisk(input, technicalAsset, hostedVirtualMachines(input, technicalAsset)))
+	}
+	return risks, nil
+}
+
+// hostedVirtualMachines returns the execution environment trust boundaries nested inside the trust boundary directly
+// containing the hypervisor
+func hostedVirtualMachines(input *types.Model, hypervisor *types.TechnicalAsset) []*types.TrustBoundary {
+	virtualMachines := make([]*types.TrustBoundary, 0)
+	trustBoundary, ok := input.DirectContainingTrustBoundaryMappedByTechnicalAssetId[hypervisor.Id]
+	if !ok {
+		return virtualMachines
+	}
+	for _, nestedId := range trustBoundary.TrustBoundariesNested {
+		if nested, ok := input.TrustBoundaries[nestedId]; ok && nested.Type == types.ExecutionEnvironment {
+			virtualMachines = append(virtualMachines, nested)
+		}
+	}
+	sort.Slice(virtualMachines, func(i, j int) bool {
+		return virtualMachines[i].Title < virtualMachines[j].Title
+	})
+	return virtualMachines
+}
+
+func (r *HypervisorEscapeRule) createRisk(input *types.Model, hypervisor *types.TechnicalAsset, virtualMachines []*types.TrustBoundary) *types.Risk {
+	highestConfidentiality := input.HighestProcessedConfidentiality(hypervisor)
+	highestIntegrity := input.HighestProcessedIntegrity(hypervisor)
+	dataBreachTechnicalAssetIDs := []string{hypervisor.Id}
+	title := "<b>Hypervisor Escape</b> risk at <b>" + hypervisor.Title + "</b>"
+	for i, virtualMachine := range virtualMachines {
+		highestConfidentiality = max(highestConfidentiality, input.FindTrustBoundaryHighestConfidentiality(virtualMachine))
+		highestIntegrity = max(highestIntegrity, input.FindTrustBoundaryHighestIntegrity(virtualMachine))
+		dataBreachTechnicalAssetIDs = append(dataBreachTechnicalAssetIDs, input.RecursivelyAllTechnicalAssetIDsInside(virtualMachine)...)
+		if i == 0 {
+			title += " hosting "
+		} else {
+			title += ", "
+		}
+		title += "<b>" + virtualMachine.Title + "</b>"
+	}
+
+	impact := types.MediumImpact
+	if highestConfidentiality == types.StrictlyConfidential || highestIntegrity == types.MissionCritical {
+		impact = types.VeryHighImpact
+	} else if highestConfidentiality >= types.Confidential || highestIntegrity >= types.Critical {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: hypervisor.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  dataBreachTechnicalAssetIDs,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + hypervisor.Id
+	return risk
+}
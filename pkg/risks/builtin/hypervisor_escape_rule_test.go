@@ -0,0 +1,135 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestHypervisorEscapeRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewHypervisorEscapeRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func createHypervisorEscapeTestModel(outOfScope bool, linuxConfidentiality types.Confidentiality, rtosIntegrity types.Criticality) *types.Model {
+	hypervisorTechnology := types.TechnologyList{
+		{
+			Name:       "hypervisor",
+			Attributes: map[string]bool{types.Hypervisor: true},
+		},
+	}
+	cores := &types.TrustBoundary{
+		Id:                    "cores",
+		Title:                 "Application Cores",
+		Type:                  types.ExecutionEnvironment,
+		TechnicalAssetsInside: []string{"hypervisor"},
+		TrustBoundariesNested: []string{"vm2", "vm1", "network"},
+	}
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"hypervisor": {Id: "hypervisor", Title: "Type-1 Hypervisor", OutOfScope: outOfScope, Technologies: hypervisorTechnology},
+			"linux":      {Id: "linux", Title: "Linux Agent", Confidentiality: linuxConfidentiality},
+			"rtos":       {Id: "rtos", Title: "Vehicle Control", Integrity: rtosIntegrity},
+			"switch":     {Id: "switch", Title: "Ethernet Switch", Confidentiality: types.StrictlyConfidential},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{
+			"cores":   cores,
+			"vm1":     {Id: "vm1", Title: "VM1: Linux", Type: types.ExecutionEnvironment, TechnicalAssetsInside: []string{"linux"}},
+			"vm2":     {Id: "vm2", Title: "VM2: RTOS", Type: types.ExecutionEnvironment, TechnicalAssetsInside: []string{"rtos"}},
+			"network": {Id: "network", Title: "Network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"switch"}},
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"hypervisor": cores,
+		},
+	}
+}
+
+func TestHypervisorEscapeRuleGenerateRisksOutOfScopeNotRisksCreated(t *testing.T) {
+	rule := NewHypervisorEscapeRule()
+
+	risks, err := rule.GenerateRisks(createHypervisorEscapeTestModel(true, types.Internal, types.Critical))
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestHypervisorEscapeRuleGenerateRisksNotHypervisorNotRisksCreated(t *testing.T) {
+	rule := NewHypervisorEscapeRule()
+	model := createHypervisorEscapeTestModel(false, types.Internal, types.Critical)
+	model.TechnicalAssets["hypervisor"].Technologies = types.TechnologyList{{Name: "linux"}}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestHypervisorEscapeRuleGenerateRisksWithoutTrustBoundaryRisksCreated(t *testing.T) {
+	rule := NewHypervisorEscapeRule()
+	model := createHypervisorEscapeTestModel(false, types.Internal, types.Critical)
+	model.DirectContainingTrustBoundaryMappedByTechnicalAssetId = map[string]*types.TrustBoundary{}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Hypervisor Escape</b> risk at <b>Type-1 Hypervisor</b>", risks[0].Title)
+	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, []string{"hypervisor"}, risks[0].DataBreachTechnicalAssetIDs)
+}
+
+func TestHypervisorEscapeRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]struct {
+		linuxConfidentiality types.Confidentiality
+		rtosIntegrity        types.Criticality
+		expectedImpact       types.RiskExploitationImpact
+	}{
+		"internal and important": {
+			linuxConfidentiality: types.Internal,
+			rtosIntegrity:        types.Important,
+			expectedImpact:       types.MediumImpact,
+		},
+		"confidential": {
+			linuxConfidentiality: types.Confidential,
+			rtosIntegrity:        types.Important,
+			expectedImpact:       types.HighImpact,
+		},
+		"critical": {
+			linuxConfidentiality: types.Internal,
+			rtosIntegrity:        types.Critical,
+			expectedImpact:       types.HighImpact,
+		},
+		"strictly confidential": {
+			linuxConfidentiality: types.StrictlyConfidential,
+			rtosIntegrity:        types.Important,
+			expectedImpact:       types.VeryHighImpact,
+		},
+		"mission critical": {
+			linuxConfidentiality: types.Internal,
+			rtosIntegrity:        types.MissionCritical,
+			expectedImpact:       types.VeryHighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewHypervisorEscapeRule()
+
+			risks, err := rule.GenerateRisks(createHypervisorEscapeTestModel(false, testCase.linuxConfidentiality, testCase.rtosIntegrity))
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, 1)
+			assert.Equal(t, "hypervisor-escape@hypervisor", risks[0].SyntheticId)
+			assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+			assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+			assert.Equal(t, []string{"hypervisor", "linux", "rtos"}, risks[0].DataBreachTechnicalAssetIDs)
+			expTitle := "<b>Hypervisor Escape</b> risk at <b>Type-1 Hypervisor</b> hosting <b>VM1: Linux</b>, <b>VM2: RTOS</b>"
+			assert.Equal(t, expTitle, risks[0].Title)
+		})
+	}
+}
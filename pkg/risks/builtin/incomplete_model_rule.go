@@ -1,6 +1,9 @@
 package builtin
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -14,17 +17,19 @@ func (*IncompleteModelRule) Category() *types.RiskCategory {
 	return &types.RiskCategory{
 		ID:    "incomplete-model",
 		Title: "Incomplete Model",
-		Description: "When the threat model contains unknown technologies or transfers data over unknown protocols, this is " +
-			"an indicator for an incomplete model.",
-		Impact:                     "If this risk is unmitigated, other risks might not be noticed as the model is incomplete.",
-		ASVS:                       "V1 - Architecture, Design and Threat Modeling Requirements",
-		CheatSheet:                 "https://cheatsheetseries.owasp.org/cheatsheets/Threat_Modeling_Cheat_Sheet.html",
-		Action:                     "Threat Modeling Completeness",
-		Mitigation:                 "Try to find out what technology or protocol is used instead of specifying that it is unknown.",
-		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
-		Function:                   types.Architecture,
-		STRIDE:                     types.InformationDisclosure,
-		DetectionLogic:             "All technical assets and communication links with technology type or protocol type specified as unknown.",
+		Description: "When the threat model contains unknown technologies, transfers data over unknown protocols or contains CIA " +
+			"ratings marked as " + types.TodoMarker + " (like generated model stubs), this is an indicator for an incomplete model.",
+		Impact:     "If this risk is unmitigated, other risks might not be noticed as the model is incomplete.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Threat_Modeling_Cheat_Sheet.html",
+		Action:     "Threat Modeling Completeness",
+		Mitigation: "Try to find out what technology or protocol is used instead of specifying that it is unknown. " +
+			"Rate the confidentiality, integrity and availability of assets marked as " + types.TodoMarker + " and justify the rating.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "All technical assets and communication links with technology type or protocol type specified as unknown. " +
+			"Also all technical assets and data assets with a CIA rating justification marked as " + types.TodoMarker + ".",
 		RiskAssessment:             types.LowSeverity.String(),
 		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
 		ModelFailurePossibleReason: true,
@@ -51,10 +56,29 @@ func (r *IncompleteModelRule) GenerateRisks(input *types.Model) ([]*types.Risk,
 				risks = append(risks, r.createRiskCommLink(technicalAsset, commLink))
 			}
 		}
+		if isMarkedAsTodo(technicalAsset.JustificationCiaRating) {
+			risks = append(risks, r.createRiskUnratedTechAsset(technicalAsset))
+		}
+	}
+	// as in Go ranging over map is random order, range over them in sorted (hence reproducible) way:
+	keys := make([]string, 0)
+	for k := range input.DataAssets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		dataAsset := input.DataAssets[key]
+		if isMarkedAsTodo(dataAsset.JustificationCiaRating) {
+			risks = append(risks, r.createRiskUnratedDataAsset(dataAsset))
+		}
 	}
 	return risks, nil
 }
 
+func isMarkedAsTodo(text string) bool {
+	return strings.HasPrefix(strings.TrimSpace(text), types.TodoMarker)
+}
+
 func (im IncompleteModelRule) skipAsset(technicalAsset *types.TechnicalAsset) bool {
 	return technicalAsset.OutOfScope
 }
@@ -91,3 +115,35 @@ func (r *IncompleteModelRule) createRiskCommLink(technicalAsset *types.Technical
 	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *IncompleteModelRule) createRiskUnratedTechAsset(technicalAsset *types.TechnicalAsset) *types.Risk {
+	title := "<b>Unrated CIA</b> marked as " + types.TodoMarker + " at technical asset <b>" + technicalAsset.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, types.LowImpact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           types.LowImpact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{technicalAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@cia-technical-asset@" + technicalAsset.Id
+	return risk
+}
+
+func (r *IncompleteModelRule) createRiskUnratedDataAsset(dataAsset *types.DataAsset) *types.Risk {
+	title := "<b>Unrated CIA</b> marked as " + types.TodoMarker + " at data asset <b>" + dataAsset.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                  r.Category().ID,
+		Severity:                    types.CalculateSeverity(types.Unlikely, types.LowImpact),
+		ExploitationLikelihood:      types.Unlikely,
+		ExploitationImpact:          types.LowImpact,
+		Title:                       title,
+		MostRelevantDataAssetId:     dataAsset.Id,
+		DataBreachProbability:       types.Improbable,
+		DataBreachTechnicalAssetIDs: []string{},
+	}
+	risk.SyntheticId = risk.CategoryId + "@cia-data-asset@" + dataAsset.Id
+	return risk
+}
@@ -179,3 +179,46 @@ func TestIncompleteModelRuleGenerateRisksUnknownProtocolCommunicationLinksRisksC
 	assert.Equal(t, "<b>Unknown Protocol</b> specified for communication link <b>Test Communication Link</b> at technical asset <b>Test Technical Asset</b>", risks[0].Title)
 	assert.Equal(t, types.LowImpact, risks[0].ExploitationImpact)
 }
+
+func TestIncompleteModelRuleGenerateRisksCiaRatingMarkedAsTodoRisksCreated(t *testing.T) {
+	rule := NewIncompleteModelRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ta1": {
+				Id:                     "ta1",
+				Title:                  "Test Technical Asset",
+				JustificationCiaRating: "TODO: rate confidentiality, integrity and availability",
+				Technologies: types.TechnologyList{
+					{
+						Name: "tool",
+						Attributes: map[string]bool{
+							types.UnknownTechnology: false,
+						},
+					},
+				},
+			},
+		},
+		DataAssets: map[string]*types.DataAsset{
+			"da1": {
+				Id:                     "da1",
+				Title:                  "Test Data Asset",
+				JustificationCiaRating: " TODO",
+			},
+			"da2": {
+				Id:                     "da2",
+				Title:                  "Rated Data Asset",
+				JustificationCiaRating: "Rated by the product owner",
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 2)
+	assert.Equal(t, "<b>Unrated CIA</b> marked as TODO at technical asset <b>Test Technical Asset</b>", risks[0].Title)
+	assert.Equal(t, "incomplete-model@cia-technical-asset@ta1", risks[0].SyntheticId)
+	assert.Equal(t, "<b>Unrated CIA</b> marked as TODO at data asset <b>Test Data Asset</b>", risks[1].Title)
+	assert.Equal(t, "da1", risks[1].MostRelevantDataAssetId)
+	assert.Equal(t, "incomplete-model@cia-data-asset@da1", risks[1].SyntheticId)
+	assert.Equal(t, types.LowImpact, risks[1].ExploitationImpact)
+}
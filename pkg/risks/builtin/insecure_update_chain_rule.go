@@ -0,0 +1,353 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type InsecureUpdateChainRule struct{}
+
+func NewInsecureUpdateChainRule() *InsecureUpdateChainRule {
+	return &InsecureUpdateChainRule{}
+}
+
+const (
+	updateFirmwareTag              = "firmware"
+	updateSoftwareUpdateTag        = "software-update"
+	updateSignatureVerificationTag = "signature-verification"
+	updateRollbackProtectionTag    = "rollback-protection"
+
+	maxUpdatePathLength        = 8 // in communication links
+	maxUpdatePathsPerDataAsset = 64
+)
+
+func (*InsecureUpdateChainRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "insecure-update-chain",
+		Title: "Insecure Update Chain",
+		Description: "Software and firmware updates (like over-the-air updates of vehicles) pass several technical assets " +
+			"between the update server and the component finally installing them. Unless the final consumer verifies " +
+			"the signature of the update end-to-end and rejects older versions, every asset along the update path is " +
+			"able to install malicious or vulnerable software.",
+		Impact: "If this risk is unmitigated, attackers might be able to install manipulated software or to downgrade " +
+			"components to versions with known vulnerabilities, thereby persistently taking over the updated component.",
+		ASVS:       "V10 - Malicious Code Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Vulnerable_Dependency_Management_Cheat_Sheet.html",
+		Action:     "Secure Update Chain",
+		Mitigation: "Sign updates at the origin and verify the signature end-to-end at the component installing the update " +
+			"(with keys anchored in a hardware trust anchor), enforce rollback protection via monotonic version counters, " +
+			"let the component to update pull the update instead of having it pushed, and avoid relaying updates via " +
+			"technical assets with a lower integrity rating than the updated component (like using Uptane director and image " +
+			"repositories).",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "Update paths of data assets tagged with '" + updateFirmwareTag + "' or '" + updateSoftwareUpdateTag + "' " +
+			"along the communication links sending or receiving them, from the asset originating the update (the technical " +
+			"asset named by the 'origin' of the data asset, or else the technical assets storing it) to the in-scope " +
+			"technical asset finally consuming it. A risk is raised per path when the final consumer is not tagged with " +
+			"'" + updateSignatureVerificationTag + "' or not tagged with '" + updateRollbackProtectionTag + "', when the path passes " +
+			"technical assets with a lower integrity rating than the final consumer, or when the update is pushed " +
+			"by the originating asset instead of being pulled. At most " + fmt.Sprint(maxUpdatePathsPerDataAsset) + " paths of at " +
+			"most " + fmt.Sprint(maxUpdatePathLength) + " communication links are analyzed per data asset, an additional risk " +
+			"is raised for data assets with more update paths, so that these are reviewed manually.",
+		RiskAssessment: "The risk rating depends on the integrity rating of the final consumer and of the update data asset. " +
+			"Paths without end-to-end signature verification are rated as more likely.",
+		FalsePositives: "Update paths where the final consumer verifies the update by other means (like a secure boot chain " +
+			"verifying the installed image before execution) can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        494,
+	}
+}
+
+func (*InsecureUpdateChainRule) SupportedTags() []string {
+	return []string{updateFirmwareTag, updateSoftwareUpdateTag, updateSignatureVerificationTag, updateRollbackProtectionTag}
+}
+
+// updateHop is a single step of an update path, either pushed via a sent data asset or pulled via a received one
+type updateHop struct {
+	link *types.CommunicationLink
+	from *types.TechnicalAsset
+	to   *types.TechnicalAsset
+	push bool
+}
+
+func (r *InsecureUpdateChainRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, dataAsset := range updateDataAssets(input) {
+		syntheticIds := make(map[string]bool) // paths via parallel communication links pass the same technical assets
+		search := updatePaths(input, dataAsset)
+		for _, path := range search.paths {
+			if risk := r.createRisk(dataAsset, path); risk != nil && !syntheticIds[risk.SyntheticId] {
+				syntheticIds[risk.SyntheticId] = true
+				risks = append(risks, risk)
+			}
+		}
+		if search.truncated {
+			risks = append(risks, r.createRiskTruncated(dataAsset))
+		}
+	}
+	return risks, nil
+}
+
+// updateDataAssets returns the data assets tagged as updates, sorted by id
+func updateDataAssets(input *types.Model) []*types.DataAsset {
+	// as in Go ranging over map is random order, range over them in sorted (hence reproducible) way:
+	keys := make([]string, 0)
+	for k := range input.DataAssets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	dataAssets := make([]*types.DataAsset, 0)
+	for _, key := range keys {
+		if input.DataAssets[key].IsTaggedWithAny(updateFirmwareTag, updateSoftwareUpdateTag) {
+			dataAssets = append(dataAssets, input.DataAssets[key])
+		}
+	}
+	return dataAssets
+}
+
+// updatePathSearch collects the update paths of a data asset
+type updatePathSearch struct {
+	hops         map[string][]*updateHop
+	paths        [][]*updateHop
+	originReason string // how the technical assets originating the update were chosen
+	truncated    bool   // more than maxUpdatePathsPerDataAsset paths, the others not being analyzed
+}
+
+// updatePaths searches the paths the data asset takes from the technical assets originating it to the technical assets
+// finally consuming it (having no further outgoing hop), at most maxUpdatePathsPerDataAsset paths of at most
+// maxUpdatePathLength hops
+func updatePaths(input *types.Model, dataAsset *types.DataAsset) *updatePathSearch {
+	hops := make(map[string][]*updateHop)
+	hasIncomingHop := make(map[string]bool)
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		for _, link := range input.TechnicalAssets[id].CommunicationLinksSorted() {
+			source, sourceOk := input.TechnicalAssets[link.SourceId]
+			target, targetOk := input.TechnicalAssets[link.TargetId]
+			if !sourceOk || !targetOk {
+				continue
+			}
+			if contains(link.DataAssetsSent, dataAsset.Id) {
+				hops[source.Id] = append(hops[source.Id], &updateHop{link: link, from: source, to: target, push: true})
+				hasIncomingHop[target.Id] = true
+			}
+			if contains(link.DataAssetsReceived, dataAsset.Id) {
+				hops[target.Id] = append(hops[target.Id], &updateHop{link: link, from: target, to: source})
+				hasIncomingHop[source.Id] = true
+			}
+		}
+	}
+
+	search := &updatePathSearch{hops: hops, paths: make([][]*updateHop, 0)}
+	origins, originReason := updateOrigins(input, dataAsset, hasIncomingHop)
+	search.originReason = originReason
+	for _, id := range origins {
+		search.collect(make([]*updateHop, 0), map[string]bool{id: true}, id)
+	}
+	return search
+}
+
+// updateOrigins returns the technical assets originating the data asset: the one whose id or title is the origin of the
+// data asset, else the ones storing it, else (for models not telling) the ones passing it on without receiving it
+func updateOrigins(input *types.Model, dataAsset *types.DataAsset, hasIncomingHop map[string]bool) ([]string, string) {
+	named := make([]string, 0)
+	storing := make([]string, 0)
+	notReceiving := make([]string, 0)
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		technicalAsset := input.TechnicalAssets[id]
+		if len(dataAsset.Origin) > 0 && (technicalAsset.Id == dataAsset.Origin || technicalAsset.Title == dataAsset.Origin) {
+			named = append(named, id)
+		}
+		if contains(technicalAsset.DataAssetsStored, dataAsset.Id) {
+			storing = append(storing, id)
+		}
+		if !hasIncomingHop[id] {
+			notReceiving = append(notReceiving, id)
+		}
+	}
+	if len(named) > 0 {
+		return named, "named by the origin of the data asset"
+	}
+	if len(storing) > 0 {
+		return storing, "storing the data asset"
+	}
+	return notReceiving, "passing the data asset on without receiving it"
+}
+
+func (what *updatePathSearch) collect(path []*updateHop, visited map[string]bool, current string) {
+	if len(what.paths) >= maxUpdatePathsPerDataAsset {
+		// any path reaching this point or leaving it is one more
+		what.truncated = what.truncated || len(path) > 0 || len(what.hops[current]) > 0
+		return
+	}
+	extended := false
+	if len(path) < maxUpdatePathLength {
+		for _, hop := range what.hops[current] {
+			if visited[hop.to.Id] {
+				continue
+			}
+			extended = true
+			visited[hop.to.Id] = true
+			what.collect(append(path[:len(path):len(path)], hop), visited, hop.to.Id)
+			visited[hop.to.Id] = false
+		}
+	}
+	if !extended && len(path) > 0 {
+		what.paths = append(what.paths, path)
+	}
+}
+
+func (r *InsecureUpdateChainRule) createRisk(dataAsset *types.DataAsset, path []*updateHop) *types.Risk {
+	origin := path[0].from
+	lastHop := path[len(path)-1]
+	consumer := lastHop.to
+	if consumer.OutOfScope {
+		return nil
+	}
+
+	findings := make([]string, 0)
+	likelihood := types.Unlikely
+	if !consumer.IsTaggedWithAny(updateSignatureVerificationTag) {
+		findings = append(findings, "missing end-to-end signature verification")
+		likelihood = types.Likely
+	}
+	if !consumer.IsTaggedWithAny(updateRollbackProtectionTag) {
+		findings = append(findings, "missing rollback protection")
+	}
+	lowerIntegrityAssets := make([]string, 0)
+	for _, hop := range path {
+		if hop.from.Integrity < consumer.Integrity {
+			lowerIntegrityAssets = append(lowerIntegrityAssets, "<b>"+hop.from.Title+"</b>")
+		}
+	}
+	if len(lowerIntegrityAssets) > 0 {
+		findings = append(findings, "passing lower-integrity "+strings.Join(lowerIntegrityAssets, ", "))
+	}
+	if path[0].push {
+		findings = append(findings, "pushed instead of pulled")
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+
+	impact := types.MediumImpact
+	highestIntegrity := max(consumer.Integrity, dataAsset.Integrity)
+	if highestIntegrity == types.MissionCritical {
+		impact = types.VeryHighImpact
+	} else if highestIntegrity == types.Critical {
+		impact = types.HighImpact
+	}
+
+	pathIds := []string{origin.Id}
+	title := "<b>Insecure Update Chain</b> of <b>" + dataAsset.Title + "</b> from <b>" + origin.Title + "</b>"
+	for i, hop := range path {
+		pathIds = append(pathIds, hop.to.Id)
+		if i == 0 && len(path) > 1 {
+			title += " via "
+		} else if i > 0 && i < len(path)-1 {
+			title += ", "
+		}
+		if i < len(path)-1 {
+			title += "<b>" + hop.to.Title + "</b>"
+		}
+	}
+	title += " to <b>" + consumer.Title + "</b>: " + strings.Join(findings, ", ")
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantDataAssetId:         dataAsset.Id,
+		MostRelevantTechnicalAssetId:    consumer.Id,
+		MostRelevantCommunicationLinkId: lastHop.link.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     []string{consumer.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + dataAsset.Id + "@" + strings.Join(pathIds, "@")
+	return risk
+}
+
+// createRiskTruncated flags a data asset with more update paths than analyzed
+func (r *InsecureUpdateChainRule) createRiskTruncated(dataAsset *types.DataAsset) *types.Risk {
+	risk := &types.Risk{
+		CategoryId:             r.Category().ID,
+		Severity:               types.CalculateSeverity(types.Unlikely, types.LowImpact),
+		ExploitationLikelihood: types.Unlikely,
+		ExploitationImpact:     types.LowImpact,
+		Title: "<b>Insecure Update Chain</b> of <b>" + dataAsset.Title + "</b> not fully analyzed: more than " +
+			fmt.Sprint(maxUpdatePathsPerDataAsset) + " update paths, the others have to be reviewed manually",
+		MostRelevantDataAssetId:     dataAsset.Id,
+		DataBreachProbability:       types.Improbable,
+		DataBreachTechnicalAssetIDs: []string{},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + dataAsset.Id
+	return risk
+}
+
+// ExplainRisk states the conditions the update paths of the risks with a synthetic id matching the given one met
+func (r *InsecureUpdateChainRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	explained := make(map[string]bool)
+	for _, dataAsset := range updateDataAssets(parsedModel) {
+		search := updatePaths(parsedModel, dataAsset)
+		for _, path := range search.paths {
+			generatedRisk := r.createRisk(dataAsset, path)
+			if generatedRisk == nil || explained[generatedRisk.SyntheticId] || !types.MatchesSyntheticRiskId(risk, generatedRisk.SyntheticId) {
+				continue
+			}
+			explained[generatedRisk.SyntheticId] = true
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+			explanation = append(explanation, r.explainPath(dataAsset, path, search.originReason)...)
+		}
+		if search.truncated && types.MatchesSyntheticRiskId(risk, r.createRiskTruncated(dataAsset).SyntheticId) {
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+			explanation = append(explanation,
+				fmt.Sprintf("update paths of data asset %q", dataAsset.Id),
+				fmt.Sprintf("  - data asset tags: %v (has either [%q, %q])", strings.Join(dataAsset.Tags, ", "), updateFirmwareTag, updateSoftwareUpdateTag),
+				fmt.Sprintf("  - update paths: more than %d (only the first %d analyzed)", maxUpdatePathsPerDataAsset, maxUpdatePathsPerDataAsset))
+		}
+	}
+	return explanation
+}
+
+func (r *InsecureUpdateChainRule) explainPath(dataAsset *types.DataAsset, path []*updateHop, originReason string) []string {
+	origin := path[0].from
+	consumer := path[len(path)-1].to
+	pathIds := []string{origin.Id}
+	for _, hop := range path {
+		pathIds = append(pathIds, hop.to.Id)
+	}
+
+	explanation := []string{
+		fmt.Sprintf("update path %q of data asset %q", strings.Join(pathIds, " -> "), dataAsset.Id),
+		fmt.Sprintf("  - data asset tags: %v (has either [%q, %q])", strings.Join(dataAsset.Tags, ", "), updateFirmwareTag, updateSoftwareUpdateTag),
+		fmt.Sprintf("  - origin: %q (%v)", origin.Id, originReason),
+		fmt.Sprintf("  - final consumer: %q (in scope, not passing the update on)", consumer.Id),
+	}
+	if !consumer.IsTaggedWithAny(updateSignatureVerificationTag) {
+		explanation = append(explanation, fmt.Sprintf("  - final consumer tags: %v (lacks %q)", strings.Join(consumer.Tags, ", "), updateSignatureVerificationTag))
+	}
+	if !consumer.IsTaggedWithAny(updateRollbackProtectionTag) {
+		explanation = append(explanation, fmt.Sprintf("  - final consumer tags: %v (lacks %q)", strings.Join(consumer.Tags, ", "), updateRollbackProtectionTag))
+	}
+	for _, hop := range path {
+		if hop.from.Integrity < consumer.Integrity {
+			explanation = append(explanation, fmt.Sprintf("  - integrity of %q: %v (<%v of the final consumer)", hop.from.Id, hop.from.Integrity, consumer.Integrity))
+		}
+	}
+	if path[0].push {
+		explanation = append(explanation, fmt.Sprintf("  - communication link %q: sends the update (pushed by the origin instead of pulled)", path[0].link.Id))
+	}
+	return explanation
+}
@@ -0,0 +1,314 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestInsecureUpdateChainRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+// createUpdateChainTestModel creates the chain backend -> agent -> ecu, where the agent pulls the update from the backend
+// and pushes it to the ecu
+func createUpdateChainTestModel() *types.Model {
+	pull := &types.CommunicationLink{
+		Id:                 "agent>pull",
+		Title:              "Pull",
+		SourceId:           "agent",
+		TargetId:           "backend",
+		Readonly:           true,
+		DataAssetsReceived: []string{"firmware"},
+	}
+	flash := &types.CommunicationLink{
+		Id:             "agent>flash",
+		Title:          "Flash",
+		SourceId:       "agent",
+		TargetId:       "ecu",
+		DataAssetsSent: []string{"firmware"},
+	}
+	return &types.Model{
+		DataAssets: map[string]*types.DataAsset{
+			"firmware": {Id: "firmware", Title: "Firmware Image", Tags: []string{"firmware"}, Integrity: types.Critical},
+			"logs":     {Id: "logs", Title: "Logs", Integrity: types.Operational},
+		},
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"backend": {Id: "backend", Title: "Update Backend", OutOfScope: true, Integrity: types.Critical},
+			"agent":   {Id: "agent", Title: "Update Agent", Integrity: types.Critical, CommunicationLinks: []*types.CommunicationLink{pull, flash}},
+			"ecu": {
+				Id:        "ecu",
+				Title:     "Brake ECU",
+				Integrity: types.Critical,
+				Tags:      []string{"signature-verification", "rollback-protection"},
+			},
+		},
+	}
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksSecureChainNotRisksCreated(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+
+	risks, err := rule.GenerateRisks(createUpdateChainTestModel())
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksNotUpdateDataAssetNotRisksCreated(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.DataAssets["firmware"].Tags = []string{}
+	model.TechnicalAssets["ecu"].Tags = []string{}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksOutOfScopeConsumerNotRisksCreated(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.TechnicalAssets["ecu"].Tags = []string{}
+	model.TechnicalAssets["ecu"].OutOfScope = true
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksMissingSignatureVerificationRisksCreated(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.TechnicalAssets["ecu"].Tags = []string{"rollback-protection"}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Insecure Update Chain</b> of <b>Firmware Image</b> from <b>Update Backend</b> via <b>Update Agent</b> "+
+		"to <b>Brake ECU</b>: missing end-to-end signature verification", risks[0].Title)
+	assert.Equal(t, "insecure-update-chain@firmware@backend@agent@ecu", risks[0].SyntheticId)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, "firmware", risks[0].MostRelevantDataAssetId)
+	assert.Equal(t, "ecu", risks[0].MostRelevantTechnicalAssetId)
+	assert.Equal(t, "agent>flash", risks[0].MostRelevantCommunicationLinkId)
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksLowerIntegrityPushedRisksCreated(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.TechnicalAssets["agent"].Integrity = types.Important
+	model.TechnicalAssets["ecu"].Integrity = types.MissionCritical
+	model.TechnicalAssets["ecu"].Tags = []string{"signature-verification"}
+	model.TechnicalAssets["backend"].CommunicationLinks = []*types.CommunicationLink{
+		{
+			Id:             "backend>push",
+			Title:          "Push",
+			SourceId:       "backend",
+			TargetId:       "agent",
+			DataAssetsSent: []string{"firmware"},
+		},
+	}
+	model.TechnicalAssets["agent"].CommunicationLinks = model.TechnicalAssets["agent"].CommunicationLinks[1:]
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Insecure Update Chain</b> of <b>Firmware Image</b> from <b>Update Backend</b> via <b>Update Agent</b> "+
+		"to <b>Brake ECU</b>: missing rollback protection, passing lower-integrity <b>Update Backend</b>, <b>Update Agent</b>, "+
+		"pushed instead of pulled", risks[0].Title)
+	assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.VeryHighImpact, risks[0].ExploitationImpact)
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksOneRiskPerPath(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.TechnicalAssets["ecu"].Tags = []string{}
+	model.TechnicalAssets["ecu"].CommunicationLinks = []*types.CommunicationLink{
+		{
+			Id:             "ecu>ack",
+			Title:          "Acknowledge",
+			SourceId:       "ecu",
+			TargetId:       "agent",
+			DataAssetsSent: []string{"firmware"},
+		},
+	}
+	model.TechnicalAssets["sensor"] = &types.TechnicalAsset{Id: "sensor", Title: "Sensor", Integrity: types.Important}
+	model.TechnicalAssets["agent"].CommunicationLinks = append(model.TechnicalAssets["agent"].CommunicationLinks, &types.CommunicationLink{
+		Id:             "agent>sensor",
+		Title:          "Flash Sensor",
+		SourceId:       "agent",
+		TargetId:       "sensor",
+		DataAssetsSent: []string{"firmware"},
+	})
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 2)
+	assert.Equal(t, "insecure-update-chain@firmware@backend@agent@sensor", risks[0].SyntheticId)
+	assert.Equal(t, "insecure-update-chain@firmware@backend@agent@ecu", risks[1].SyntheticId)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksTwoWaySyncStartsAtStoringAsset(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.TechnicalAssets["ecu"].Tags = []string{}
+	model.TechnicalAssets["backend"].DataAssetsStored = []string{"firmware"}
+	model.TechnicalAssets["backend"].CommunicationLinks = []*types.CommunicationLink{
+		{
+			Id:                 "backend>sync",
+			Title:              "Sync",
+			SourceId:           "backend",
+			TargetId:           "agent",
+			DataAssetsSent:     []string{"firmware"},
+			DataAssetsReceived: []string{"firmware"},
+		},
+	}
+	model.TechnicalAssets["agent"].CommunicationLinks = model.TechnicalAssets["agent"].CommunicationLinks[1:]
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "insecure-update-chain@firmware@backend@agent@ecu", risks[0].SyntheticId)
+	assert.Contains(t, risks[0].Title, "pushed instead of pulled")
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksStartsAtDataAssetOrigin(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.TechnicalAssets["ecu"].Tags = []string{}
+	model.DataAssets["firmware"].Origin = "Update Agent"
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "insecure-update-chain@firmware@agent@ecu", risks[0].SyntheticId)
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksDenselyLinkedBounded(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := &types.Model{
+		DataAssets: map[string]*types.DataAsset{
+			"firmware": {Id: "firmware", Title: "Firmware Image", Tags: []string{"firmware"}, Integrity: types.Critical},
+		},
+		TechnicalAssets: make(map[string]*types.TechnicalAsset),
+	}
+	ids := make([]string, 0)
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("ecu-%02d", i)
+		ids = append(ids, id)
+		model.TechnicalAssets[id] = &types.TechnicalAsset{Id: id, Title: id, Integrity: types.Critical}
+	}
+	model.TechnicalAssets["ecu-00"].DataAssetsStored = []string{"firmware"}
+	for _, sourceId := range ids {
+		for _, targetId := range ids {
+			if sourceId != targetId {
+				model.TechnicalAssets[sourceId].CommunicationLinks = append(model.TechnicalAssets[sourceId].CommunicationLinks, &types.CommunicationLink{
+					Id:             sourceId + ">" + targetId,
+					SourceId:       sourceId,
+					TargetId:       targetId,
+					DataAssetsSent: []string{"firmware"},
+				})
+			}
+		}
+	}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, maxUpdatePathsPerDataAsset+1)
+	for _, risk := range risks[:maxUpdatePathsPerDataAsset] {
+		assert.LessOrEqual(t, strings.Count(risk.SyntheticId, "@"), maxUpdatePathLength+2)
+	}
+
+	truncated := risks[maxUpdatePathsPerDataAsset]
+	assert.Equal(t, "insecure-update-chain@firmware", truncated.SyntheticId)
+	assert.Equal(t, "<b>Insecure Update Chain</b> of <b>Firmware Image</b> not fully analyzed: more than 64 update paths, the others have to be reviewed manually", truncated.Title)
+	assert.Equal(t, types.LowSeverity, truncated.Severity)
+	assert.Equal(t, []string{
+		`update paths of data asset "firmware"`,
+		`  - data asset tags: firmware (has either ["firmware", "software-update"])`,
+		`  - update paths: more than 64 (only the first 64 analyzed)`,
+	}, rule.ExplainRisk(model, "insecure-update-chain@firmware"))
+}
+
+func TestInsecureUpdateChainRuleGenerateRisksPathsUpToLimitNotTruncated(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := &types.Model{
+		DataAssets: map[string]*types.DataAsset{
+			"firmware": {Id: "firmware", Title: "Firmware Image", Tags: []string{"firmware"}, Integrity: types.Critical},
+		},
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"backend": {Id: "backend", Title: "Backend", Integrity: types.Critical, DataAssetsStored: []string{"firmware"}},
+		},
+	}
+	for i := 0; i < maxUpdatePathsPerDataAsset; i++ {
+		id := fmt.Sprintf("ecu-%02d", i)
+		model.TechnicalAssets[id] = &types.TechnicalAsset{Id: id, Title: id, Integrity: types.Critical}
+		model.TechnicalAssets["backend"].CommunicationLinks = append(model.TechnicalAssets["backend"].CommunicationLinks, &types.CommunicationLink{
+			Id:             "backend>" + id,
+			SourceId:       "backend",
+			TargetId:       id,
+			DataAssetsSent: []string{"firmware"},
+		})
+	}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, maxUpdatePathsPerDataAsset)
+	assert.NotContains(t, risks[len(risks)-1].Title, "not fully analyzed")
+
+	// passing the update on to two other ECUs results in one more path
+	for _, targetId := range []string{"ecu-01", "ecu-02"} {
+		model.TechnicalAssets["ecu-00"].CommunicationLinks = append(model.TechnicalAssets["ecu-00"].CommunicationLinks, &types.CommunicationLink{
+			Id:             "ecu-00>" + targetId,
+			SourceId:       "ecu-00",
+			TargetId:       targetId,
+			DataAssetsSent: []string{"firmware"},
+		})
+	}
+
+	risks, err = rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, maxUpdatePathsPerDataAsset+1)
+	assert.Equal(t, "insecure-update-chain@firmware", risks[len(risks)-1].SyntheticId)
+}
+
+func TestInsecureUpdateChainRuleExplainRisk(t *testing.T) {
+	rule := NewInsecureUpdateChainRule()
+	model := createUpdateChainTestModel()
+	model.TechnicalAssets["agent"].Integrity = types.Important
+	model.TechnicalAssets["ecu"].Tags = []string{"rollback-protection"}
+
+	explanation := rule.ExplainRisk(model, "insecure-update-chain@firmware@*@*@ecu")
+
+	assert.Equal(t, []string{
+		`update path "backend -> agent -> ecu" of data asset "firmware"`,
+		`  - data asset tags: firmware (has either ["firmware", "software-update"])`,
+		`  - origin: "backend" (passing the data asset on without receiving it)`,
+		`  - final consumer: "ecu" (in scope, not passing the update on)`,
+		`  - final consumer tags: rollback-protection (lacks "signature-verification")`,
+		`  - integrity of "agent": important (<critical of the final consumer)`,
+	}, explanation)
+	assert.Empty(t, rule.ExplainRisk(model, "insecure-update-chain@firmware@backend@ecu"))
+}
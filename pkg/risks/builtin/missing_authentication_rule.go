@@ -1,6 +1,8 @@
 package builtin
 
 import (
+	"fmt"
+
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -20,14 +22,16 @@ func (*MissingAuthenticationRule) Category() *types.RiskCategory {
 		CheatSheet:  "https://cheatsheetseries.owasp.org/cheatsheets/Authentication_Cheat_Sheet.html",
 		Action:      "Authentication of Incoming Requests",
 		Mitigation: "Apply an authentication method to the technical asset. To protect highly sensitive data consider " +
-			"the use of two-factor authentication for human users.",
+			"the use of two-factor authentication for human users. For vehicle buses and protocols without authentication " +
+			"use their authenticated variants (like SecOC for " + types.CAN.String() + " or SOME/IP-TLS).",
 		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
 		Function: types.Architecture,
 		STRIDE:   types.ElevationOfPrivilege,
 		DetectionLogic: "In-scope technical assets (except " + types.LoadBalancer + ", " + types.ReverseProxy + ", " + types.ServiceRegistry + ", " + types.WAF + ", " + types.IDS + ", and " + types.IPS + " and in-process calls) should authenticate incoming requests when the asset processes " +
 			"sensitive data. This is especially the case for all multi-tenant assets (there even non-sensitive ones).",
 		RiskAssessment: "The risk rating (medium or high) " +
-			"depends on the sensitivity of the data sent across the communication link. Monitoring callers are exempted from this risk.",
+			"depends on the sensitivity of the data sent across the communication link. Monitoring callers are exempted from this risk. " +
+			"On-chip communication (like " + types.IPC.String() + ") is less likely to be exploited.",
 		FalsePositives: "Technical assets which do not process requests regarding functionality or data linked to end-users (customers) " +
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: false,
@@ -62,8 +66,12 @@ func (r *MissingAuthenticationRule) GenerateRisks(input *types.Model) ([]*types.
 				continue
 			}
 			impact := r.calculateImpact(commLink, input)
-			if commLink.Authentication == types.NoneAuthentication && !commLink.Protocol.IsProcessLocal() {
-				risks = append(risks, r.createRisk(input, technicalAsset, commLink, commLink, "", impact, types.Likely, false, r.Category()))
+			if commLink.Authentication == types.NoneAuthentication && commLink.MessageAuthentication != types.SecOC && !commLink.Protocol.IsProcessLocal() {
+				likelihood := types.Likely
+				if commLink.Protocol.IsOnChip() {
+					likelihood = types.Unlikely
+				}
+				risks = append(risks, r.createRisk(input, technicalAsset, commLink, commLink, "", impact, likelihood, false, r.Category()))
 			}
 		}
 	}
@@ -96,7 +104,7 @@ func (r *MissingAuthenticationRule) createRisk(input *types.Model, technicalAsse
 		ExploitationImpact:     impact,
 		Title: "<b>Missing " + factorString + "Authentication</b> covering communication link <b>" + incomingAccess.Title + "</b> " +
 			"from <b>" + input.TechnicalAssets[incomingAccessOrigin.SourceId].Title + "</b> " + hopBetween +
-			"to <b>" + technicalAsset.Title + "</b>",
+			"to <b>" + technicalAsset.Title + "</b>" + authenticatedVariantHint(incomingAccess.Protocol),
 		MostRelevantTechnicalAssetId:    technicalAsset.Id,
 		MostRelevantCommunicationLinkId: incomingAccess.Id,
 		DataBreachProbability:           types.Possible,
@@ -105,3 +113,60 @@ func (r *MissingAuthenticationRule) createRisk(input *types.Model, technicalAsse
 	risk.SyntheticId = risk.CategoryId + "@" + incomingAccess.Id + "@" + input.TechnicalAssets[incomingAccess.SourceId].Id + "@" + technicalAsset.Id
 	return risk
 }
+
+func authenticatedVariantHint(protocol types.Protocol) string {
+	if len(protocol.AuthenticatedVariant()) == 0 {
+		return ""
+	}
+	return " (consider using " + protocol.AuthenticatedVariant() + ")"
+}
+
+// ExplainRisk states the conditions the communication links of the risks with a synthetic id matching the given one met
+func (r *MissingAuthenticationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	generatedRisks, _ := r.GenerateRisks(parsedModel)
+	for _, generatedRisk := range generatedRisks {
+		if !types.MatchesSyntheticRiskId(risk, generatedRisk.SyntheticId) {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		var commLink *types.CommunicationLink
+		for _, incomingCommLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+			if incomingCommLink.Id == generatedRisk.MostRelevantCommunicationLinkId {
+				commLink = incomingCommLink
+				break
+			}
+		}
+		if commLink == nil {
+			continue
+		}
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("communication link %q from %q to %q", commLink.Id, commLink.SourceId, commLink.TargetId),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has no %q)", technicalAsset.Technologies.String(), types.NoAuthenticationRequired),
+		)
+		if technicalAsset.MultiTenant {
+			explanation = append(explanation, fmt.Sprintf("  - multi-tenant: %v (=true)", technicalAsset.MultiTenant))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("  - highest processed confidentiality: %v, integrity: %v, availability: %v (any >=%v, %v, %v)",
+				parsedModel.HighestProcessedConfidentiality(technicalAsset), parsedModel.HighestProcessedIntegrity(technicalAsset),
+				parsedModel.HighestProcessedAvailability(technicalAsset), types.Confidential, types.Critical, types.Critical))
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - authentication: %v (=%v)", commLink.Authentication, types.NoneAuthentication),
+			fmt.Sprintf("  - message authentication: %v (!=%v)", commLink.MessageAuthentication, types.SecOC),
+			fmt.Sprintf("  - protocol: %v (not process-local)", commLink.Protocol),
+		)
+		if commLink.Protocol.IsOnChip() {
+			explanation = append(explanation, "  - protocol is on-chip (less likely to be exploited)")
+		}
+		if len(commLink.Protocol.AuthenticatedVariant()) > 0 {
+			explanation = append(explanation, fmt.Sprintf("  - protocol has an authenticated variant: %v", commLink.Protocol.AuthenticatedVariant()))
+		}
+	}
+	return explanation
+}
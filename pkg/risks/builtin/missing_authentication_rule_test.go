@@ -353,3 +353,92 @@ func TestMissingAuthenticationRuleGenerateRisksProcessingConfidentialDataRisksCr
 	assert.Equal(t, "<b>Missing Authentication</b> covering communication link <b>User Access via Browser</b> from <b>User Interface</b> to <b>Test Technical Asset</b>", risks[0].Title)
 	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
 }
+
+func createMissingAuthenticationAutomotiveTestModel(protocol types.Protocol) *types.Model {
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ta1": {
+				Id:          "ta1",
+				Title:       "Powertrain ECU",
+				MultiTenant: true, // require less code instead of adding processed data
+			},
+			"ta2": {
+				Id:    "ta2",
+				Title: "Signal Gateway",
+			},
+		},
+		IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+			"ta1": {
+				{
+					Title:          "Torque Request",
+					SourceId:       "ta2",
+					Authentication: types.NoneAuthentication,
+					Protocol:       protocol,
+				},
+			},
+		},
+	}
+}
+
+func TestMissingAuthenticationRuleGenerateRisksBroadcastBusRisksCreatedWithAuthenticatedVariant(t *testing.T) {
+	rule := NewMissingAuthenticationRule()
+
+	risks, err := rule.GenerateRisks(createMissingAuthenticationAutomotiveTestModel(types.CAN))
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Missing Authentication</b> covering communication link <b>Torque Request</b> from <b>Signal Gateway</b> to <b>Powertrain ECU</b> (consider using SecOC)", risks[0].Title)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+}
+
+func TestMissingAuthenticationRuleGenerateRisksOnChipRisksCreatedWithUnlikelyLikelihood(t *testing.T) {
+	rule := NewMissingAuthenticationRule()
+
+	risks, err := rule.GenerateRisks(createMissingAuthenticationAutomotiveTestModel(types.IPC))
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Missing Authentication</b> covering communication link <b>Torque Request</b> from <b>Signal Gateway</b> to <b>Powertrain ECU</b>", risks[0].Title)
+	assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+}
+
+func TestMissingAuthenticationRuleGenerateRisksLocalCallNoRisksCreated(t *testing.T) {
+	rule := NewMissingAuthenticationRule()
+
+	risks, err := rule.GenerateRisks(createMissingAuthenticationAutomotiveTestModel(types.Local))
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMissingAuthenticationRuleGenerateRisksSecOCNoRisksCreated(t *testing.T) {
+	rule := NewMissingAuthenticationRule()
+	model := createMissingAuthenticationAutomotiveTestModel(types.CAN)
+	model.IncomingTechnicalCommunicationLinksMappedByTargetId["ta1"][0].MessageAuthentication = types.SecOC
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMissingAuthenticationRuleExplainRisk(t *testing.T) {
+	rule := NewMissingAuthenticationRule()
+	model := createMissingAuthenticationAutomotiveTestModel(types.CAN)
+	model.IncomingTechnicalCommunicationLinksMappedByTargetId["ta1"][0].Id = "ta2>torque-request"
+	model.IncomingTechnicalCommunicationLinksMappedByTargetId["ta1"][0].TargetId = "ta1"
+
+	explanation := rule.ExplainRisk(model, "missing-authentication@*@ta2@ta1")
+
+	assert.Equal(t, []string{
+		`communication link "ta2>torque-request" from "ta2" to "ta1"`,
+		"  - out of scope: false (=false)",
+		`  - technology:  (has no "no_authentication_required")`,
+		"  - multi-tenant: true (=true)",
+		"  - authentication: none (=none)",
+		"  - message authentication: none (!=secoc)",
+		"  - protocol: can-bus (not process-local)",
+		"  - protocol has an authenticated variant: SecOC",
+	}, explanation)
+	assert.Empty(t, rule.ExplainRisk(model, "missing-authentication@*@ta1@ta2"))
+}
@@ -0,0 +1,161 @@
+package builtin
+
+import (
+	"sort"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingKeyIsolationRule struct{}
+
+func NewMissingKeyIsolationRule() *MissingKeyIsolationRule {
+	return &MissingKeyIsolationRule{}
+}
+
+const keyMaterialTag = "key-material"
+
+func (*MissingKeyIsolationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-key-isolation",
+		Title: "Missing Key Isolation",
+		Description: "Cryptographic keys of embedded systems (like keys for secure boot, SecOC or the vehicle identity) " +
+			"should only be stored and used inside hardware security modules (HSM) or secure elements, which in turn " +
+			"should only be reachable via local inter-processor communication from within their own isolated " +
+			"execution environment.",
+		Impact: "If this risk is unmitigated, attackers having compromised a technical asset storing or processing keys " +
+			"outside an HSM, or being able to send requests to the HSM from another execution environment, might be " +
+			"able to extract the keys or misuse them for forging signatures and messages.",
+		ASVS:       "V6 - Stored Cryptography Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Key_Management_Cheat_Sheet.html",
+		Action:     "Key Isolation",
+		Mitigation: "Store and use keys only inside an HSM or secure element and only hand out key handles instead of " +
+			"the key material itself. Restrict access to the HSM to local inter-processor communication (like mailboxes " +
+			"or shared memory with firewalls) from components within its own execution environment, and relay requests " +
+			"from other execution environments via a hardened crypto service checking the permissions of the caller.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets storing or processing data assets tagged with '" + keyMaterialTag + "' " +
+			"without being an HSM or secure element (technologies with the " + types.HSM + " attribute), as well as " +
+			"communication links to HSMs not being local inter-processor communication from technical assets within the " +
+			"same " + types.ExecutionEnvironment.String() + " trust boundary as the HSM.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the key material. Technical " +
+			"assets storing keys outside an HSM are rated as more likely than ones only processing them.",
+		FalsePositives: "Keys of limited value (like per-session keys derived inside an HSM) can be considered as false " +
+			"positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        320,
+	}
+}
+
+func (*MissingKeyIsolationRule) SupportedTags() []string {
+	return []string{keyMaterialTag}
+}
+
+func (r *MissingKeyIsolationRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		technicalAsset := input.TechnicalAssets[id]
+		if technicalAsset.OutOfScope {
+			continue
+		}
+		storedKeys := keyMaterialDataAssets(input, technicalAsset.DataAssetsStored)
+		processedKeys := keyMaterialDataAssets(input, technicalAsset.DataAssetsProcessed)
+		if !technicalAsset.Technologies.GetAttribute(types.HSM) {
+			if len(storedKeys) > 0 {
+				risks = append(risks, r.createKeysOutsideHsmRisk(technicalAsset, storedKeys, true))
+			} else if len(processedKeys) > 0 {
+				risks = append(risks, r.createKeysOutsideHsmRisk(technicalAsset, processedKeys, false))
+			}
+			continue
+		}
+
+		hsmBoundary := input.DirectContainingTrustBoundaryMappedByTechnicalAssetId[technicalAsset.Id]
+		for _, sourceId := range input.SortedTechnicalAssetIDs() {
+			sourceAsset := input.TechnicalAssets[sourceId]
+			for _, link := range sourceAsset.CommunicationLinksSorted() {
+				if link.TargetId != technicalAsset.Id {
+					continue
+				}
+				sourceBoundary := input.DirectContainingTrustBoundaryMappedByTechnicalAssetId[sourceAsset.Id]
+				sameExecutionEnvironment := hsmBoundary != nil && hsmBoundary.Type == types.ExecutionEnvironment && sourceBoundary == hsmBoundary
+				if sameExecutionEnvironment && link.Protocol.IsOnChip() {
+					continue
+				}
+				risks = append(risks, r.createHsmAccessRisk(input, technicalAsset, sourceAsset, link, sameExecutionEnvironment))
+			}
+		}
+	}
+	return risks, nil
+}
+
+func keyMaterialDataAssets(input *types.Model, dataAssetIds []string) []*types.DataAsset {
+	keys := make([]*types.DataAsset, 0)
+	for _, dataAssetId := range dataAssetIds {
+		if dataAsset, ok := input.DataAssets[dataAssetId]; ok && dataAsset.IsTaggedWithAny(keyMaterialTag) {
+			keys = append(keys, dataAsset)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Id < keys[j].Id
+	})
+	return keys
+}
+
+func keyMaterialImpact(keys []*types.DataAsset) types.RiskExploitationImpact {
+	for _, key := range keys {
+		if key.Confidentiality == types.StrictlyConfidential {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *MissingKeyIsolationRule) createKeysOutsideHsmRisk(technicalAsset *types.TechnicalAsset, keys []*types.DataAsset, stored bool) *types.Risk {
+	impact := keyMaterialImpact(keys)
+	likelihood := types.Unlikely
+	title := "<b>Missing Key Isolation</b> at <b>" + technicalAsset.Title + "</b> processing key material <b>" + keys[0].Title + "</b>"
+	if stored {
+		likelihood = types.Likely
+		title = "<b>Missing Key Isolation</b> at <b>" + technicalAsset.Title + "</b> storing key material <b>" + keys[0].Title + "</b>"
+	}
+	title += " outside an HSM"
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		MostRelevantDataAssetId:      keys[0].Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{technicalAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
+	return risk
+}
+
+func (r *MissingKeyIsolationRule) createHsmAccessRisk(input *types.Model, hsm *types.TechnicalAsset, sourceAsset *types.TechnicalAsset,
+	link *types.CommunicationLink, sameExecutionEnvironment bool) *types.Risk {
+	keys := keyMaterialDataAssets(input, append(append(make([]string, 0), hsm.DataAssetsStored...), hsm.DataAssetsProcessed...))
+	impact := keyMaterialImpact(keys)
+	title := "<b>Missing Key Isolation</b> of HSM <b>" + hsm.Title + "</b> accessed by <b>" + sourceAsset.Title + "</b> via <b>" + link.Title + "</b>"
+	if sameExecutionEnvironment {
+		title += " not being local inter-processor communication"
+	} else {
+		title += " from outside its execution environment"
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:          types.Unlikely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    hsm.Id,
+		MostRelevantCommunicationLinkId: link.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{hsm.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + link.Id + "@" + sourceAsset.Id + "@" + hsm.Id
+	return risk
+}
@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingKeyIsolationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingKeyIsolationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+// createKeyIsolationTestModel creates an HSM storing keys and a crypto service using it via local IPC, both inside the
+// same execution environment, as well as an application outside of it
+func createKeyIsolationTestModel() *types.Model {
+	hsmBoundary := &types.TrustBoundary{
+		Id:                    "security-island",
+		Title:                 "Security Island",
+		Type:                  types.ExecutionEnvironment,
+		TechnicalAssetsInside: []string{"hsm", "crypto-service"},
+	}
+	applicationBoundary := &types.TrustBoundary{
+		Id:                    "application-vm",
+		Title:                 "Application VM",
+		Type:                  types.ExecutionEnvironment,
+		TechnicalAssetsInside: []string{"application"},
+	}
+	return &types.Model{
+		DataAssets: map[string]*types.DataAsset{
+			"keys":      {Id: "keys", Title: "Private Keys", Tags: []string{"key-material"}, Confidentiality: types.StrictlyConfidential},
+			"telemetry": {Id: "telemetry", Title: "Telemetry", Confidentiality: types.Confidential},
+		},
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"hsm": {
+				Id:               "hsm",
+				Title:            "HSM",
+				Technologies:     types.TechnologyList{{Name: "hsm", Attributes: map[string]bool{types.HSM: true}}},
+				DataAssetsStored: []string{"keys"},
+			},
+			"crypto-service": {
+				Id:    "crypto-service",
+				Title: "Crypto Service",
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "crypto-service>hsm", Title: "Mailbox", SourceId: "crypto-service", TargetId: "hsm", Protocol: types.IPC},
+				},
+			},
+			"application": {
+				Id:                  "application",
+				Title:               "Application",
+				DataAssetsProcessed: []string{"telemetry"},
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "application>crypto-service", Title: "Sign", SourceId: "application", TargetId: "crypto-service", Protocol: types.IPC},
+				},
+			},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{
+			"security-island": hsmBoundary,
+			"application-vm":  applicationBoundary,
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"hsm":            hsmBoundary,
+			"crypto-service": hsmBoundary,
+			"application":    applicationBoundary,
+		},
+	}
+}
+
+func TestMissingKeyIsolationRuleGenerateRisksIsolatedHsmNotRisksCreated(t *testing.T) {
+	rule := NewMissingKeyIsolationRule()
+
+	risks, err := rule.GenerateRisks(createKeyIsolationTestModel())
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMissingKeyIsolationRuleGenerateRisksKeysOutsideHsmRisksCreated(t *testing.T) {
+	testCases := map[string]struct {
+		stored             bool
+		confidentiality    types.Confidentiality
+		expectedLikelihood types.RiskExploitationLikelihood
+		expectedImpact     types.RiskExploitationImpact
+		expectedTitle      string
+	}{
+		"stored": {
+			stored:             true,
+			confidentiality:    types.StrictlyConfidential,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.HighImpact,
+			expectedTitle:      "<b>Missing Key Isolation</b> at <b>Application</b> storing key material <b>Private Keys</b> outside an HSM",
+		},
+		"processed": {
+			stored:             false,
+			confidentiality:    types.StrictlyConfidential,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.HighImpact,
+			expectedTitle:      "<b>Missing Key Isolation</b> at <b>Application</b> processing key material <b>Private Keys</b> outside an HSM",
+		},
+		"confidential": {
+			stored:             true,
+			confidentiality:    types.Confidential,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.MediumImpact,
+			expectedTitle:      "<b>Missing Key Isolation</b> at <b>Application</b> storing key material <b>Private Keys</b> outside an HSM",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingKeyIsolationRule()
+			model := createKeyIsolationTestModel()
+			model.DataAssets["keys"].Confidentiality = testCase.confidentiality
+			if testCase.stored {
+				model.TechnicalAssets["application"].DataAssetsStored = []string{"keys"}
+			} else {
+				model.TechnicalAssets["application"].DataAssetsProcessed = []string{"telemetry", "keys"}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, 1)
+			assert.Equal(t, "missing-key-isolation@application", risks[0].SyntheticId)
+			assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+			assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+			assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+			assert.Equal(t, "keys", risks[0].MostRelevantDataAssetId)
+		})
+	}
+}
+
+func TestMissingKeyIsolationRuleGenerateRisksOutOfScopeNotRisksCreated(t *testing.T) {
+	rule := NewMissingKeyIsolationRule()
+	model := createKeyIsolationTestModel()
+	model.TechnicalAssets["application"].DataAssetsStored = []string{"keys"}
+	model.TechnicalAssets["application"].OutOfScope = true
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMissingKeyIsolationRuleGenerateRisksHsmAccessedFromOtherExecutionEnvironmentRisksCreated(t *testing.T) {
+	rule := NewMissingKeyIsolationRule()
+	model := createKeyIsolationTestModel()
+	model.TechnicalAssets["application"].CommunicationLinks[0].TargetId = "hsm"
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "missing-key-isolation@application>crypto-service@application@hsm", risks[0].SyntheticId)
+	assert.Equal(t, "<b>Missing Key Isolation</b> of HSM <b>HSM</b> accessed by <b>Application</b> via <b>Sign</b> from outside its execution environment", risks[0].Title)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, "hsm", risks[0].MostRelevantTechnicalAssetId)
+}
+
+func TestMissingKeyIsolationRuleGenerateRisksHsmAccessedNotLocallyRisksCreated(t *testing.T) {
+	rule := NewMissingKeyIsolationRule()
+	model := createKeyIsolationTestModel()
+	model.TechnicalAssets["crypto-service"].CommunicationLinks[0].Protocol = types.TCP
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Missing Key Isolation</b> of HSM <b>HSM</b> accessed by <b>Crypto Service</b> via <b>Mailbox</b> not being local inter-processor communication", risks[0].Title)
+}
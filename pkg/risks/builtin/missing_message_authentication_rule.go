@@ -0,0 +1,128 @@
+package builtin
+
+import (
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingMessageAuthenticationRule struct{}
+
+func NewMissingMessageAuthenticationRule() *MissingMessageAuthenticationRule {
+	return &MissingMessageAuthenticationRule{}
+}
+
+func (*MissingMessageAuthenticationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-message-authentication",
+		Title: "Missing Message Authentication",
+		Description: "Integrity-critical data sent over broadcast buses (like " + types.CAN.String() + " or " + types.FlexRay.String() + ") " +
+			"must be protected by message authentication (AUTOSAR SecOC), as every node connected to the bus is able to send " +
+			"arbitrary frames.",
+		Impact: "If this risk is unmitigated, attackers controlling any node on the bus (or having physical access to it) might be " +
+			"able to forge or replay messages, like actuation commands, and thereby cause hazardous vehicle behavior.",
+		ASVS:       "V9 - Communication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Transport_Layer_Protection_Cheat_Sheet.html",
+		Action:     "Message Authentication",
+		Mitigation: "Apply AUTOSAR SecOC with a message authentication code and a freshness value to the messages. " +
+			"E2E protection profiles only detect random faults, but not deliberately forged messages.",
+		Check:    "Is SecOC configured for all integrity-critical messages and are the keys provisioned securely?",
+		Function: types.Architecture,
+		STRIDE:   types.Spoofing,
+		DetectionLogic: "Communication links over broadcast buses supporting SecOC (" + types.CAN.String() + ", " + types.CANFD.String() +
+			" and " + types.FlexRay.String() + ", but not " + types.LIN.String() + ") of in-scope technical assets sending or receiving " +
+			"data assets with an integrity rating of " + types.Critical.String() + " or higher without SecOC message authentication.",
+		RiskAssessment: "The risk rating depends on the integrity rating of the data assets transferred and on the safety " +
+			"integrity level of the receiving technical assets (ASIL-C and higher being rated higher).",
+		FalsePositives: "When the receiving technical assets validate the messages by other means, like plausibility checks " +
+			"against redundant sensors.",
+		ModelFailurePossibleReason: false,
+		CWE:                        345,
+	}
+}
+
+func (*MissingMessageAuthenticationRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *MissingMessageAuthenticationRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		technicalAsset := input.TechnicalAssets[id]
+		for _, communicationLink := range technicalAsset.CommunicationLinksSorted() {
+			// broadcast buses without an authenticated variant (like LIN) can't be protected by SecOC
+			if !communicationLink.Protocol.IsBroadcast() || len(communicationLink.Protocol.AuthenticatedVariant()) == 0 ||
+				communicationLink.MessageAuthentication == types.SecOC {
+				continue
+			}
+			sourceAsset, sourceOk := input.TechnicalAssets[communicationLink.SourceId]
+			targetAsset, targetOk := input.TechnicalAssets[communicationLink.TargetId]
+			if !sourceOk || !targetOk || (sourceAsset.OutOfScope && targetAsset.OutOfScope) {
+				continue
+			}
+
+			highestIntegrity := types.Archive
+			receivers := make([]*types.TechnicalAsset, 0)
+			if integrity := highestDataAssetIntegrity(input, communicationLink.DataAssetsSent); integrity >= types.Critical {
+				highestIntegrity = max(highestIntegrity, integrity)
+				receivers = append(receivers, targetAsset)
+			}
+			if integrity := highestDataAssetIntegrity(input, communicationLink.DataAssetsReceived); integrity >= types.Critical {
+				highestIntegrity = max(highestIntegrity, integrity)
+				receivers = append(receivers, sourceAsset)
+			}
+			if len(receivers) == 0 {
+				continue
+			}
+			risks = append(risks, r.createRisk(input, communicationLink, sourceAsset, targetAsset, highestIntegrity, receivers))
+		}
+	}
+	return risks, nil
+}
+
+func highestDataAssetIntegrity(input *types.Model, dataAssetIds []string) types.Criticality {
+	highest := types.Archive
+	for _, dataAssetId := range dataAssetIds {
+		if dataAsset, ok := input.DataAssets[dataAssetId]; ok && dataAsset.Integrity > highest {
+			highest = dataAsset.Integrity
+		}
+	}
+	return highest
+}
+
+func (r *MissingMessageAuthenticationRule) createRisk(input *types.Model, communicationLink *types.CommunicationLink,
+	sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, highestIntegrity types.Criticality, receivers []*types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if highestIntegrity == types.MissionCritical {
+		impact = types.HighImpact
+	}
+	receiverIds := make([]string, 0)
+	highestSafetyIntegrityLevel := types.QM
+	for _, receiver := range receivers {
+		receiverIds = append(receiverIds, receiver.Id)
+		highestSafetyIntegrityLevel = max(highestSafetyIntegrityLevel, input.SafetyIntegrityLevelOfTechnicalAsset(receiver))
+	}
+	if highestSafetyIntegrityLevel >= types.ASILC {
+		impact++
+	}
+
+	title := "<b>Missing Message Authentication</b> of communication link <b>" + communicationLink.Title + "</b> from <b>" + sourceAsset.Title +
+		"</b> to <b>" + targetAsset.Title + "</b> transferring integrity-critical data over a broadcast bus"
+	if communicationLink.MessageAuthentication == types.MacWithoutFreshness {
+		title += " (the message authentication code without freshness value does not prevent replayed messages)"
+	}
+	if communicationLink.E2EProtection != types.NoE2EProtection {
+		title += " (E2E protection does not prevent forged messages)"
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    receiverIds[0],
+		MostRelevantCommunicationLinkId: communicationLink.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     receiverIds,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + communicationLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
@@ -0,0 +1,193 @@
+package builtin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingMessageAuthenticationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingMessageAuthenticationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingMessageAuthenticationRuleTest struct {
+	outOfScope            bool
+	protocol              types.Protocol
+	messageAuthentication types.MessageAuthentication
+	e2eProtection         types.E2EProtection
+	sent                  bool
+	dataAssetIntegrity    types.Criticality
+	receiverLevel         types.SafetyIntegrityLevel
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedReceiverId  string
+	expectedSuffixTitle string
+}
+
+func TestMissingMessageAuthenticationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingMessageAuthenticationRuleTest{
+		"out of scope": {
+			outOfScope:         true,
+			protocol:           types.CAN,
+			sent:               true,
+			dataAssetIntegrity: types.MissionCritical,
+
+			riskCreated: false,
+		},
+		"point-to-point protocol": {
+			protocol:           types.SomeIP,
+			sent:               true,
+			dataAssetIntegrity: types.MissionCritical,
+
+			riskCreated: false,
+		},
+		"broadcast bus without SecOC": {
+			protocol:           types.LIN,
+			sent:               true,
+			dataAssetIntegrity: types.MissionCritical,
+
+			riskCreated: false,
+		},
+		"SecOC": {
+			protocol:              types.CAN,
+			messageAuthentication: types.SecOC,
+			sent:                  true,
+			dataAssetIntegrity:    types.MissionCritical,
+
+			riskCreated: false,
+		},
+		"not integrity-critical data": {
+			protocol:           types.CAN,
+			sent:               true,
+			dataAssetIntegrity: types.Important,
+
+			riskCreated: false,
+		},
+		"send critical data": {
+			protocol:           types.CAN,
+			sent:               true,
+			dataAssetIntegrity: types.Critical,
+
+			riskCreated:        true,
+			expectedImpact:     types.MediumImpact,
+			expectedReceiverId: "target",
+		},
+		"receive mission-critical data": {
+			protocol:           types.FlexRay,
+			sent:               false,
+			dataAssetIntegrity: types.MissionCritical,
+
+			riskCreated:        true,
+			expectedImpact:     types.HighImpact,
+			expectedReceiverId: "source",
+		},
+		"send mission-critical data to ASIL-D receiver": {
+			protocol:           types.CANFD,
+			sent:               true,
+			dataAssetIntegrity: types.MissionCritical,
+			receiverLevel:      types.ASILD,
+
+			riskCreated:        true,
+			expectedImpact:     types.VeryHighImpact,
+			expectedReceiverId: "target",
+		},
+		"send critical data to ASIL-B receiver": {
+			protocol:           types.CAN,
+			sent:               true,
+			dataAssetIntegrity: types.Critical,
+			receiverLevel:      types.ASILB,
+
+			riskCreated:        true,
+			expectedImpact:     types.MediumImpact,
+			expectedReceiverId: "target",
+		},
+		"MAC without freshness": {
+			protocol:              types.CAN,
+			messageAuthentication: types.MacWithoutFreshness,
+			sent:                  true,
+			dataAssetIntegrity:    types.Critical,
+
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedReceiverId:  "target",
+			expectedSuffixTitle: " (the message authentication code without freshness value does not prevent replayed messages)",
+		},
+		"E2E protection only": {
+			protocol:           types.CAN,
+			e2eProtection:      types.E2EProfile1,
+			sent:               true,
+			dataAssetIntegrity: types.Critical,
+
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedReceiverId:  "target",
+			expectedSuffixTitle: " (E2E protection does not prevent forged messages)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingMessageAuthenticationRule()
+			communicationLink := &types.CommunicationLink{
+				Id:                    "source>torque",
+				Title:                 "Torque Request",
+				SourceId:              "source",
+				TargetId:              "target",
+				Protocol:              testCase.protocol,
+				MessageAuthentication: testCase.messageAuthentication,
+				E2EProtection:         testCase.e2eProtection,
+			}
+			if testCase.sent {
+				communicationLink.DataAssetsSent = []string{"da1"}
+			} else {
+				communicationLink.DataAssetsReceived = []string{"da1"}
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"source": {
+						Id:                   "source",
+						Title:                "Signal Gateway",
+						OutOfScope:           testCase.outOfScope,
+						SafetyIntegrityLevel: testCase.receiverLevel,
+						CommunicationLinks:   []*types.CommunicationLink{communicationLink},
+					},
+					"target": {
+						Id:                   "target",
+						Title:                "Powertrain ECU",
+						OutOfScope:           testCase.outOfScope,
+						SafetyIntegrityLevel: testCase.receiverLevel,
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"da1": {
+						Id:        "da1",
+						Title:     "Vehicle Control Signals",
+						Integrity: testCase.dataAssetIntegrity,
+					},
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedReceiverId, risks[0].MostRelevantTechnicalAssetId)
+				assert.Equal(t, "missing-message-authentication@source>torque@source@target", risks[0].SyntheticId)
+				expectedMessage := fmt.Sprintf("<b>Missing Message Authentication</b> of communication link <b>Torque Request</b> from <b>Signal Gateway</b> to <b>Powertrain ECU</b> transferring integrity-critical data over a broadcast bus%s", testCase.expectedSuffixTitle)
+				assert.Equal(t, expectedMessage, risks[0].Title)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package builtin
+
+import (
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingSecureBootChainRule struct{}
+
+func NewMissingSecureBootChainRule() *MissingSecureBootChainRule {
+	return &MissingSecureBootChainRule{}
+}
+
+func (*MissingSecureBootChainRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-secure-boot-chain",
+		Title: "Missing Secure Boot Chain",
+		Description: "Embedded components with high integrity requirements should only start software whose authenticity " +
+			"has been verified at boot by a chain of trust rooted in a hardware root of trust (like a boot ROM or a " +
+			"security core verifying the next boot stage with keys fused into the chip), with each stage verifying the " +
+			"next one before handing over control.",
+		Impact: "If this risk is unmitigated, attackers having managed to write to the flash memory of a component (like " +
+			"via a manipulated update or a debug interface) might be able to persistently run manipulated software on it.",
+		ASVS:       "V10 - Malicious Code Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Vulnerable_Dependency_Management_Cheat_Sheet.html",
+		Action:     "Secure Boot",
+		Mitigation: "Establish a secure boot chain starting at an immutable hardware root of trust, let each boot stage " +
+			"verify the signature of the next one (like the hypervisor and the images of the virtual machines) before " +
+			"executing it, and model these verifications via 'verifies_at_boot' of the verifying technical asset.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "In-scope technical assets (except external entities) with an integrity rating of " +
+			types.Critical.String() + " or higher being embedded components (technologies with the " +
+			types.IsEmbeddedComponent + " or " + types.HSM + " attribute) or taking part in boot verifications, " +
+			"which are neither a 'hardware_root_of_trust' themselves nor (transitively) verified at boot by one " +
+			"via 'verifies_at_boot' of the verifying technical assets.",
+		RiskAssessment: "The risk rating depends on the highest integrity rating of the technical asset. Technical assets " +
+			"not verified at boot at all are rated as more likely than ones verified by a chain of trust not rooted in " +
+			"a hardware root of trust.",
+		FalsePositives: "Technical assets running from read-only memory only can be considered as false positives after " +
+			"individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        1326,
+	}
+}
+
+func (*MissingSecureBootChainRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *MissingSecureBootChainRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	covered := input.TechnicalAssetIDsCoveredBySecureBoot()
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		technicalAsset := input.TechnicalAssets[id]
+		if technicalAsset.OutOfScope || technicalAsset.Type == types.ExternalEntity || covered[id] ||
+			input.HighestIntegrity(technicalAsset) < types.Critical {
+			continue
+		}
+		verifiers := input.BootVerifiersOfTechnicalAsset(technicalAsset)
+		if len(verifiers) == 0 && len(technicalAsset.VerifiesAtBoot) == 0 &&
+			!technicalAsset.Technologies.GetAttribute(types.IsEmbeddedComponent, types.HSM) {
+			continue
+		}
+		risks = append(risks, r.createRisk(input, technicalAsset, verifiers))
+	}
+	return risks, nil
+}
+
+func (r *MissingSecureBootChainRule) createRisk(input *types.Model, technicalAsset *types.TechnicalAsset, verifiers []*types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if input.HighestIntegrity(technicalAsset) == types.MissionCritical {
+		impact = types.HighImpact
+	}
+	likelihood := types.Likely
+	title := "<b>Missing Secure Boot Chain</b> at <b>" + technicalAsset.Title + "</b>: "
+	if len(verifiers) == 0 {
+		title += "not verified at boot"
+	} else {
+		likelihood = types.Unlikely
+		verifierTitles := make([]string, 0)
+		for _, verifier := range verifiers {
+			verifierTitles = append(verifierTitles, "<b>"+verifier.Title+"</b>")
+		}
+		title += "verified at boot by " + strings.Join(verifierTitles, ", ") + " without a chain of trust rooted in a hardware root of trust"
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{technicalAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
+	return risk
+}
@@ -0,0 +1,141 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingSecureBootChainRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+// createSecureBootTestModel creates the chain of trust boot-rom -> hypervisor -> vm, next to a critical cloud service
+// not being an embedded component
+func createSecureBootTestModel() *types.Model {
+	embeddedTechnology := types.TechnologyList{{Name: "ecu", Attributes: map[string]bool{types.IsEmbeddedComponent: true}}}
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"boot-rom": {
+				Id:                  "boot-rom",
+				Title:               "Boot ROM",
+				Type:                types.Process,
+				Integrity:           types.MissionCritical,
+				Technologies:        embeddedTechnology,
+				HardwareRootOfTrust: true,
+				VerifiesAtBoot:      []string{"hypervisor"},
+			},
+			"hypervisor": {
+				Id:             "hypervisor",
+				Title:          "Hypervisor",
+				Type:           types.Process,
+				Integrity:      types.MissionCritical,
+				Technologies:   embeddedTechnology,
+				VerifiesAtBoot: []string{"vm"},
+			},
+			"vm":    {Id: "vm", Title: "Vehicle Control VM", Type: types.Process, Integrity: types.Critical, Technologies: embeddedTechnology},
+			"cloud": {Id: "cloud", Title: "Cloud Service", Type: types.Process, Integrity: types.MissionCritical},
+		},
+	}
+}
+
+func TestMissingSecureBootChainRuleGenerateRisksVerifiedChainNotRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+
+	risks, err := rule.GenerateRisks(createSecureBootTestModel())
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMissingSecureBootChainRuleGenerateRisksLowIntegrityNotRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+	model := createSecureBootTestModel()
+	model.TechnicalAssets["hypervisor"].VerifiesAtBoot = []string{}
+	model.TechnicalAssets["vm"].Integrity = types.Important
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMissingSecureBootChainRuleGenerateRisksOutOfScopeNotRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+	model := createSecureBootTestModel()
+	model.TechnicalAssets["hypervisor"].VerifiesAtBoot = []string{}
+	model.TechnicalAssets["vm"].OutOfScope = true
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMissingSecureBootChainRuleGenerateRisksNotVerifiedRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+	model := createSecureBootTestModel()
+	model.TechnicalAssets["hypervisor"].VerifiesAtBoot = []string{}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "missing-secure-boot-chain@vm", risks[0].SyntheticId)
+	assert.Equal(t, "<b>Missing Secure Boot Chain</b> at <b>Vehicle Control VM</b>: not verified at boot", risks[0].Title)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, "vm", risks[0].MostRelevantTechnicalAssetId)
+}
+
+func TestMissingSecureBootChainRuleGenerateRisksChainNotRootedInHardwareRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+	model := createSecureBootTestModel()
+	model.TechnicalAssets["boot-rom"].HardwareRootOfTrust = false
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 3)
+	assert.Equal(t, "missing-secure-boot-chain@boot-rom", risks[0].SyntheticId)
+	assert.Equal(t, "<b>Missing Secure Boot Chain</b> at <b>Boot ROM</b>: not verified at boot", risks[0].Title)
+	assert.Equal(t, "missing-secure-boot-chain@hypervisor", risks[1].SyntheticId)
+	assert.Equal(t, "<b>Missing Secure Boot Chain</b> at <b>Hypervisor</b>: verified at boot by <b>Boot ROM</b> "+
+		"without a chain of trust rooted in a hardware root of trust", risks[1].Title)
+	assert.Equal(t, types.Unlikely, risks[1].ExploitationLikelihood)
+	assert.Equal(t, types.HighImpact, risks[1].ExploitationImpact)
+	assert.Equal(t, "missing-secure-boot-chain@vm", risks[2].SyntheticId)
+}
+
+func TestMissingSecureBootChainRuleGenerateRisksVerifyingNonEmbeddedRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+	model := createSecureBootTestModel()
+	model.TechnicalAssets["cloud"].VerifiesAtBoot = []string{"vm"}
+	model.TechnicalAssets["hypervisor"].VerifiesAtBoot = []string{}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 2)
+	assert.Equal(t, "missing-secure-boot-chain@cloud", risks[0].SyntheticId)
+	assert.Equal(t, "<b>Missing Secure Boot Chain</b> at <b>Vehicle Control VM</b>: verified at boot by <b>Cloud Service</b> "+
+		"without a chain of trust rooted in a hardware root of trust", risks[1].Title)
+}
+
+func TestMissingSecureBootChainRuleGenerateRisksExternalEntityNotRisksCreated(t *testing.T) {
+	rule := NewMissingSecureBootChainRule()
+	model := createSecureBootTestModel()
+	model.TechnicalAssets["hypervisor"].VerifiesAtBoot = []string{}
+	model.TechnicalAssets["vm"].Type = types.ExternalEntity
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
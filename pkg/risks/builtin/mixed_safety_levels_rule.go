@@ -0,0 +1,238 @@
+package builtin
+
+import (
+	"sort"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MixedSafetyLevelsRule struct{}
+
+func NewMixedSafetyLevelsRule() *MixedSafetyLevelsRule {
+	return &MixedSafetyLevelsRule{}
+}
+
+func (*MixedSafetyLevelsRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "mixed-safety-levels",
+		Title: "Mixed Safety Levels",
+		Description: "QM (quality managed) components should not share a hypervisor, shared runtime or IPC channel with safety " +
+			"relevant (ASIL-B and above) components without an isolating boundary ensuring freedom from interference.",
+		Impact: "If this risk is unmitigated, attackers successfully attacking a QM component (which is not developed to any safety " +
+			"standard) might interfere with safety relevant components, for example by exhausting shared resources or by " +
+			"corrupting shared memory, and thereby cause hazardous vehicle behavior.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Attack_Surface_Analysis_Cheat_Sheet.html",
+		Action:     "Freedom from Interference",
+		Mitigation: "Separate QM and safety relevant components into distinct partitions (trust boundaries) of a hypervisor or " +
+			"separation kernel developed to the highest ASIL of the components it hosts, or develop the QM components to " +
+			"the higher ASIL as well. Validate all data received via IPC from QM components.",
+		Check:    "Is freedom from interference between the QM and the safety relevant components demonstrated (ISO 26262-6 annex D)?",
+		Function: types.Architecture,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "QM technical assets running on the same shared runtime as ASIL-B (or higher) technical assets without " +
+			"being separated by trust boundaries, or separated by trust boundaries of a shared runtime (like a hypervisor) " +
+			"rated below the highest ASIL of the technical assets running on it. Also IPC and in-process communication " +
+			"links between QM and ASIL-B (or higher) technical assets not crossing a trust boundary. Technical assets " +
+			"inherit the safety integrity level of the trust boundary directly containing them. Hypervisors modeled as technical " +
+			"assets (technologies with the " + types.Hypervisor + " attribute) are checked like shared runtimes, with the technical " +
+			"assets inside the " + types.ExecutionEnvironment.String() + " trust boundaries nested inside the trust boundary directly " +
+			"containing the hypervisor being the ones running on it, and each of these trust boundaries isolating its technical assets.",
+		RiskAssessment: "The risk rating depends on the highest safety integrity level of the interfered components (ASIL-D " +
+			"being rated higher) and on whether the components are at least separated by trust boundaries.",
+		FalsePositives: "When freedom from interference is ensured by other means not expressed in the model, like memory " +
+			"protection units or temporal partitioning of a safety qualified operating system.",
+		ModelFailurePossibleReason: false,
+		CWE:                        653,
+	}
+}
+
+func (*MixedSafetyLevelsRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *MixedSafetyLevelsRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	// as in Go ranging over map is random order, range over them in sorted (hence reproducible) way:
+	keys := make([]string, 0)
+	for k := range input.SharedRuntimes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sharedRuntime := input.SharedRuntimes[key]
+		for _, technicalAssetId := range sharedRuntime.TechnicalAssetsRunning {
+			technicalAsset, ok := input.TechnicalAssets[technicalAssetId]
+			if !ok || input.SafetyIntegrityLevelOfTechnicalAsset(technicalAsset) != types.QM {
+				continue
+			}
+			interferedIds, isolated := make([]string, 0), true
+			highestLevel := types.QM
+			for _, otherAssetId := range sharedRuntime.TechnicalAssetsRunning {
+				otherAsset, ok := input.TechnicalAssets[otherAssetId]
+				if !ok || !isSafetyRelevant(input, otherAsset) {
+					continue
+				}
+				interferedIds = append(interferedIds, otherAssetId)
+				highestLevel = max(highestLevel, input.SafetyIntegrityLevelOfTechnicalAsset(otherAsset))
+				if !isSeparatedByTrustBoundary(input, technicalAsset.Id, otherAssetId) {
+					isolated = false
+				}
+			}
+			if len(interferedIds) == 0 || (isolated && sharedRuntime.SafetyIntegrityLevel >= highestLevel) {
+				continue
+			}
+			risks = append(risks, r.createSharedRuntimeRisk(technicalAsset, sharedRuntime, interferedIds, highestLevel, isolated))
+		}
+	}
+
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		hypervisor := input.TechnicalAssets[id]
+		if !hypervisor.Technologies.GetAttribute(types.Hypervisor) {
+			continue
+		}
+		risks = append(risks, r.generateHypervisorRisks(input, hypervisor)...)
+	}
+
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		for _, communicationLink := range input.TechnicalAssets[id].CommunicationLinksSorted() {
+			if communicationLink.Protocol != types.IPC && communicationLink.Protocol != types.InProcessLibraryCall {
+				continue
+			}
+			source, sourceOk := input.TechnicalAssets[communicationLink.SourceId]
+			target, targetOk := input.TechnicalAssets[communicationLink.TargetId]
+			if !sourceOk || !targetOk || isSeparatedByTrustBoundary(input, source.Id, target.Id) {
+				continue
+			}
+			sourceLevel := input.SafetyIntegrityLevelOfTechnicalAsset(source)
+			targetLevel := input.SafetyIntegrityLevelOfTechnicalAsset(target)
+			if sourceLevel == types.QM && isSafetyRelevant(input, target) {
+				risks = append(risks, r.createCommunicationLinkRisk(source, target, communicationLink, targetLevel))
+			} else if targetLevel == types.QM && isSafetyRelevant(input, source) {
+				risks = append(risks, r.createCommunicationLinkRisk(target, source, communicationLink, sourceLevel))
+			}
+		}
+	}
+	return risks, nil
+}
+
+// generateHypervisorRisks checks the technical assets running in the virtual machines hosted by a hypervisor modeled as
+// technical asset, where only technical assets running in distinct virtual machines are isolated from each other
+func (r *MixedSafetyLevelsRule) generateHypervisorRisks(input *types.Model, hypervisor *types.TechnicalAsset) []*types.Risk {
+	risks := make([]*types.Risk, 0)
+	virtualMachineIds := make(map[string]string)
+	hostedIds := make([]string, 0)
+	for _, virtualMachine := range hostedVirtualMachines(input, hypervisor) {
+		for _, technicalAssetId := range input.RecursivelyAllTechnicalAssetIDsInside(virtualMachine) {
+			if _, ok := input.TechnicalAssets[technicalAssetId]; ok {
+				virtualMachineIds[technicalAssetId] = virtualMachine.Id
+				hostedIds = append(hostedIds, technicalAssetId)
+			}
+		}
+	}
+	sort.Strings(hostedIds)
+
+	hypervisorLevel := input.SafetyIntegrityLevelOfTechnicalAsset(hypervisor)
+	for _, technicalAssetId := range hostedIds {
+		technicalAsset := input.TechnicalAssets[technicalAssetId]
+		if input.SafetyIntegrityLevelOfTechnicalAsset(technicalAsset) != types.QM {
+			continue
+		}
+		interferedIds, isolated := make([]string, 0), true
+		highestLevel := types.QM
+		for _, otherAssetId := range hostedIds {
+			otherAsset := input.TechnicalAssets[otherAssetId]
+			if !isSafetyRelevant(input, otherAsset) {
+				continue
+			}
+			interferedIds = append(interferedIds, otherAssetId)
+			highestLevel = max(highestLevel, input.SafetyIntegrityLevelOfTechnicalAsset(otherAsset))
+			if virtualMachineIds[technicalAssetId] == virtualMachineIds[otherAssetId] {
+				isolated = false
+			}
+		}
+		if len(interferedIds) == 0 || (isolated && hypervisorLevel >= highestLevel) {
+			continue
+		}
+		risks = append(risks, r.createHypervisorRisk(technicalAsset, hypervisor, hypervisorLevel, interferedIds, highestLevel, isolated))
+	}
+	return risks
+}
+
+func isSafetyRelevant(input *types.Model, technicalAsset *types.TechnicalAsset) bool {
+	return input.SafetyIntegrityLevelOfTechnicalAsset(technicalAsset) >= types.ASILB
+}
+
+func isSeparatedByTrustBoundary(input *types.Model, technicalAssetId string, otherAssetId string) bool {
+	trustBoundary, ok := input.DirectContainingTrustBoundaryMappedByTechnicalAssetId[technicalAssetId]
+	otherTrustBoundary, otherOk := input.DirectContainingTrustBoundaryMappedByTechnicalAssetId[otherAssetId]
+	if !ok || !otherOk {
+		return ok != otherOk
+	}
+	return trustBoundary.Id != otherTrustBoundary.Id
+}
+
+func mixedSafetyLevelsImpact(highestLevel types.SafetyIntegrityLevel) types.RiskExploitationImpact {
+	if highestLevel >= types.ASILD {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *MixedSafetyLevelsRule) createSharedRuntimeRisk(technicalAsset *types.TechnicalAsset, sharedRuntime *types.SharedRuntime,
+	interferedIds []string, highestLevel types.SafetyIntegrityLevel, isolated bool) *types.Risk {
+	risk := r.createHostRisk(technicalAsset, "shared runtime <b>"+sharedRuntime.Title+"</b>", sharedRuntime.SafetyIntegrityLevel,
+		interferedIds, highestLevel, isolated)
+	risk.MostRelevantSharedRuntimeId = sharedRuntime.Id
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id + "@" + sharedRuntime.Id
+	return risk
+}
+
+func (r *MixedSafetyLevelsRule) createHypervisorRisk(technicalAsset *types.TechnicalAsset, hypervisor *types.TechnicalAsset,
+	hypervisorLevel types.SafetyIntegrityLevel, interferedIds []string, highestLevel types.SafetyIntegrityLevel, isolated bool) *types.Risk {
+	risk := r.createHostRisk(technicalAsset, "hypervisor <b>"+hypervisor.Title+"</b>", hypervisorLevel, interferedIds, highestLevel, isolated)
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id + "@" + hypervisor.Id
+	return risk
+}
+
+func (r *MixedSafetyLevelsRule) createHostRisk(technicalAsset *types.TechnicalAsset, host string, hostLevel types.SafetyIntegrityLevel,
+	interferedIds []string, highestLevel types.SafetyIntegrityLevel, isolated bool) *types.Risk {
+	likelihood := types.Likely
+	title := "<b>Mixed Safety Levels</b> at <b>" + technicalAsset.Title + "</b> running on " + host +
+		" together with " + highestLevel.Title() + " components without an isolating trust boundary"
+	if isolated {
+		likelihood = types.Unlikely
+		title = "<b>Mixed Safety Levels</b> at <b>" + technicalAsset.Title + "</b> running on " + host +
+			" (rated " + hostLevel.Title() + ") together with " + highestLevel.Title() + " components"
+	}
+	impact := mixedSafetyLevelsImpact(highestLevel)
+	return &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  interferedIds,
+	}
+}
+
+func (r *MixedSafetyLevelsRule) createCommunicationLinkRisk(technicalAsset *types.TechnicalAsset, interferedAsset *types.TechnicalAsset,
+	communicationLink *types.CommunicationLink, interferedLevel types.SafetyIntegrityLevel) *types.Risk {
+	impact := mixedSafetyLevelsImpact(interferedLevel)
+	risk := &types.Risk{
+		CategoryId:             r.Category().ID,
+		Severity:               types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood: types.Likely,
+		ExploitationImpact:     impact,
+		Title: "<b>Mixed Safety Levels</b> at <b>" + technicalAsset.Title + "</b> sharing the IPC channel <b>" + communicationLink.Title +
+			"</b> with " + interferedLevel.Title() + " component <b>" + interferedAsset.Title + "</b> without an isolating trust boundary",
+		MostRelevantTechnicalAssetId:    technicalAsset.Id,
+		MostRelevantCommunicationLinkId: communicationLink.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     []string{interferedAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + communicationLink.Id
+	return risk
+}
@@ -0,0 +1,232 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMixedSafetyLevelsRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func createMixedSafetyLevelsTestModel(qmBoundary *types.TrustBoundary, asilBoundary *types.TrustBoundary, sharedRuntime *types.SharedRuntime) *types.Model {
+	model := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"qm":   {Id: "qm", Title: "Linux Agent"},
+			"asil": {Id: "asil", Title: "Vehicle Control", SafetyIntegrityLevel: types.ASILD},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{},
+		SharedRuntimes:  map[string]*types.SharedRuntime{},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{},
+	}
+	if qmBoundary != nil {
+		model.TrustBoundaries[qmBoundary.Id] = qmBoundary
+		model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["qm"] = qmBoundary
+	}
+	if asilBoundary != nil {
+		model.TrustBoundaries[asilBoundary.Id] = asilBoundary
+		model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["asil"] = asilBoundary
+	}
+	if sharedRuntime != nil {
+		model.SharedRuntimes[sharedRuntime.Id] = sharedRuntime
+	}
+	return model
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksSharedRuntimeWithoutTrustBoundariesRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsTestModel(nil, nil, &types.SharedRuntime{
+		Id:                     "hypervisor",
+		Title:                  "Hypervisor",
+		SafetyIntegrityLevel:   types.ASILD,
+		TechnicalAssetsRunning: []string{"qm", "asil"},
+	})
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "mixed-safety-levels@qm@hypervisor", risks[0].SyntheticId)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, "hypervisor", risks[0].MostRelevantSharedRuntimeId)
+	assert.Equal(t, []string{"asil"}, risks[0].DataBreachTechnicalAssetIDs)
+	expTitle := "<b>Mixed Safety Levels</b> at <b>Linux Agent</b> running on shared runtime <b>Hypervisor</b> together with ASIL D components without an isolating trust boundary"
+	assert.Equal(t, expTitle, risks[0].Title)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksSharedRuntimeRatedBelowHostedAssetsRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsTestModel(
+		&types.TrustBoundary{Id: "vm1", Type: types.ExecutionEnvironment},
+		&types.TrustBoundary{Id: "vm2", Type: types.ExecutionEnvironment, SafetyIntegrityLevel: types.ASILB},
+		&types.SharedRuntime{
+			Id:                     "hypervisor",
+			Title:                  "Hypervisor",
+			TechnicalAssetsRunning: []string{"qm", "asil"},
+		})
+	model.TechnicalAssets["asil"].SafetyIntegrityLevel = types.QM
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+	expTitle := "<b>Mixed Safety Levels</b> at <b>Linux Agent</b> running on shared runtime <b>Hypervisor</b> (rated QM) together with ASIL B components"
+	assert.Equal(t, expTitle, risks[0].Title)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksSharedRuntimeIsolatingTrustBoundariesNoRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsTestModel(
+		&types.TrustBoundary{Id: "vm1", Type: types.ExecutionEnvironment},
+		&types.TrustBoundary{Id: "vm2", Type: types.ExecutionEnvironment},
+		&types.SharedRuntime{
+			Id:                     "hypervisor",
+			Title:                  "Hypervisor",
+			SafetyIntegrityLevel:   types.ASILD,
+			TechnicalAssetsRunning: []string{"qm", "asil"},
+		})
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksSharedRuntimeAsilAOnlyNoRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsTestModel(nil, nil, &types.SharedRuntime{
+		Id:                     "hypervisor",
+		Title:                  "Hypervisor",
+		TechnicalAssetsRunning: []string{"qm", "asil"},
+	})
+	model.TechnicalAssets["asil"].SafetyIntegrityLevel = types.ASILA
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksIpcWithinTrustBoundaryRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	partition := &types.TrustBoundary{Id: "partition", Type: types.ExecutionEnvironment}
+	model := createMixedSafetyLevelsTestModel(partition, partition, nil)
+	model.TechnicalAssets["asil"].SafetyIntegrityLevel = types.ASILB
+	model.TechnicalAssets["asil"].CommunicationLinks = []*types.CommunicationLink{
+		{Id: "asil>status", Title: "Status", SourceId: "asil", TargetId: "qm", Protocol: types.IPC},
+	}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "mixed-safety-levels@asil>status", risks[0].SyntheticId)
+	assert.Equal(t, "qm", risks[0].MostRelevantTechnicalAssetId)
+	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+	expTitle := "<b>Mixed Safety Levels</b> at <b>Linux Agent</b> sharing the IPC channel <b>Status</b> with ASIL B component <b>Vehicle Control</b> without an isolating trust boundary"
+	assert.Equal(t, expTitle, risks[0].Title)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksIpcAcrossTrustBoundaryNoRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsTestModel(
+		&types.TrustBoundary{Id: "vm1", Type: types.ExecutionEnvironment},
+		&types.TrustBoundary{Id: "vm2", Type: types.ExecutionEnvironment},
+		nil)
+	model.TechnicalAssets["qm"].CommunicationLinks = []*types.CommunicationLink{
+		{Id: "qm>command", Title: "Command", SourceId: "qm", TargetId: "asil", Protocol: types.IPC},
+	}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksTrustBoundaryLevelInheritedNoRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	partition := &types.TrustBoundary{Id: "partition", Type: types.ExecutionEnvironment, SafetyIntegrityLevel: types.ASILD}
+	model := createMixedSafetyLevelsTestModel(partition, partition, nil)
+	model.TechnicalAssets["qm"].CommunicationLinks = []*types.CommunicationLink{
+		{Id: "qm>command", Title: "Command", SourceId: "qm", TargetId: "asil", Protocol: types.InProcessLibraryCall},
+	}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func createMixedSafetyLevelsHypervisorTestModel(hypervisorLevel types.SafetyIntegrityLevel, qmVirtualMachine string, asilVirtualMachine string) *types.Model {
+	model := createMixedSafetyLevelsTestModel(nil, nil, nil)
+	model.TechnicalAssets["hypervisor"] = &types.TechnicalAsset{
+		Id:                   "hypervisor",
+		Title:                "Type-1 Hypervisor",
+		SafetyIntegrityLevel: hypervisorLevel,
+		Technologies:         types.TechnologyList{{Name: "hypervisor", Attributes: map[string]bool{types.Hypervisor: true}}},
+	}
+	model.TrustBoundaries["ecu"] = &types.TrustBoundary{
+		Id:                    "ecu",
+		TechnicalAssetsInside: []string{"hypervisor"},
+		TrustBoundariesNested: []string{"vm1", "vm2"},
+	}
+	model.TrustBoundaries["vm1"] = &types.TrustBoundary{Id: "vm1", Title: "VM 1", Type: types.ExecutionEnvironment}
+	model.TrustBoundaries["vm2"] = &types.TrustBoundary{Id: "vm2", Title: "VM 2", Type: types.ExecutionEnvironment}
+	model.TrustBoundaries[qmVirtualMachine].TechnicalAssetsInside = append(model.TrustBoundaries[qmVirtualMachine].TechnicalAssetsInside, "qm")
+	model.TrustBoundaries[asilVirtualMachine].TechnicalAssetsInside = append(model.TrustBoundaries[asilVirtualMachine].TechnicalAssetsInside, "asil")
+	model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["hypervisor"] = model.TrustBoundaries["ecu"]
+	model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["qm"] = model.TrustBoundaries[qmVirtualMachine]
+	model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["asil"] = model.TrustBoundaries[asilVirtualMachine]
+	return model
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksHypervisorSameVirtualMachineRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsHypervisorTestModel(types.ASILD, "vm1", "vm1")
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "mixed-safety-levels@qm@hypervisor", risks[0].SyntheticId)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, "qm", risks[0].MostRelevantTechnicalAssetId)
+	assert.Empty(t, risks[0].MostRelevantSharedRuntimeId)
+	assert.Equal(t, []string{"asil"}, risks[0].DataBreachTechnicalAssetIDs)
+	expTitle := "<b>Mixed Safety Levels</b> at <b>Linux Agent</b> running on hypervisor <b>Type-1 Hypervisor</b> together with ASIL D components without an isolating trust boundary"
+	assert.Equal(t, expTitle, risks[0].Title)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksHypervisorRatedBelowHostedAssetsRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsHypervisorTestModel(types.QM, "vm1", "vm2")
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+	expTitle := "<b>Mixed Safety Levels</b> at <b>Linux Agent</b> running on hypervisor <b>Type-1 Hypervisor</b> (rated QM) together with ASIL D components"
+	assert.Equal(t, expTitle, risks[0].Title)
+}
+
+func TestMixedSafetyLevelsRuleGenerateRisksHypervisorIsolatingVirtualMachinesNoRisksCreated(t *testing.T) {
+	rule := NewMixedSafetyLevelsRule()
+	model := createMixedSafetyLevelsHypervisorTestModel(types.ASILD, "vm1", "vm2")
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
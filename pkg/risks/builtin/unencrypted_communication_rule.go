@@ -1,7 +1,9 @@
 package builtin
 
 import (
+	"fmt"
 	"slices"
+	"sort"
 
 	"github.com/threagile/threagile/pkg/types"
 )
@@ -26,9 +28,10 @@ func (*UnencryptedCommunicationRule) Category() *types.RiskCategory {
 		Check:      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
 		Function:   types.Operations,
 		STRIDE:     types.InformationDisclosure,
-		DetectionLogic: "Unencrypted technical communication links of in-scope technical assets (excluding " + types.Monitoring + " traffic as well as " + types.LocalFileAccess.String() + ", " + types.InProcessLibraryCall.String() + " and " + types.InterProcessCommunication.String() + ") " +
+		DetectionLogic: "Unencrypted technical communication links of in-scope technical assets (excluding " + types.Monitoring + " traffic as well as " + types.LocalFileAccess.String() + ", " + types.InProcessLibraryCall.String() + ", " + types.InterProcessCommunication.String() + " and other on-chip communication like " + types.IPC.String() + ") " +
 			"transferring sensitive data.", // TODO more detailed text required here
-		RiskAssessment: "Depending on the confidentiality rating of the transferred data-assets either medium or high risk.",
+		RiskAssessment: "Depending on the confidentiality rating of the transferred data-assets either medium or high risk. " +
+			"Communication across a network trust boundary or over a broadcast bus (like " + types.CAN.String() + ") readable by all connected nodes is more likely to be exploited.",
 		FalsePositives: "When all sensitive data sent over the communication link is already fully encrypted on document or data level. " +
 			"Also intra-container/pod communication can be considered false positive when container orchestration platform handles encryption.",
 		ModelFailurePossibleReason: false,
@@ -51,7 +54,7 @@ func (r *UnencryptedCommunicationRule) GenerateRisks(input *types.Model) ([]*typ
 			if sourceAsset.OutOfScope && targetAsset.OutOfScope {
 				continue
 			}
-			if dataFlow.Protocol.IsEncrypted() || dataFlow.Protocol.IsProcessLocal() {
+			if dataFlow.Protocol.IsEncrypted() || dataFlow.Protocol.IsOnChip() {
 				continue
 			}
 			if sourceAsset.Technologies.GetAttribute(types.IsUnprotectedCommunicationsTolerated) ||
@@ -88,12 +91,15 @@ func (r *UnencryptedCommunicationRule) createRisk(input *types.Model, technicalA
 	if transferringAuthData {
 		title += " transferring authentication data (like credentials, token, session-id, etc.)"
 	}
+	if dataFlow.Protocol.IsBroadcast() {
+		title += " over a broadcast bus readable by all connected nodes"
+	}
 	if dataFlow.VPN {
 		title += " (even VPN-protected connections need to encrypt their data in-transit when confidentiality is " +
 			"rated " + types.StrictlyConfidential.String() + " or integrity is rated " + types.MissionCritical.String() + ")"
 	}
 	likelihood := types.Unlikely
-	if isAcrossTrustBoundaryNetworkOnly(input, dataFlow) {
+	if isAcrossTrustBoundaryNetworkOnly(input, dataFlow) || dataFlow.Protocol.IsBroadcast() {
 		likelihood = types.Likely
 	}
 	risk := &types.Risk{
@@ -118,3 +124,60 @@ func isHighSensitivity(dataAsset *types.DataAsset) bool {
 func isMediumSensitivity(dataAsset *types.DataAsset) bool {
 	return dataAsset.Confidentiality == types.Confidential || dataAsset.Integrity == types.Critical
 }
+
+// ExplainRisk states the conditions the communication links of the risks with a synthetic id matching the given one met
+func (r *UnencryptedCommunicationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	generatedRisks, _ := r.GenerateRisks(parsedModel)
+	sort.Slice(generatedRisks, func(i, j int) bool {
+		return generatedRisks[i].SyntheticId < generatedRisks[j].SyntheticId
+	})
+	for _, generatedRisk := range generatedRisks {
+		if !types.MatchesSyntheticRiskId(risk, generatedRisk.SyntheticId) {
+			continue
+		}
+
+		sourceAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		var dataFlow *types.CommunicationLink
+		for _, commLink := range sourceAsset.CommunicationLinks {
+			if commLink.Id == generatedRisk.MostRelevantCommunicationLinkId {
+				dataFlow = commLink
+				break
+			}
+		}
+		if dataFlow == nil {
+			continue
+		}
+		targetAsset := parsedModel.TechnicalAssets[dataFlow.TargetId]
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("communication link %q from %q to %q", dataFlow.Id, dataFlow.SourceId, dataFlow.TargetId),
+			fmt.Sprintf("  - out of scope: source %v, target %v (not both)", sourceAsset.OutOfScope, targetAsset.OutOfScope),
+			fmt.Sprintf("  - protocol: %v (neither encrypted nor on-chip)", dataFlow.Protocol),
+		)
+		if dataFlow.Protocol.IsBroadcast() {
+			explanation = append(explanation, "  - protocol is a broadcast bus readable by all connected nodes (more likely to be exploited)")
+		}
+		if isAcrossTrustBoundaryNetworkOnly(parsedModel, dataFlow) {
+			explanation = append(explanation, "  - crosses a network trust boundary (more likely to be exploited)")
+		}
+		if dataFlow.Authentication != types.NoneAuthentication {
+			explanation = append(explanation, fmt.Sprintf("  - authentication: %v (!=%v, transferring authentication data)", dataFlow.Authentication, types.NoneAuthentication))
+		}
+		dataAssetIds := append(slices.Clone(dataFlow.DataAssetsSent), dataFlow.DataAssetsReceived...)
+		slices.Sort(dataAssetIds)
+		for _, dataAssetId := range slices.Compact(dataAssetIds) {
+			dataAsset := parsedModel.DataAssets[dataAssetId]
+			if isHighSensitivity(dataAsset) {
+				explanation = append(explanation, fmt.Sprintf("  - data asset %q: confidentiality %v, integrity %v (%v or %v)",
+					dataAsset.Id, dataAsset.Confidentiality, dataAsset.Integrity, types.StrictlyConfidential, types.MissionCritical))
+			} else if !dataFlow.VPN && isMediumSensitivity(dataAsset) {
+				explanation = append(explanation, fmt.Sprintf("  - data asset %q: confidentiality %v, integrity %v (%v or %v, not sent via VPN)",
+					dataAsset.Id, dataAsset.Confidentiality, dataAsset.Integrity, types.Confidential, types.Critical))
+			}
+		}
+	}
+	return explanation
+}
@@ -477,6 +477,51 @@ func TestUnencryptedCommunicationRuleGenerateRisks(t *testing.T) {
 
 			riskCreated: false,
 		},
+		"Local": {
+			sourceOutOfScope: false,
+			targetOutOfScope: false,
+			protocol:         types.Local,
+
+			riskCreated: false,
+		},
+		"send high sensitive data via IPC": {
+			sendAnyData:              true,
+			dataAssetConfidentiality: types.StrictlyConfidential,
+			dataAssetIntegrity:       types.MissionCritical,
+			protocol:                 types.IPC,
+			isAcrossTrustBoundary:    true,
+
+			riskCreated: false,
+		},
+		"send high sensitive data via SOME/IP-TLS": {
+			sendAnyData:              true,
+			dataAssetConfidentiality: types.StrictlyConfidential,
+			dataAssetIntegrity:       types.MissionCritical,
+			protocol:                 types.SomeIpTls,
+
+			riskCreated: false,
+		},
+		"send high sensitive data via SOME/IP": {
+			sendAnyData:              true,
+			dataAssetConfidentiality: types.StrictlyConfidential,
+			dataAssetIntegrity:       types.MissionCritical,
+			protocol:                 types.SomeIP,
+
+			riskCreated:        true,
+			expectedImpact:     types.HighImpact,
+			expectedLikelihood: types.Unlikely,
+		},
+		"send high sensitive data via CAN": {
+			sendAnyData:              true,
+			dataAssetConfidentiality: types.StrictlyConfidential,
+			dataAssetIntegrity:       types.MissionCritical,
+			protocol:                 types.CAN,
+
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedLikelihood:  types.Likely,
+			expectedSuffixTitle: " over a broadcast bus readable by all connected nodes",
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -582,3 +627,39 @@ func TestUnencryptedCommunicationRuleGenerateRisks(t *testing.T) {
 		})
 	}
 }
+
+func TestUnencryptedCommunicationRuleExplainRisk(t *testing.T) {
+	rule := NewUnencryptedCommunicationRule()
+	model := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"gateway": {
+				Id: "gateway",
+				CommunicationLinks: []*types.CommunicationLink{
+					{
+						Id:             "gateway>torque-request",
+						SourceId:       "gateway",
+						TargetId:       "ecu",
+						Protocol:       types.CAN,
+						DataAssetsSent: []string{"torque", "status"},
+					},
+				},
+			},
+			"ecu": {Id: "ecu"},
+		},
+		DataAssets: map[string]*types.DataAsset{
+			"torque": {Id: "torque", Confidentiality: types.Internal, Integrity: types.MissionCritical},
+			"status": {Id: "status", Confidentiality: types.Public, Integrity: types.Operational},
+		},
+	}
+
+	explanation := rule.ExplainRisk(model, "unencrypted-communication@gateway>torque-request@*@*")
+
+	assert.Equal(t, []string{
+		`communication link "gateway>torque-request" from "gateway" to "ecu"`,
+		"  - out of scope: source false, target false (not both)",
+		"  - protocol: can-bus (neither encrypted nor on-chip)",
+		"  - protocol is a broadcast bus readable by all connected nodes (more likely to be exploited)",
+		`  - data asset "torque": confidentiality internal, integrity mission-critical (strictly-confidential or mission-critical)`,
+	}, explanation)
+	assert.Empty(t, rule.ExplainRisk(model, "unencrypted-communication@ecu>*@*@*"))
+}
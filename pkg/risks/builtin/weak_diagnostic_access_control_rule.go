@@ -0,0 +1,225 @@
+package builtin
+
+import (
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type WeakDiagnosticAccessControlRule struct{}
+
+func NewWeakDiagnosticAccessControlRule() *WeakDiagnosticAccessControlRule {
+	return &WeakDiagnosticAccessControlRule{}
+}
+
+func (*WeakDiagnosticAccessControlRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "weak-diagnostic-access-control",
+		Title: "Weak Diagnostic Access Control",
+		Description: "Diagnostic entry points (like OBD-II testers, UDS, SOVD or DoIP clients) are able to read and write " +
+			"memory, change the configuration, run routines and reprogram the ECUs reachable by them. " +
+			"Communication links carrying diagnostic requests must be protected by security access or authentication " +
+			"at least as strong as the integrity of the safety relevant technical assets reachable via them.",
+		Impact: "If this risk is unmitigated, attackers with access to a diagnostic entry point (like a manipulated " +
+			"diagnostic tester or a dongle plugged into the OBD-II port) might be able to manipulate or reprogram " +
+			"safety relevant ECUs, thereby causing hazardous vehicle behavior.",
+		ASVS:       "V2 - Authentication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authentication_Cheat_Sheet.html",
+		Action:     "Diagnostic Access Control",
+		Mitigation: "Protect diagnostic services by UDS authentication (service 0x29) with certificates of the tester " +
+			"instead of (or in addition to) UDS security access (service 0x27) with static seed and key algorithms, " +
+			"authenticate SOVD clients with tokens bound to the role of the tester, restrict the services available per " +
+			"diagnostic session and role, and block diagnostic requests while driving.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "Diagnostic entry points are out-of-scope technical assets or external entities with a diagnostic " +
+			"technology (with the " + types.IsDiagnosticOBD + ", " + types.IsDiagnosticUDS + ", " + types.IsDiagnosticSOVD +
+			" or " + types.IsDiagnosticDoIP + " attribute). Their diagnostic requests are followed along communication links " +
+			"sending the data assets sent by the entry point or targeting diagnostic technical assets. A risk is raised for " +
+			"each such communication link whose authentication is weaker than required by the highest integrity of the " +
+			"safety relevant in-scope technical assets reachable via it: " + types.Important.String() + " requires " +
+			"at least " + types.Credentials.String() + " (like UDS security access), " + types.Critical.String() +
+			" requires at least " + types.Token.String() + " and " + types.MissionCritical.String() + " requires " +
+			types.ClientCertificate.String() + " or " + types.TwoFactor.String() + " authentication.",
+		RiskAssessment: "The risk rating depends on the highest integrity rating and safety integrity level of the " +
+			"technical assets reachable via the communication link. Links without any authentication are rated as " +
+			"more likely.",
+		FalsePositives: "Diagnostic services offering read access only (like reading OBD-II emission data) can be " +
+			"considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        306,
+	}
+}
+
+func (*WeakDiagnosticAccessControlRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *WeakDiagnosticAccessControlRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	checkedLinks := make(map[string]bool)
+	for _, id := range input.SortedTechnicalAssetIDs() {
+		entryPoint := input.TechnicalAssets[id]
+		if !isDiagnosticEntryPoint(entryPoint) {
+			continue
+		}
+		diagnosticDataAssets := make([]string, 0)
+		for _, link := range entryPoint.CommunicationLinks {
+			diagnosticDataAssets = append(diagnosticDataAssets, link.DataAssetsSent...)
+		}
+		for _, link := range diagnosticLinks(input, entryPoint, diagnosticDataAssets) {
+			if checkedLinks[link.Id] {
+				continue
+			}
+			checkedLinks[link.Id] = true
+			reachable := reachableSafetyRelevantAssets(input, input.TechnicalAssets[link.TargetId], diagnosticDataAssets)
+			if len(reachable) == 0 {
+				continue
+			}
+			highestIntegrity := types.Archive
+			for _, asset := range reachable {
+				highestIntegrity = max(highestIntegrity, input.HighestIntegrity(asset))
+			}
+			if diagnosticAuthenticationStrength(link.Authentication) >= requiredDiagnosticAuthenticationStrength(highestIntegrity) {
+				continue
+			}
+			risks = append(risks, r.createRisk(input, entryPoint, link, reachable, highestIntegrity))
+		}
+	}
+	return risks, nil
+}
+
+func isDiagnosticTechnology(technicalAsset *types.TechnicalAsset) bool {
+	return technicalAsset.Technologies.GetAttribute(types.IsDiagnosticOBD, types.IsDiagnosticUDS, types.IsDiagnosticSOVD, types.IsDiagnosticDoIP)
+}
+
+func isDiagnosticEntryPoint(technicalAsset *types.TechnicalAsset) bool {
+	return isDiagnosticTechnology(technicalAsset) && (technicalAsset.OutOfScope || technicalAsset.Type == types.ExternalEntity)
+}
+
+// isDiagnosticLink tells whether diagnostic requests are forwarded via the communication link, which is the case when
+// it sends any of the data assets sent by the diagnostic entry point or targets a diagnostic technical asset
+func isDiagnosticLink(input *types.Model, link *types.CommunicationLink, diagnosticDataAssets []string) bool {
+	for _, dataAsset := range link.DataAssetsSent {
+		if contains(diagnosticDataAssets, dataAsset) {
+			return true
+		}
+	}
+	target, ok := input.TechnicalAssets[link.TargetId]
+	return ok && isDiagnosticTechnology(target)
+}
+
+// diagnosticLinks returns the communication links diagnostic requests of the entry point take, in the order of traversal
+func diagnosticLinks(input *types.Model, entryPoint *types.TechnicalAsset, diagnosticDataAssets []string) []*types.CommunicationLink {
+	links := make([]*types.CommunicationLink, 0)
+	visited := map[string]bool{entryPoint.Id: true}
+	queue := []*types.TechnicalAsset{entryPoint}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, link := range current.CommunicationLinksSorted() {
+			target, ok := input.TechnicalAssets[link.TargetId]
+			if !ok || !isDiagnosticLink(input, link, diagnosticDataAssets) {
+				continue
+			}
+			links = append(links, link)
+			if !visited[target.Id] {
+				visited[target.Id] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+	return links
+}
+
+// reachableSafetyRelevantAssets returns the in-scope technical assets with a safety integrity level above QM or an
+// integrity rating of critical or higher reachable by diagnostic requests from (and including) the given asset
+func reachableSafetyRelevantAssets(input *types.Model, start *types.TechnicalAsset, diagnosticDataAssets []string) []*types.TechnicalAsset {
+	reachable := make([]*types.TechnicalAsset, 0)
+	visited := map[string]bool{start.Id: true}
+	queue := []*types.TechnicalAsset{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if !current.OutOfScope &&
+			(input.SafetyIntegrityLevelOfTechnicalAsset(current) > types.QM || input.HighestIntegrity(current) >= types.Critical) {
+			reachable = append(reachable, current)
+		}
+		for _, link := range current.CommunicationLinksSorted() {
+			target, ok := input.TechnicalAssets[link.TargetId]
+			if !ok || visited[target.Id] || !isDiagnosticLink(input, link, diagnosticDataAssets) {
+				continue
+			}
+			visited[target.Id] = true
+			queue = append(queue, target)
+		}
+	}
+	return reachable
+}
+
+func diagnosticAuthenticationStrength(authentication types.Authentication) int {
+	switch authentication {
+	case types.ClientCertificate, types.TwoFactor:
+		return 3
+	case types.Token, types.Externalized:
+		return 2
+	case types.Credentials, types.SessionId:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func requiredDiagnosticAuthenticationStrength(integrity types.Criticality) int {
+	switch integrity {
+	case types.MissionCritical:
+		return 3
+	case types.Critical:
+		return 2
+	case types.Important:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (r *WeakDiagnosticAccessControlRule) createRisk(input *types.Model, entryPoint *types.TechnicalAsset, link *types.CommunicationLink,
+	reachable []*types.TechnicalAsset, highestIntegrity types.Criticality) *types.Risk {
+	impact := types.LowImpact
+	if highestIntegrity == types.MissionCritical {
+		impact = types.HighImpact
+	} else if highestIntegrity == types.Critical {
+		impact = types.MediumImpact
+	}
+	reachableIds := make([]string, 0)
+	highestSafetyIntegrityLevel := types.QM
+	for _, asset := range reachable {
+		reachableIds = append(reachableIds, asset.Id)
+		highestSafetyIntegrityLevel = max(highestSafetyIntegrityLevel, input.SafetyIntegrityLevelOfTechnicalAsset(asset))
+	}
+	if highestSafetyIntegrityLevel >= types.ASILC {
+		impact++
+	}
+	likelihood := types.Unlikely
+	if link.Authentication == types.NoneAuthentication {
+		likelihood = types.Likely
+	}
+
+	sourceAsset := input.TechnicalAssets[link.SourceId]
+	targetAsset := input.TechnicalAssets[link.TargetId]
+	title := "<b>Weak Diagnostic Access Control</b> of communication link <b>" + link.Title + "</b> from <b>" + sourceAsset.Title +
+		"</b> to <b>" + targetAsset.Title + "</b> reachable from diagnostic entry point <b>" + entryPoint.Title + "</b> " +
+		"with " + link.Authentication.String() + " authentication while reaching assets of " + highestIntegrity.String() + " integrity"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: link.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     reachableIds,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + link.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
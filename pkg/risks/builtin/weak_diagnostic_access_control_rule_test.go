@@ -0,0 +1,177 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestWeakDiagnosticAccessControlRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewWeakDiagnosticAccessControlRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+// createDiagnosticTestModel creates the diagnostic path tester -> sovd -> bridge -> ecu next to a non-diagnostic link
+// from the sovd server to a logger
+func createDiagnosticTestModel(testerAuthentication types.Authentication, ecuIntegrity types.Criticality) *types.Model {
+	diagnosticTechnology := func(attribute string) types.TechnologyList {
+		return types.TechnologyList{{Name: attribute, Attributes: map[string]bool{attribute: true}}}
+	}
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"tester": {
+				Id:           "tester",
+				Title:        "Diagnostic Tester",
+				Type:         types.ExternalEntity,
+				OutOfScope:   true,
+				Technologies: diagnosticTechnology(types.IsDiagnosticOBD),
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "tester>sovd", Title: "SOVD", SourceId: "tester", TargetId: "sovd", Authentication: testerAuthentication, DataAssetsSent: []string{"requests"}},
+				},
+			},
+			"sovd": {
+				Id:           "sovd",
+				Title:        "SOVD Server",
+				Integrity:    types.Important,
+				Technologies: diagnosticTechnology(types.IsDiagnosticSOVD),
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "sovd>bridge", Title: "IPC", SourceId: "sovd", TargetId: "bridge", Authentication: types.ClientCertificate},
+					{Id: "sovd>logger", Title: "Log", SourceId: "sovd", TargetId: "logger"},
+				},
+			},
+			"bridge": {
+				Id:           "bridge",
+				Title:        "Diagnostic Bridge",
+				Integrity:    types.Important,
+				Technologies: diagnosticTechnology(types.IsDiagnosticUDS),
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "bridge>ecu", Title: "UDS over CAN", SourceId: "bridge", TargetId: "ecu", Authentication: types.ClientCertificate, DataAssetsSent: []string{"requests"}},
+				},
+			},
+			"ecu":    {Id: "ecu", Title: "Brake ECU", Integrity: ecuIntegrity},
+			"logger": {Id: "logger", Title: "Logger", Integrity: types.MissionCritical},
+		},
+	}
+}
+
+func TestWeakDiagnosticAccessControlRuleGenerateRisksStrongAuthenticationNotRisksCreated(t *testing.T) {
+	rule := NewWeakDiagnosticAccessControlRule()
+
+	risks, err := rule.GenerateRisks(createDiagnosticTestModel(types.ClientCertificate, types.MissionCritical))
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestWeakDiagnosticAccessControlRuleGenerateRisksNotSafetyRelevantNotRisksCreated(t *testing.T) {
+	rule := NewWeakDiagnosticAccessControlRule()
+
+	risks, err := rule.GenerateRisks(createDiagnosticTestModel(types.NoneAuthentication, types.Important))
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestWeakDiagnosticAccessControlRuleGenerateRisksNoEntryPointNotRisksCreated(t *testing.T) {
+	rule := NewWeakDiagnosticAccessControlRule()
+	model := createDiagnosticTestModel(types.NoneAuthentication, types.MissionCritical)
+	model.TechnicalAssets["tester"].Technologies = types.TechnologyList{{Name: "tool"}}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestWeakDiagnosticAccessControlRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]struct {
+		authentication     types.Authentication
+		ecuIntegrity       types.Criticality
+		safetyLevel        types.SafetyIntegrityLevel
+		expectRisk         bool
+		expectedLikelihood types.RiskExploitationLikelihood
+		expectedImpact     types.RiskExploitationImpact
+	}{
+		"none critical": {
+			authentication:     types.NoneAuthentication,
+			ecuIntegrity:       types.Critical,
+			expectRisk:         true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"credentials critical": {
+			authentication:     types.Credentials,
+			ecuIntegrity:       types.Critical,
+			expectRisk:         true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"token critical": {
+			authentication: types.Token,
+			ecuIntegrity:   types.Critical,
+		},
+		"token mission critical": {
+			authentication:     types.Token,
+			ecuIntegrity:       types.MissionCritical,
+			expectRisk:         true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.HighImpact,
+		},
+		"credentials asil-d": {
+			authentication:     types.Credentials,
+			ecuIntegrity:       types.MissionCritical,
+			safetyLevel:        types.ASILD,
+			expectRisk:         true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.VeryHighImpact,
+		},
+		"two-factor mission critical": {
+			authentication: types.TwoFactor,
+			ecuIntegrity:   types.MissionCritical,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewWeakDiagnosticAccessControlRule()
+			model := createDiagnosticTestModel(testCase.authentication, testCase.ecuIntegrity)
+			model.TechnicalAssets["ecu"].SafetyIntegrityLevel = testCase.safetyLevel
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if !testCase.expectRisk {
+				assert.Empty(t, risks)
+				return
+			}
+			assert.Len(t, risks, 1)
+			assert.Equal(t, "weak-diagnostic-access-control@tester>sovd@tester@sovd", risks[0].SyntheticId)
+			assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+			assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+			assert.Equal(t, []string{"ecu"}, risks[0].DataBreachTechnicalAssetIDs)
+			expTitle := "<b>Weak Diagnostic Access Control</b> of communication link <b>SOVD</b> from <b>Diagnostic Tester</b> " +
+				"to <b>SOVD Server</b> reachable from diagnostic entry point <b>Diagnostic Tester</b> with " +
+				testCase.authentication.String() + " authentication while reaching assets of " + testCase.ecuIntegrity.String() + " integrity"
+			assert.Equal(t, expTitle, risks[0].Title)
+		})
+	}
+}
+
+func TestWeakDiagnosticAccessControlRuleGenerateRisksWeakLinkBehindGatewayRisksCreated(t *testing.T) {
+	rule := NewWeakDiagnosticAccessControlRule()
+	model := createDiagnosticTestModel(types.ClientCertificate, types.MissionCritical)
+	model.TechnicalAssets["bridge"].CommunicationLinks[0].Authentication = types.NoneAuthentication
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "weak-diagnostic-access-control@bridge>ecu@bridge@ecu", risks[0].SyntheticId)
+	assert.Equal(t, "ecu", risks[0].MostRelevantTechnicalAssetId)
+	assert.Equal(t, "bridge>ecu", risks[0].MostRelevantCommunicationLinkId)
+}
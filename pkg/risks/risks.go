@@ -21,7 +21,9 @@ func GetBuiltInRiskRules() types.RiskRules {
 		builtin.NewCrossSiteRequestForgeryRule(),
 		builtin.NewCrossSiteScriptingRule(),
 		builtin.NewDosRiskyAccessAcrossTrustBoundaryRule(),
+		builtin.NewHypervisorEscapeRule(),
 		builtin.NewIncompleteModelRule(),
+		builtin.NewInsecureUpdateChainRule(),
 		builtin.NewLdapInjectionRule(),
 		builtin.NewMissingAuthenticationRule(),
 		builtin.NewMissingAuthenticationSecondFactorRule(builtin.NewMissingAuthenticationRule()),
@@ -32,10 +34,14 @@ func GetBuiltInRiskRules() types.RiskRules {
 		builtin.NewMissingIdentityPropagationRule(),
 		builtin.NewMissingIdentityProviderIsolationRule(),
 		builtin.NewMissingIdentityStoreRule(),
+		builtin.NewMissingKeyIsolationRule(),
+		builtin.NewMissingMessageAuthenticationRule(),
 		builtin.NewMissingNetworkSegmentationRule(),
+		builtin.NewMissingSecureBootChainRule(),
 		builtin.NewMissingVaultRule(),
 		builtin.NewMissingVaultIsolationRule(),
 		builtin.NewMissingWafRule(),
+		builtin.NewMixedSafetyLevelsRule(),
 		builtin.NewMixedTargetsOnSharedRuntimeRule(),
 		builtin.NewPathTraversalRule(),
 		builtin.NewPushInsteadPullDeploymentRule(),
@@ -53,6 +59,7 @@ func GetBuiltInRiskRules() types.RiskRules {
 		builtin.NewUnnecessaryDataTransferRule(),
 		builtin.NewUnnecessaryTechnicalAssetRule(),
 		builtin.NewUntrustedDeserializationRule(),
+		builtin.NewWeakDiagnosticAccessControlRule(),
 		builtin.NewWrongCommunicationLinkContentRule(),
 		builtin.NewWrongTrustBoundaryContentRule(),
 		builtin.NewXmlExternalEntityRule(),
@@ -0,0 +1,326 @@
+package xsam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// Applicability decides whether a threat applies to a technical asset. It is parsed from expressions like
+//
+//	technology:electronic-control-unit and (protocol:can-bus or protocol:can-fd) and not boundary-tag:lab
+//
+// combining terms with "and", "or", "not" and parentheses ("and" binds stronger than "or"). Terms are
+//
+//	technology:<name>    the asset has a technology of that name or with that attribute
+//	type:<type>          the asset is of that technical asset type (external-entity, process, datastore)
+//	protocol:<protocol>  any incoming communication link of the asset uses that protocol
+//	tag:<tag>            the asset is tagged
+//	boundary-tag:<tag>   any trust boundary containing the asset (directly or nested) is tagged
+//	data-tag:<tag>       any data asset processed, stored or received by the asset is tagged
+type Applicability interface {
+	Applies(parsedModel *types.Model, technicalAsset *types.TechnicalAsset) bool
+	String() string
+}
+
+const (
+	technologyTerm  = "technology"
+	typeTerm        = "type"
+	protocolTerm    = "protocol"
+	tagTerm         = "tag"
+	boundaryTagTerm = "boundary-tag"
+	dataTagTerm     = "data-tag"
+)
+
+// ParseApplicability parses an applicability expression; an empty expression applies to any asset
+func ParseApplicability(expression string) (Applicability, error) {
+	parser := &applicabilityParser{tokens: tokenizeApplicability(expression)}
+	if len(parser.tokens) == 0 {
+		return anyAsset{}, nil
+	}
+
+	result, parseError := parser.parseOr()
+	if parseError != nil {
+		return nil, fmt.Errorf("invalid applicability %q: %w", expression, parseError)
+	}
+
+	if parser.position < len(parser.tokens) {
+		return nil, fmt.Errorf("invalid applicability %q: unexpected %q", expression, parser.tokens[parser.position])
+	}
+
+	return result, nil
+}
+
+func tokenizeApplicability(expression string) []string {
+	expression = strings.ReplaceAll(expression, "(", " ( ")
+	expression = strings.ReplaceAll(expression, ")", " ) ")
+	return strings.Fields(expression)
+}
+
+type applicabilityParser struct {
+	tokens   []string
+	position int
+}
+
+func (what *applicabilityParser) peek() string {
+	if what.position < len(what.tokens) {
+		return strings.ToLower(what.tokens[what.position])
+	}
+	return ""
+}
+
+func (what *applicabilityParser) parseOr() (Applicability, error) {
+	first, parseError := what.parseAnd()
+	if parseError != nil {
+		return nil, parseError
+	}
+
+	result := orCondition{first}
+	for what.peek() == "or" {
+		what.position++
+		next, nextError := what.parseAnd()
+		if nextError != nil {
+			return nil, nextError
+		}
+		result = append(result, next)
+	}
+
+	if len(result) == 1 {
+		return first, nil
+	}
+	return result, nil
+}
+
+func (what *applicabilityParser) parseAnd() (Applicability, error) {
+	first, parseError := what.parseUnary()
+	if parseError != nil {
+		return nil, parseError
+	}
+
+	result := andCondition{first}
+	for what.peek() == "and" {
+		what.position++
+		next, nextError := what.parseUnary()
+		if nextError != nil {
+			return nil, nextError
+		}
+		result = append(result, next)
+	}
+
+	if len(result) == 1 {
+		return first, nil
+	}
+	return result, nil
+}
+
+func (what *applicabilityParser) parseUnary() (Applicability, error) {
+	switch what.peek() {
+	case "":
+		return nil, fmt.Errorf("unexpected end of expression")
+
+	case "not":
+		what.position++
+		condition, parseError := what.parseUnary()
+		if parseError != nil {
+			return nil, parseError
+		}
+		return notCondition{condition}, nil
+
+	case "(":
+		what.position++
+		condition, parseError := what.parseOr()
+		if parseError != nil {
+			return nil, parseError
+		}
+		if what.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		what.position++
+		return condition, nil
+
+	case ")", "and", "or":
+		return nil, fmt.Errorf("unexpected %q", what.tokens[what.position])
+	}
+
+	token := what.tokens[what.position]
+	what.position++
+	return parseTerm(token)
+}
+
+func parseTerm(token string) (Applicability, error) {
+	key, value, found := strings.Cut(token, ":")
+	key = strings.ToLower(strings.TrimSpace(key))
+	value = strings.TrimSpace(value)
+	if !found || len(value) == 0 {
+		return nil, fmt.Errorf("term %q is not of the form key:value", token)
+	}
+
+	switch key {
+	case technologyTerm, tagTerm, boundaryTagTerm, dataTagTerm:
+		return termCondition{key: key, value: value}, nil
+
+	case typeTerm:
+		if _, typeError := types.ParseTechnicalAssetType(value); typeError != nil {
+			return nil, typeError
+		}
+		return termCondition{key: key, value: value}, nil
+
+	case protocolTerm:
+		if _, protocolError := types.ParseProtocol(value); protocolError != nil {
+			return nil, protocolError
+		}
+		return termCondition{key: key, value: value}, nil
+	}
+
+	return nil, fmt.Errorf("unknown term %q (expected one of %v)", key,
+		[]string{technologyTerm, typeTerm, protocolTerm, tagTerm, boundaryTagTerm, dataTagTerm})
+}
+
+type anyAsset struct{}
+
+func (anyAsset) Applies(*types.Model, *types.TechnicalAsset) bool {
+	return true
+}
+
+func (anyAsset) String() string {
+	return ""
+}
+
+type andCondition []Applicability
+
+func (what andCondition) Applies(parsedModel *types.Model, technicalAsset *types.TechnicalAsset) bool {
+	for _, condition := range what {
+		if !condition.Applies(parsedModel, technicalAsset) {
+			return false
+		}
+	}
+	return true
+}
+
+func (what andCondition) String() string {
+	return joinConditions(what, " and ")
+}
+
+type orCondition []Applicability
+
+func (what orCondition) Applies(parsedModel *types.Model, technicalAsset *types.TechnicalAsset) bool {
+	for _, condition := range what {
+		if condition.Applies(parsedModel, technicalAsset) {
+			return true
+		}
+	}
+	return false
+}
+
+func (what orCondition) String() string {
+	return joinConditions(what, " or ")
+}
+
+type notCondition struct {
+	condition Applicability
+}
+
+func (what notCondition) Applies(parsedModel *types.Model, technicalAsset *types.TechnicalAsset) bool {
+	return !what.condition.Applies(parsedModel, technicalAsset)
+}
+
+func (what notCondition) String() string {
+	return "not " + what.condition.String()
+}
+
+// matchedTerms lists the terms (or negated conditions) making the applicability apply to the technical asset, nothing
+// if it does not apply or applies to any asset
+func matchedTerms(applicability Applicability, parsedModel *types.Model, technicalAsset *types.TechnicalAsset) []string {
+	if !applicability.Applies(parsedModel, technicalAsset) {
+		return []string{}
+	}
+
+	terms := make([]string, 0)
+	switch condition := applicability.(type) {
+	case andCondition:
+		for _, part := range condition {
+			terms = append(terms, matchedTerms(part, parsedModel, technicalAsset)...)
+		}
+
+	case orCondition:
+		for _, part := range condition {
+			terms = append(terms, matchedTerms(part, parsedModel, technicalAsset)...)
+		}
+
+	case notCondition, termCondition:
+		terms = append(terms, condition.String())
+	}
+	return terms
+}
+
+func joinConditions(conditions []Applicability, separator string) string {
+	texts := make([]string, len(conditions))
+	for i, condition := range conditions {
+		texts[i] = condition.String()
+		if _, ok := condition.(termCondition); !ok {
+			texts[i] = "(" + texts[i] + ")"
+		}
+	}
+	return strings.Join(texts, separator)
+}
+
+type termCondition struct {
+	key   string
+	value string
+}
+
+func (what termCondition) String() string {
+	return what.key + ":" + what.value
+}
+
+func (what termCondition) Applies(parsedModel *types.Model, technicalAsset *types.TechnicalAsset) bool {
+	switch what.key {
+	case technologyTerm:
+		for _, technology := range technicalAsset.Technologies {
+			if technology.Is(what.value) || technology.GetAttribute(what.value) {
+				return true
+			}
+		}
+
+	case typeTerm:
+		return strings.EqualFold(technicalAsset.Type.String(), what.value)
+
+	case protocolTerm:
+		for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+			if strings.EqualFold(commLink.Protocol.String(), what.value) {
+				return true
+			}
+		}
+
+	case tagTerm:
+		return technicalAsset.IsTaggedWithAny(what.value)
+
+	case boundaryTagTerm:
+		trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[technicalAsset.Id]
+		if !ok || trustBoundary == nil {
+			return false
+		}
+		for _, trustBoundaryId := range parsedModel.AllParentTrustBoundaryIDs(trustBoundary) {
+			if parentTrustBoundary, ok := parsedModel.TrustBoundaries[trustBoundaryId]; ok && parentTrustBoundary.IsTaggedWithAny(what.value) {
+				return true
+			}
+		}
+
+	case dataTagTerm:
+		dataAssetIds := append(append([]string{}, technicalAsset.DataAssetsProcessed...), technicalAsset.DataAssetsStored...)
+		for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+			dataAssetIds = append(dataAssetIds, commLink.DataAssetsSent...)
+		}
+		for _, commLink := range technicalAsset.CommunicationLinks {
+			dataAssetIds = append(dataAssetIds, commLink.DataAssetsReceived...)
+		}
+		for _, dataAssetId := range dataAssetIds {
+			if dataAsset, ok := parsedModel.DataAssets[dataAssetId]; ok && dataAsset.IsTaggedWithAny(what.value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,122 @@
+package xsam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ApplicabilityTest struct {
+	expression string
+	assetId    string
+	expected   bool
+}
+
+func createApplicabilityTestModel() *types.Model {
+	tcu := &types.TechnicalAsset{
+		Id:   "tcu",
+		Type: types.Process,
+		Tags: []string{SupportedTag, "connectivity"},
+		Technologies: types.TechnologyList{
+			{Name: "telematics-control-unit", Attributes: map[string]bool{"telematics-control-unit": true, "gateway": true}},
+		},
+		DataAssetsProcessed: []string{"firmware-image"},
+		CommunicationLinks: []*types.CommunicationLink{
+			{Id: "tcu>ecu", SourceId: "tcu", TargetId: "ecu", Protocol: types.CAN, DataAssetsSent: []string{"firmware-image"}},
+		},
+	}
+	ecu := &types.TechnicalAsset{
+		Id:   "ecu",
+		Type: types.Process,
+		Tags: []string{SupportedTag},
+		Technologies: types.TechnologyList{
+			{Name: "electronic-control-unit", Attributes: map[string]bool{"electronic-control-unit": true}},
+		},
+	}
+	sensor := &types.TechnicalAsset{
+		Id:   "sensor",
+		Type: types.ExternalEntity,
+		Tags: []string{SupportedTag},
+	}
+
+	vm := &types.TrustBoundary{Id: "vm", Tags: []string{"vm"}, TechnicalAssetsInside: []string{"ecu"}}
+
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{"tcu": tcu, "ecu": ecu, "sensor": sensor},
+		DataAssets: map[string]*types.DataAsset{
+			"firmware-image": {Id: "firmware-image", Tags: []string{"ota"}},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{
+			"vehicle": {Id: "vehicle", Tags: []string{"vehicle"}, TrustBoundariesNested: []string{"vm"}},
+			"vm":      vm,
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{"ecu": vm},
+		IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+			"ecu": tcu.CommunicationLinks,
+		},
+	}
+}
+
+func TestApplicabilityApplies(t *testing.T) {
+	parsedModel := createApplicabilityTestModel()
+
+	testCases := map[string]ApplicabilityTest{
+		"empty expression":                 {expression: "", assetId: "sensor", expected: true},
+		"technology name":                  {expression: "technology:electronic-control-unit", assetId: "ecu", expected: true},
+		"technology attribute":             {expression: "technology:gateway", assetId: "tcu", expected: true},
+		"technology mismatch":              {expression: "technology:telematics-control-unit", assetId: "ecu", expected: false},
+		"type":                             {expression: "type:external-entity", assetId: "sensor", expected: true},
+		"incoming protocol":                {expression: "protocol:can-bus", assetId: "ecu", expected: true},
+		"outgoing protocol does not count": {expression: "protocol:can-bus", assetId: "tcu", expected: false},
+		"tag":                              {expression: "tag:connectivity", assetId: "tcu", expected: true},
+		"direct boundary tag":              {expression: "boundary-tag:vm", assetId: "ecu", expected: true},
+		"parent boundary tag":              {expression: "boundary-tag:vehicle", assetId: "ecu", expected: true},
+		"outside of boundaries":            {expression: "boundary-tag:vehicle", assetId: "tcu", expected: false},
+		"processed data tag":               {expression: "data-tag:ota", assetId: "tcu", expected: true},
+		"received data tag":                {expression: "data-tag:ota", assetId: "ecu", expected: true},
+		"and":                              {expression: "tag:automotive and technology:gateway", assetId: "ecu", expected: false},
+		"or":                               {expression: "technology:gateway or type:external-entity", assetId: "sensor", expected: true},
+		"not":                              {expression: "not technology:gateway", assetId: "tcu", expected: false},
+		"and binds stronger than or":       {expression: "type:external-entity or type:process and tag:none", assetId: "sensor", expected: true},
+		"parentheses":                      {expression: "(type:external-entity or type:process) and tag:none", assetId: "sensor", expected: false},
+		"case-insensitive operators":       {expression: "NOT (tag:connectivity OR data-tag:ota)", assetId: "sensor", expected: true},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			applicability, err := ParseApplicability(test.expression)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, applicability.Applies(parsedModel, parsedModel.TechnicalAssets[test.assetId]))
+		})
+	}
+}
+
+func TestParseApplicabilityString(t *testing.T) {
+	applicability, err := ParseApplicability("technology:ecu and ( protocol:can-bus or not protocol:lin )")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "technology:ecu and (protocol:can-bus or (not protocol:lin))", applicability.String())
+}
+
+func TestParseApplicabilityInvalid(t *testing.T) {
+	for _, expression := range []string{
+		"technology",
+		"color:red",
+		"protocol:carrier-pigeon",
+		"type:vehicle",
+		"tag:a and",
+		"tag:a tag:b",
+		"(tag:a or tag:b",
+		"tag:a)",
+		"or tag:a",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := ParseApplicability(expression)
+
+			assert.NotNil(t, err)
+		})
+	}
+}
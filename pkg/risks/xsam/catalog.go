@@ -0,0 +1,116 @@
+package xsam
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type RootNodes struct {
+	XMLName         xml.Name         `xml:"RootNodes"`
+	ThreatsCatalogs []ThreatsCatalog `xml:"ThreatsCatalog"`
+}
+
+type ThreatsCatalog struct {
+	Name          string        `xml:"name,attr"`
+	ThreatClasses []ThreatClass `xml:"ThreatClasses>ThreatClass"`
+}
+
+type ThreatClass struct {
+	ID            string `xml:"id,attr"` // Map localRef:id to this
+	Name          string `xml:"name,attr"`
+	Title         string `xml:"title,attr"`
+	STRIDE        string `xml:"stride,attr"`
+	Function      string `xml:"function,attr"`
+	Impact        string `xml:"impact,attr"`
+	Feasibility   string `xml:"feasibility,attr"`
+	Applicability string `xml:"applicability,attr"`
+}
+
+// Threat is a classified threat class of a catalog, its ID (like "t-s-001") is unique within a catalog namespace
+type Threat struct {
+	ID    string
+	Title string
+	Classification
+}
+
+var nonIdCharacters = regexp.MustCompile("[^a-z0-9]+")
+
+func ReadCatalog(filename string) (*RootNodes, error) {
+	xmlFile, openError := os.Open(filepath.Clean(filename))
+	if openError != nil {
+		return nil, fmt.Errorf("unable to open threat catalog %q: %w", filename, openError)
+	}
+	defer func() { _ = xmlFile.Close() }()
+
+	root, parseError := ParseCatalog(xmlFile)
+	if parseError != nil {
+		return nil, fmt.Errorf("unable to parse threat catalog %q: %w", filename, parseError)
+	}
+
+	return root, nil
+}
+
+func ParseCatalog(reader io.Reader) (*RootNodes, error) {
+	root := new(RootNodes)
+	if decodeError := xml.NewDecoder(reader).Decode(root); decodeError != nil {
+		return nil, decodeError
+	}
+
+	return root, nil
+}
+
+// Threats classifies all threat classes of all catalogs; duplicate IDs are skipped (keeping the first one)
+// and reported as warnings along with threat classes falling back to default ratings
+func (what *RootNodes) Threats(mapping ThreatClassMapping) ([]Threat, []string, error) {
+	threats := make([]Threat, 0)
+	warnings := make([]string, 0)
+	seenIDs := make(map[string]string)
+
+	for _, catalog := range what.ThreatsCatalogs {
+		for _, threatClass := range catalog.ThreatClasses {
+			name := threatClass.Name
+			if len(strings.TrimSpace(name)) == 0 {
+				name = threatClass.ID
+			}
+
+			id := toID(name)
+			if len(id) == 0 {
+				warnings = append(warnings, fmt.Sprintf("threat class %q of catalog %q has neither name nor id, skipping", threatClass.Title, catalog.Name))
+				continue
+			}
+
+			if otherName, ok := seenIDs[id]; ok {
+				warnings = append(warnings, fmt.Sprintf("duplicate threat class %q of catalog %q (same ID %q as %q), skipping", name, catalog.Name, id, otherName))
+				continue
+			}
+			seenIDs[id] = name
+
+			classification, known, classifyError := mapping.Classify(threatClass)
+			if classifyError != nil {
+				return nil, warnings, classifyError
+			}
+
+			if !known {
+				warnings = append(warnings, fmt.Sprintf("threat class %q is neither annotated nor mapped, using default ratings", name))
+			}
+
+			threats = append(threats, Threat{
+				ID:             id,
+				Title:          threatClass.Title,
+				Classification: classification,
+			})
+		}
+	}
+
+	return threats, warnings, nil
+}
+
+// toID converts a threat class name (like "T.S.001") or namespace into an ID (like "t-s-001")
+func toID(name string) string {
+	return strings.Trim(nonIdCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
@@ -0,0 +1,238 @@
+package xsam
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// ThreatClassRating holds the (optional) classification of a threat class; unset fields are inherited
+// from less specific sources (mapping file prefix, STRIDE prefix defaults)
+type ThreatClassRating struct {
+	STRIDE     *types.STRIDE                     `yaml:"stride,omitempty"`
+	Function   *types.RiskFunction               `yaml:"function,omitempty"`
+	Likelihood *types.RiskExploitationLikelihood `yaml:"likelihood,omitempty"`
+	Impact     *types.RiskExploitationImpact     `yaml:"impact,omitempty"`
+
+	// Applicability is an expression (see ParseApplicability) selecting the assets the threat applies to
+	Applicability *string `yaml:"applicability,omitempty"`
+}
+
+// ThreatClassMapping maps threat class names or name prefixes (like "T.S" or "T.PE.003") to ratings
+type ThreatClassMapping map[string]ThreatClassRating
+
+type Classification struct {
+	STRIDE     types.STRIDE
+	Function   types.RiskFunction
+	Likelihood types.RiskExploitationLikelihood
+	Impact     types.RiskExploitationImpact
+
+	Applicability string
+}
+
+// default ratings of the STRIDE top-level classes (second name segment of T.S, T.T, T.R, T.I, T.D and T.E)
+var strideDefaults = map[string]Classification{
+	"s": {STRIDE: types.Spoofing, Function: types.Architecture, Likelihood: types.Likely, Impact: types.MediumImpact},
+	"t": {STRIDE: types.Tampering, Function: types.Architecture, Likelihood: types.Likely, Impact: types.HighImpact},
+	"r": {STRIDE: types.Repudiation, Function: types.Operations, Likelihood: types.Unlikely, Impact: types.LowImpact},
+	"i": {STRIDE: types.InformationDisclosure, Function: types.Architecture, Likelihood: types.Likely, Impact: types.MediumImpact},
+	"d": {STRIDE: types.DenialOfService, Function: types.Operations, Likelihood: types.Likely, Impact: types.MediumImpact},
+	"e": {STRIDE: types.ElevationOfPrivilege, Function: types.Architecture, Likelihood: types.Unlikely, Impact: types.HighImpact},
+}
+
+// fallback for threat classes neither annotated, mapped nor following the STRIDE naming scheme
+var defaultClassification = Classification{
+	STRIDE:     types.Tampering,
+	Function:   types.BusinessSide,
+	Likelihood: types.Likely,
+	Impact:     types.MediumImpact,
+}
+
+//go:embed threat_class_mapping.yaml
+var defaultThreatClassMapping []byte
+
+// LoadThreatClassMapping reads a mapping file, or the built-in mapping if no filename is given
+func LoadThreatClassMapping(filename string) (ThreatClassMapping, error) {
+	if len(filename) == 0 {
+		return parseThreatClassMapping(defaultThreatClassMapping, "built-in")
+	}
+
+	data, readError := os.ReadFile(filename)
+	if readError != nil {
+		return nil, fmt.Errorf("unable to read threat class mapping file %q: %w", filename, readError)
+	}
+
+	return parseThreatClassMapping(data, filename)
+}
+
+func parseThreatClassMapping(data []byte, name string) (ThreatClassMapping, error) {
+	mapping := make(ThreatClassMapping)
+	if unmarshalError := yaml.Unmarshal(data, &mapping); unmarshalError != nil {
+		return nil, fmt.Errorf("unable to parse threat class mapping file %q: %w", name, unmarshalError)
+	}
+
+	return mapping, nil
+}
+
+// Classify derives the classification of a threat class: attributes of the catalog take precedence,
+// then the most specific entry of the mapping file, then the STRIDE class the name belongs to
+func (what ThreatClassMapping) Classify(threat ThreatClass) (Classification, bool, error) {
+	segments := strings.Split(strings.ToLower(threat.Name), ".")
+
+	result := defaultClassification
+	known := false
+	if len(segments) > 1 && strings.EqualFold(segments[0], "t") {
+		if defaults, ok := strideDefaults[segments[1]]; ok {
+			result = defaults
+			known = true
+		}
+	}
+
+	// less specific prefixes first, so the more specific ones override them
+	for n := 1; n <= len(segments); n++ {
+		if rating, ok := what.find(strings.Join(segments[:n], ".")); ok {
+			result = rating.apply(result)
+			known = known || rating.rated()
+		}
+	}
+
+	annotated, annotationError := threat.rating()
+	if annotationError != nil {
+		return result, known, fmt.Errorf("threat class %q: %w", threat.Name, annotationError)
+	}
+	result = annotated.apply(result)
+	known = known || annotated.rated()
+
+	if _, applicabilityError := ParseApplicability(result.Applicability); applicabilityError != nil {
+		return result, known, fmt.Errorf("threat class %q: %w", threat.Name, applicabilityError)
+	}
+
+	return result, known, nil
+}
+
+func (what ThreatClassMapping) find(prefix string) (ThreatClassRating, bool) {
+	for key, rating := range what {
+		if strings.EqualFold(key, prefix) {
+			return rating, true
+		}
+	}
+	return ThreatClassRating{}, false
+}
+
+// rated tells whether the rating sets any of STRIDE, function, likelihood or impact
+func (what ThreatClassRating) rated() bool {
+	return what.STRIDE != nil || what.Function != nil || what.Likelihood != nil || what.Impact != nil
+}
+
+func (what ThreatClassRating) apply(classification Classification) Classification {
+	if what.STRIDE != nil {
+		classification.STRIDE = *what.STRIDE
+	}
+	if what.Function != nil {
+		classification.Function = *what.Function
+	}
+	if what.Likelihood != nil {
+		classification.Likelihood = *what.Likelihood
+	}
+	if what.Impact != nil {
+		classification.Impact = *what.Impact
+	}
+	if what.Applicability != nil {
+		classification.Applicability = *what.Applicability
+	}
+	return classification
+}
+
+// rating converts the STRIDE/impact/feasibility/applicability attributes of the catalog into a rating
+func (what ThreatClass) rating() (ThreatClassRating, error) {
+	var rating ThreatClassRating
+
+	if len(strings.TrimSpace(what.STRIDE)) > 0 {
+		stride, parseError := parseStride(what.STRIDE)
+		if parseError != nil {
+			return rating, parseError
+		}
+		rating.STRIDE = &stride
+	}
+
+	if len(strings.TrimSpace(what.Function)) > 0 {
+		function, parseError := types.ParseRiskFunction(strings.TrimSpace(what.Function))
+		if parseError != nil {
+			return rating, parseError
+		}
+		rating.Function = &function
+	}
+
+	if len(strings.TrimSpace(what.Feasibility)) > 0 {
+		likelihood, parseError := parseFeasibility(what.Feasibility)
+		if parseError != nil {
+			return rating, parseError
+		}
+		rating.Likelihood = &likelihood
+	}
+
+	if len(strings.TrimSpace(what.Impact)) > 0 {
+		impact, parseError := parseImpact(what.Impact)
+		if parseError != nil {
+			return rating, parseError
+		}
+		rating.Impact = &impact
+	}
+
+	if len(strings.TrimSpace(what.Applicability)) > 0 {
+		applicability := strings.TrimSpace(what.Applicability)
+		rating.Applicability = &applicability
+	}
+
+	return rating, nil
+}
+
+func parseStride(value string) (types.STRIDE, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if defaults, ok := strideDefaults[value]; ok {
+		return defaults.STRIDE, nil
+	}
+
+	for _, stride := range types.STRIDEValues() {
+		if strings.EqualFold(value, stride.(types.STRIDE).Title()) {
+			return stride.(types.STRIDE), nil
+		}
+	}
+
+	return types.ParseSTRIDE(value)
+}
+
+// parseFeasibility accepts ISO/SAE 21434 attack feasibility ratings as well as threagile likelihood values
+func parseFeasibility(value string) (types.RiskExploitationLikelihood, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "very-low", "very low", "low":
+		return types.Unlikely, nil
+	case "medium":
+		return types.Likely, nil
+	case "high":
+		return types.VeryLikely, nil
+	}
+
+	return types.ParseRiskExploitationLikelihood(strings.TrimSpace(value))
+}
+
+// parseImpact accepts ISO/SAE 21434 impact ratings as well as threagile impact values
+func parseImpact(value string) (types.RiskExploitationImpact, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "negligible":
+		return types.LowImpact, nil
+	case "moderate":
+		return types.MediumImpact, nil
+	case "major":
+		return types.HighImpact, nil
+	case "severe":
+		return types.VeryHighImpact, nil
+	}
+
+	return types.ParseRiskExploitationImpact(strings.TrimSpace(value))
+}
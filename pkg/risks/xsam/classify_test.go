@@ -0,0 +1,97 @@
+package xsam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ClassifyTest struct {
+	threat   ThreatClass
+	expected Classification
+	known    bool
+}
+
+func TestClassify(t *testing.T) {
+	tampering := types.Tampering
+	veryHigh := types.VeryHighImpact
+	mapping := ThreatClassMapping{
+		"T.AVF": {
+			STRIDE: &tampering,
+			Impact: &veryHigh,
+		},
+	}
+
+	testCases := map[string]ClassifyTest{
+		"spoofing prefix": {
+			threat:   ThreatClass{Name: "T.S.001"},
+			expected: strideDefaults["s"],
+			known:    true,
+		},
+		"denial of service prefix is not confused with longer prefixes": {
+			threat:   ThreatClass{Name: "T.D.004"},
+			expected: strideDefaults["d"],
+			known:    true,
+		},
+		"mapped prefix": {
+			threat: ThreatClass{Name: "T.Avf.004"},
+			expected: Classification{
+				STRIDE:     types.Tampering,
+				Function:   defaultClassification.Function,
+				Likelihood: defaultClassification.Likelihood,
+				Impact:     types.VeryHighImpact,
+			},
+			known: true,
+		},
+		"catalog attributes take precedence": {
+			threat: ThreatClass{Name: "T.S.004", STRIDE: "T", Impact: "severe", Feasibility: "very-low"},
+			expected: Classification{
+				STRIDE:     types.Tampering,
+				Function:   strideDefaults["s"].Function,
+				Likelihood: types.Unlikely,
+				Impact:     types.VeryHighImpact,
+			},
+			known: true,
+		},
+		"applicability alone does not rate a class": {
+			threat: ThreatClass{Name: "T.DE.002", Applicability: "technology:ecu"},
+			expected: Classification{
+				STRIDE:        defaultClassification.STRIDE,
+				Function:      defaultClassification.Function,
+				Likelihood:    defaultClassification.Likelihood,
+				Impact:        defaultClassification.Impact,
+				Applicability: "technology:ecu",
+			},
+			known: false,
+		},
+		"unknown class": {
+			threat:   ThreatClass{Name: "T.DE.002"},
+			expected: defaultClassification,
+			known:    false,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			classification, known, err := mapping.Classify(test.threat)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, classification)
+			assert.Equal(t, test.known, known)
+		})
+	}
+}
+
+func TestClassifyInvalidAttribute(t *testing.T) {
+	_, _, err := ThreatClassMapping{}.Classify(ThreatClass{Name: "T.S.001", Impact: "catastrophic"})
+
+	assert.NotNil(t, err)
+}
+
+func TestClassifyInvalidApplicability(t *testing.T) {
+	_, _, err := ThreatClassMapping{}.Classify(ThreatClass{Name: "T.S.001", Applicability: "technology:ecu and"})
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,70 @@
+package xsam
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// LoadRiskRules turns the threat classes of XSAM catalogs into risk rules. Each catalog is given as a filename,
+// optionally prefixed by the namespace of its risk category IDs ("namespace=catalog.xsam"); without prefix the
+// filename (without extension) is used as namespace. Threat classes resulting in an already loaded ID are skipped.
+func LoadRiskRules(catalogs []string, mappingFile string, reporter types.ProgressReporter) (types.RiskRules, error) {
+	mapping, mappingError := LoadThreatClassMapping(mappingFile)
+	if mappingError != nil {
+		return nil, mappingError
+	}
+
+	rules := make(types.RiskRules)
+	origin := make(map[string]string)
+	for _, catalog := range catalogs {
+		if len(strings.TrimSpace(catalog)) == 0 {
+			continue
+		}
+
+		namespace, filename := SplitCatalog(catalog)
+		if len(namespace) == 0 {
+			return nil, fmt.Errorf("unable to derive namespace of threat catalog %q", catalog)
+		}
+
+		root, readError := ReadCatalog(filename)
+		if readError != nil {
+			return nil, readError
+		}
+
+		threats, warnings, threatsError := root.Threats(mapping)
+		for _, warning := range warnings {
+			reporter.Warnf("threat catalog %q: %v", filename, warning)
+		}
+
+		if threatsError != nil {
+			return nil, fmt.Errorf("unable to classify threats of catalog %q: %w", filename, threatsError)
+		}
+
+		for _, threat := range threats {
+			rule := NewRiskRule(namespace, threat)
+			id := rule.Category().ID
+			if otherFilename, ok := origin[id]; ok {
+				reporter.Warnf("threat catalog %q: duplicate risk rule %q (already loaded from %q), skipping", filename, id, otherFilename)
+				continue
+			}
+
+			origin[id] = filename
+			rules[id] = rule
+		}
+	}
+
+	return rules, nil
+}
+
+// SplitCatalog splits a catalog specification ("namespace=catalog.xsam" or "catalog.xsam") into namespace and filename
+func SplitCatalog(catalog string) (string, string) {
+	catalog = strings.TrimSpace(catalog)
+	if namespace, filename, found := strings.Cut(catalog, "="); found {
+		return toID(namespace), strings.TrimSpace(filename)
+	}
+
+	return toID(strings.TrimSuffix(filepath.Base(catalog), filepath.Ext(catalog))), catalog
+}
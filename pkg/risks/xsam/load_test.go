@@ -0,0 +1,150 @@
+package xsam
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const testCatalog = `<RootNodes>
+	<ThreatsCatalog name="test">
+		<ThreatClasses>
+			<ThreatClass name="T.S.001" title="Identity spoofing"/>
+			<ThreatClass name="T.D.001" title="Flooding" impact="severe"/>
+			<ThreatClass name="T.S.001" title="Duplicate identity spoofing"/>
+		</ThreatClasses>
+	</ThreatsCatalog>
+</RootNodes>`
+
+type testReporter struct {
+	warnings []string
+}
+
+func (what *testReporter) Info(a ...any)                 {}
+func (what *testReporter) Warn(a ...any)                 { what.warnings = append(what.warnings, fmt.Sprint(a...)) }
+func (what *testReporter) Error(a ...any)                {}
+func (what *testReporter) Infof(format string, a ...any) {}
+func (what *testReporter) Warnf(format string, a ...any) {
+	what.warnings = append(what.warnings, fmt.Sprintf(format, a...))
+}
+func (what *testReporter) Errorf(format string, a ...any) {}
+
+func writeTestCatalog(t *testing.T, name string) string {
+	filename := filepath.Join(t.TempDir(), name)
+	assert.Nil(t, os.WriteFile(filename, []byte(testCatalog), 0600))
+	return filename
+}
+
+func TestLoadRiskRulesNamespacedByFilename(t *testing.T) {
+	reporter := new(testReporter)
+
+	rules, err := LoadRiskRules([]string{writeTestCatalog(t, "Vehicle Catalog.xsam")}, "", reporter)
+
+	assert.Nil(t, err)
+	assert.Len(t, rules, 2)
+	assert.Contains(t, rules, "vehicle-catalog-t-s-001")
+	assert.Equal(t, "Identity spoofing", rules["vehicle-catalog-t-s-001"].Category().Title)
+	assert.Equal(t, types.Spoofing, rules["vehicle-catalog-t-s-001"].Category().STRIDE)
+	assert.Equal(t, types.DenialOfService, rules["vehicle-catalog-t-d-001"].Category().STRIDE)
+	assert.Len(t, reporter.warnings, 1)
+}
+
+func TestLoadRiskRulesMultipleCatalogs(t *testing.T) {
+	filename := writeTestCatalog(t, "catalog.xsam")
+	reporter := new(testReporter)
+
+	rules, err := LoadRiskRules([]string{"first=" + filename, "second=" + filename, "first=" + filename}, "", reporter)
+
+	assert.Nil(t, err)
+	assert.Len(t, rules, 4)
+	assert.Contains(t, rules, "first-t-s-001")
+	assert.Contains(t, rules, "second-t-s-001")
+	assert.Len(t, reporter.warnings, 5)
+}
+
+func TestLoadRiskRulesMissingCatalog(t *testing.T) {
+	_, err := LoadRiskRules([]string{filepath.Join(t.TempDir(), "missing.xsam")}, "", new(testReporter))
+
+	assert.NotNil(t, err)
+}
+
+func TestRiskRuleGenerateRisks(t *testing.T) {
+	rule := NewRiskRule("test", Threat{
+		ID:    "t-d-001",
+		Title: "Flooding",
+		Classification: Classification{
+			STRIDE:     types.DenialOfService,
+			Function:   types.Operations,
+			Likelihood: types.Likely,
+			Impact:     types.VeryHighImpact,
+		},
+	})
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ecu": {
+				Id:    "ecu",
+				Title: "ECU",
+				Tags:  []string{SupportedTag},
+			},
+			"out-of-scope": {
+				Id:         "out-of-scope",
+				Tags:       []string{SupportedTag},
+				OutOfScope: true,
+			},
+			"untagged": {
+				Id: "untagged",
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "test-t-d-001@ecu", risks[0].SyntheticId)
+	assert.Equal(t, types.CalculateSeverity(types.Likely, types.VeryHighImpact), risks[0].Severity)
+}
+
+func TestRiskRuleGenerateRisksApplicability(t *testing.T) {
+	rule := NewRiskRule("test", Threat{
+		ID:             "t-s-004",
+		Title:          "Software package are not from an authorized source",
+		Classification: Classification{Applicability: "data-tag:ota"},
+	})
+
+	risks, err := rule.GenerateRisks(createApplicabilityTestModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 2)
+	assert.Equal(t, "test-t-s-004@ecu", risks[0].SyntheticId)
+	assert.Equal(t, "test-t-s-004@tcu", risks[1].SyntheticId)
+	assert.Contains(t, rule.Category().DetectionLogic, "data-tag:ota")
+}
+
+func TestRiskRuleGenerateRisksInvalidApplicability(t *testing.T) {
+	rule := NewRiskRule("test", Threat{ID: "t-s-004", Classification: Classification{Applicability: "data-tag:"}})
+
+	_, err := rule.GenerateRisks(createApplicabilityTestModel())
+
+	assert.NotNil(t, err)
+}
+
+func TestRiskRuleExplainRisk(t *testing.T) {
+	rule := NewRiskRule("test", Threat{
+		ID:             "t-avf-004",
+		Title:          "CAN Bus Denial of Service",
+		Classification: Classification{Applicability: "(protocol:can-bus or protocol:can-fd) and not tag:connectivity"},
+	})
+
+	assert.Equal(t, []string{
+		`technical asset "ecu"`,
+		"  - out of scope: false (=false)",
+		`  - tags: automotive (has "automotive")`,
+		"  - applicability: (protocol:can-bus or protocol:can-fd) and (not tag:connectivity) (matched by protocol:can-bus, not tag:connectivity)",
+	}, rule.ExplainRisk(createApplicabilityTestModel(), "test-t-avf-004@*"))
+	assert.Empty(t, rule.ExplainRisk(createApplicabilityTestModel(), "test-t-avf-004@tcu"))
+}
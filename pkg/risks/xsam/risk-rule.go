@@ -0,0 +1,122 @@
+package xsam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// SupportedTag marks technical assets XSAM threat catalogs apply to
+const SupportedTag = "automotive"
+
+// RiskRule applies a threat class of an XSAM catalog to the in-scope technical assets tagged with "automotive"
+// which match the applicability of the threat
+type RiskRule struct {
+	category           types.RiskCategory
+	likelihood         types.RiskExploitationLikelihood
+	impact             types.RiskExploitationImpact
+	applicability      Applicability
+	applicabilityError error
+}
+
+func NewRiskRule(namespace string, threat Threat) *RiskRule {
+	applicability, applicabilityError := ParseApplicability(threat.Applicability)
+	detectionLogic := "In-scope technical assets tagged with \"" + SupportedTag + "\""
+	if applicabilityError == nil && len(applicability.String()) > 0 {
+		detectionLogic += " matching the applicability condition: " + applicability.String()
+	}
+
+	return &RiskRule{
+		category: types.RiskCategory{
+			ID:             namespace + "-" + threat.ID,
+			Title:          threat.Title,
+			Description:    threat.Title + " (Imported from XSAM)",
+			Impact:         "Potential impact depending on the specific automotive context.",
+			Function:       threat.Function,
+			STRIDE:         threat.STRIDE,
+			Action:         "Review automotive standards",
+			Mitigation:     "Apply automotive security controls (e.g. UN R155)",
+			Check:          "Is this threat applicable?",
+			DetectionLogic: detectionLogic,
+		},
+		likelihood:         threat.Likelihood,
+		impact:             threat.Impact,
+		applicability:      applicability,
+		applicabilityError: applicabilityError,
+	}
+}
+
+func (r *RiskRule) Category() *types.RiskCategory {
+	return &r.category
+}
+
+func (r *RiskRule) SupportedTags() []string {
+	return []string{SupportedTag}
+}
+
+func (r *RiskRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	if r.applicabilityError != nil {
+		return nil, fmt.Errorf("risk rule %q: %w", r.category.ID, r.applicabilityError)
+	}
+
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		technicalAsset := parsedModel.TechnicalAssets[id]
+		if technicalAsset.OutOfScope {
+			continue
+		}
+		if technicalAsset.IsTaggedWithAny(SupportedTag) && r.applicability.Applies(parsedModel, technicalAsset) {
+			risks = append(risks, r.createRisk(technicalAsset))
+		}
+	}
+	return risks, nil
+}
+
+func (r *RiskRule) createRisk(technicalAsset *types.TechnicalAsset) *types.Risk {
+	risk := &types.Risk{
+		CategoryId:                   r.category.ID,
+		Severity:                     types.CalculateSeverity(r.likelihood, r.impact),
+		ExploitationLikelihood:       r.likelihood,
+		ExploitationImpact:           r.impact,
+		Title:                        "<b>" + r.category.Title + "</b> risk at <b>" + technicalAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{technicalAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
+	return risk
+}
+
+// ExplainRisk states the conditions the technical assets of the risks with a synthetic id matching the given one met:
+// being in scope, tagged and matching the applicability of the threat
+func (r *RiskRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	if r.applicabilityError != nil {
+		return explanation
+	}
+
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		technicalAsset := parsedModel.TechnicalAssets[id]
+		if !types.MatchesSyntheticRiskId(risk, r.category.ID+"@"+technicalAsset.Id) || technicalAsset.OutOfScope ||
+			!technicalAsset.IsTaggedWithAny(SupportedTag) || !r.applicability.Applies(parsedModel, technicalAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has %q)", strings.Join(technicalAsset.Tags, ", "), SupportedTag),
+		)
+		if len(r.applicability.String()) == 0 {
+			explanation = append(explanation, "  - applicability: none (the threat applies to any tagged asset)")
+		} else {
+			explanation = append(explanation, fmt.Sprintf("  - applicability: %v (matched by %v)",
+				r.applicability.String(), strings.Join(matchedTerms(r.applicability, parsedModel, technicalAsset), ", ")))
+		}
+	}
+	return explanation
+}
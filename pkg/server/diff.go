@@ -0,0 +1,121 @@
+package server
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/threagile/threagile/pkg/model"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const (
+	historyFolderName    = "history"
+	historyFileExtension = ".backup"
+	currentModelSnapshot = "current"
+)
+
+// lists the snapshots of a model kept in its history, oldest first
+func (s *server) getModelHistory(ginContext *gin.Context) {
+	folderNameOfKey, _, ok := s.checkTokenToFolderName(ginContext)
+	if !ok {
+		return
+	}
+	s.lockFolder(folderNameOfKey)
+	defer s.unlockFolder(folderNameOfKey)
+	modelFolder, ok := s.checkModelFolder(ginContext, ginContext.Param("model-id"), folderNameOfKey)
+	if !ok {
+		return
+	}
+
+	snapshots := make([]string, 0)
+	files, err := os.ReadDir(filepath.Join(modelFolder, historyFolderName))
+	if err != nil && !os.IsNotExist(err) {
+		handleErrorInServiceCall(err, ginContext)
+		return
+	}
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), historyFileExtension) {
+			snapshots = append(snapshots, strings.TrimSuffix(file.Name(), historyFileExtension))
+		}
+	}
+	sort.Strings(snapshots)
+
+	ginContext.JSON(http.StatusOK, snapshots)
+}
+
+// compares two snapshots of a model from its history, or a snapshot with the current model if no 'to' is given
+func (s *server) diffModel(ginContext *gin.Context) {
+	folderNameOfKey, key, ok := s.checkTokenToFolderName(ginContext)
+	if !ok {
+		return
+	}
+	s.lockFolder(folderNameOfKey)
+	defer s.unlockFolder(folderNameOfKey)
+	modelFolder, ok := s.checkModelFolder(ginContext, ginContext.Param("model-id"), folderNameOfKey)
+	if !ok {
+		return
+	}
+
+	from := ginContext.Query("from")
+	if len(from) == 0 {
+		ginContext.JSON(http.StatusBadRequest, gin.H{
+			"error": "no snapshot to compare from given",
+		})
+		return
+	}
+	to := ginContext.DefaultQuery("to", currentModelSnapshot)
+
+	fromModel, ok := s.analyzeSnapshot(ginContext, modelFolder, from, key)
+	if !ok {
+		return
+	}
+	toModel, ok := s.analyzeSnapshot(ginContext, modelFolder, to, key)
+	if !ok {
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, types.DiffModels(from, fromModel.ParsedModel, to, toModel.ParsedModel))
+}
+
+func (s *server) analyzeSnapshot(ginContext *gin.Context, modelFolder string, snapshot string, key []byte) (result *model.ReadResult, ok bool) {
+	filename := filepath.Join(modelFolder, s.config.GetInputFile())
+	if snapshot != currentModelSnapshot {
+		if snapshot != filepath.Base(filepath.Clean(snapshot)) || strings.HasPrefix(snapshot, ".") {
+			ginContext.JSON(http.StatusBadRequest, gin.H{
+				"error": "invalid snapshot name",
+			})
+			return nil, false
+		}
+		filename = filepath.Join(modelFolder, historyFolderName, snapshot+historyFileExtension)
+		if _, err := os.Stat(filename); os.IsNotExist(err) {
+			ginContext.JSON(http.StatusNotFound, gin.H{
+				"error": "snapshot not found",
+			})
+			return nil, false
+		}
+	}
+
+	modelInput, _, ok := s.readModelFile(ginContext, filename, key)
+	if !ok {
+		return nil, false
+	}
+
+	progressReporter := DefaultProgressReporter{
+		Verbose:       s.config.GetVerbose(),
+		SuppressError: true,
+	}
+	result, err := model.AnalyzeModel(&modelInput, s.config, s.builtinRiskRules, s.customRiskRules, progressReporter)
+	if err != nil {
+		log.Println(err)
+		ginContext.JSON(http.StatusBadRequest, gin.H{
+			"error": "unable to analyze model snapshot " + snapshot + ": " + err.Error(),
+		})
+		return nil, false
+	}
+	return result, true
+}
@@ -187,7 +187,11 @@ func (s *server) doItViaRuntimeCall(modelFile string, outputDir string,
 		"--output", outputDir,
 		"--execute-model-macro", s.config.GetExecuteModelMacro(),
 		"--custom-risk-rules-plugin", strings.Join(s.config.GetRiskRulePlugins(), ","),
+		"--xsam-catalogs", strings.Join(s.config.GetXsamCatalogs(), ","),
+		"--xsam-mapping", s.config.GetXsamMappingFile(),
 		"--skip-risk-rules", strings.Join(s.config.GetSkipRiskRules(), ","),
+		"--risk-rule-workers", strconv.Itoa(s.config.GetRiskRuleWorkers()),
+		"--risk-rule-timeout", strconv.Itoa(s.config.GetRiskRuleTimeout()),
 		"--diagram-dpi", strconv.Itoa(dpi),
 	}
 	if s.config.GetVerbose() {
@@ -196,6 +200,9 @@ func (s *server) doItViaRuntimeCall(modelFile string, outputDir string,
 	if s.config.GetIgnoreOrphanedRiskTracking() { // TODO why add all them as arguments, when they are also variables on outer level?
 		args = append(args, "--ignore-orphaned-risk-tracking")
 	}
+	if s.config.GetSkipAttackPathAnalysis() {
+		args = append(args, "--skip-attack-path-analysis")
+	}
 	if generateDataFlowDiagram {
 		args = append(args, "--generate-data-flow-diagram")
 	}
@@ -264,8 +271,8 @@ func (s *server) editModelAnalyze(ginContext *gin.Context) {
 		Verbose:       s.config.GetVerbose(),
 		SuppressError: true,
 	}
-	customRiskRules := model.LoadCustomRiskRules(s.config.GetPluginFolder(), s.config.GetRiskRulePlugins(), progressReporter)
 	builtinRiskRules := risks.GetBuiltInRiskRules()
+	customRiskRules := model.LoadCustomAndXsamRiskRules(s.config, builtinRiskRules, progressReporter)
 
 	result, err := model.AnalyzeModel(&modelInput, s.config, builtinRiskRules, customRiskRules, progressReporter)
 	if err != nil {
@@ -976,6 +976,11 @@ func (s *server) readModel(ginContext *gin.Context, modelUUID string, key []byte
 	if !ok {
 		return modelInputResult, yamlText, false
 	}
+	return s.readModelFile(ginContext, filepath.Join(modelFolder, s.config.GetInputFile()), key)
+}
+
+// decrypts and parses a model file, either the current model or a backup of it in the history
+func (s *server) readModelFile(ginContext *gin.Context, filename string, key []byte) (modelInputResult input.Model, yamlText string, ok bool) {
 	cryptoKey := generateKeyFromAlreadyStrongRandomInput(key)
 	block, err := aes.NewCipher(cryptoKey)
 	if err != nil {
@@ -994,7 +999,7 @@ func (s *server) readModel(ginContext *gin.Context, modelUUID string, key []byte
 		return modelInputResult, yamlText, false
 	}
 
-	fileBytes, err := os.ReadFile(filepath.Clean(filepath.Join(modelFolder, s.config.GetInputFile())))
+	fileBytes, err := os.ReadFile(filepath.Clean(filename))
 	if err != nil {
 		log.Println(err)
 		ginContext.JSON(http.StatusInternalServerError, gin.H{
@@ -1296,7 +1301,7 @@ func (s *server) unlockFolder(folderName string) {
 }
 
 func (s *server) backupModelToHistory(modelFolder string, changeReasonForHistory string) (err error) {
-	historyFolder := filepath.Join(modelFolder, "history")
+	historyFolder := filepath.Join(modelFolder, historyFolderName)
 	if _, err := os.Stat(historyFolder); os.IsNotExist(err) {
 		err = os.Mkdir(historyFolder, 0700)
 		if err != nil {
@@ -1307,7 +1312,7 @@ func (s *server) backupModelToHistory(modelFolder string, changeReasonForHistory
 	if err != nil {
 		return err
 	}
-	historyFile := filepath.Join(historyFolder, time.Now().Format("2006-01-02 15:04:05")+" "+changeReasonForHistory+".backup")
+	historyFile := filepath.Join(historyFolder, time.Now().Format("2006-01-02 15:04:05")+" "+changeReasonForHistory+historyFileExtension)
 	err = os.WriteFile(filepath.Clean(historyFile), inputModel, 0400)
 	if err != nil {
 		return err
@@ -48,9 +48,13 @@ type serverConfigReader interface {
 	GetJsonTechnicalAssetsFilename() string
 	GetJsonStatsFilename() string
 	GetTemplateFilename() string
-	GetTechnologyFilename() string
+	GetTechnologyFiles() []string
 	GetRiskRulePlugins() []string
+	GetXsamCatalogs() []string
+	GetXsamMappingFile() string
 	GetSkipRiskRules() []string
+	GetRiskRuleWorkers() int
+	GetRiskRuleTimeout() int
 	GetExecuteModelMacro() string
 	GetServerMode() bool
 	GetDiagramDPI() int
@@ -62,6 +66,9 @@ type serverConfigReader interface {
 	GetAddLegend() bool
 	GetKeepDiagramSourceFiles() bool
 	GetIgnoreOrphanedRiskTracking() bool
+	GetSkipAttackPathAnalysis() bool
+	GetTaraRiskMatrix() map[string]map[string]int
+	GetAttractiveness() types.Attractiveness
 	GetThreagileVersion() string
 	GetProgressReporter() types.ProgressReporter
 }
@@ -193,6 +200,8 @@ func RunServer(config serverConfigReader, builtinRiskRules types.RiskRules) {
 	router.GET("/models/:model-id/technical-assets", s.streamTechnicalAssetsJSON)
 	router.GET("/models/:model-id/stats", s.streamStatsJSON)
 	router.GET("/models/:model-id/analysis", s.analyzeModelOnServerDirectly)
+	router.GET("/models/:model-id/history", s.getModelHistory)
+	router.GET("/models/:model-id/diff", s.diffModel)
 
 	router.GET("/models/:model-id/cover", s.getCover)
 	router.PUT("/models/:model-id/cover", s.setCover)
@@ -225,7 +234,7 @@ func RunServer(config serverConfigReader, builtinRiskRules types.RiskRules) {
 	router.PUT("/models/:model-id/shared-runtimes/:shared-runtime-id", s.setSharedRuntime)
 	router.DELETE("/models/:model-id/shared-runtimes/:shared-runtime-id", s.deleteSharedRuntime)
 
-	s.customRiskRules = model.LoadCustomRiskRules(s.config.GetPluginFolder(), s.config.GetRiskRulePlugins(), config.GetProgressReporter())
+	s.customRiskRules = model.LoadCustomAndXsamRiskRules(s.config, s.builtinRiskRules, config.GetProgressReporter())
 
 	fmt.Println("Threagile is running...")
 	_ = router.Run(":" + strconv.Itoa(s.config.GetServerPort())) // listen and serve on 0.0.0.0:8080 or whatever port was specified
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AttackFeasibility rates how easily an attack path can be carried out per ISO/SAE 21434
+type AttackFeasibility int
+
+const (
+	VeryLowFeasibility AttackFeasibility = iota
+	LowFeasibility
+	MediumFeasibility
+	HighFeasibility
+)
+
+func AttackFeasibilityValues() []TypeEnum {
+	return []TypeEnum{
+		VeryLowFeasibility,
+		LowFeasibility,
+		MediumFeasibility,
+		HighFeasibility,
+	}
+}
+
+var AttackFeasibilityTypeDescription = [...]TypeDescription{
+	{"very-low", "Very low attack feasibility (attack potential beyond high)"},
+	{"low", "Low attack feasibility (high attack potential)"},
+	{"medium", "Medium attack feasibility (moderate attack potential)"},
+	{"high", "High attack feasibility (basic attack potential)"},
+}
+
+func ParseAttackFeasibility(value string) (attackFeasibility AttackFeasibility, err error) {
+	return AttackFeasibility(0).Find(value)
+}
+
+func (what AttackFeasibility) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return AttackFeasibilityTypeDescription[what].Name
+}
+
+func (what AttackFeasibility) Explain() string {
+	return AttackFeasibilityTypeDescription[what].Description
+}
+
+func (what AttackFeasibility) Title() string {
+	return [...]string{"Very Low", "Low", "Medium", "High"}[what]
+}
+
+func (what AttackFeasibility) Weight() int {
+	return [...]int{1, 2, 3, 4}[what]
+}
+
+func (what AttackFeasibility) Find(value string) (AttackFeasibility, error) {
+	if len(value) == 0 {
+		return MediumFeasibility, nil
+	}
+
+	for index, description := range AttackFeasibilityTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return AttackFeasibility(index), nil
+		}
+	}
+
+	return AttackFeasibility(0), fmt.Errorf("unknown attack feasibility value %q", value)
+}
+
+func (what AttackFeasibility) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *AttackFeasibility) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what AttackFeasibility) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *AttackFeasibility) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
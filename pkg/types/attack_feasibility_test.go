@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseAttackFeasibilityTest struct {
+	input         string
+	expected      AttackFeasibility
+	expectedError error
+}
+
+func TestParseAttackFeasibility(t *testing.T) {
+	testCases := map[string]ParseAttackFeasibilityTest{
+		"very-low": {
+			input:    "very-low",
+			expected: VeryLowFeasibility,
+		},
+		"low": {
+			input:    "low",
+			expected: LowFeasibility,
+		},
+		"medium": {
+			input:    "medium",
+			expected: MediumFeasibility,
+		},
+		"high": {
+			input:    "high",
+			expected: HighFeasibility,
+		},
+		"default": {
+			input:    "",
+			expected: MediumFeasibility,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown attack feasibility value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseAttackFeasibility(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
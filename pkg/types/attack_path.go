@@ -0,0 +1,33 @@
+package types
+
+// AttackPath is a chain of communication links leading from an entry point (an internet-facing or physically accessible
+// technical asset) to a technical asset with high integrity requirements
+type AttackPath struct {
+	EntryPointId         string   `json:"entry_point_id" yaml:"entry_point_id"`
+	TargetId             string   `json:"target_id" yaml:"target_id"`
+	TechnicalAssetIds    []string `json:"technical_asset_ids" yaml:"technical_asset_ids"` // from the entry point to the target
+	CommunicationLinkIds []string `json:"communication_link_ids" yaml:"communication_link_ids"`
+	Score                int      `json:"score" yaml:"score"` // sum of the protection scores of all links: the lower, the easier to follow
+}
+
+// IsAttackPathEntryPoint tells whether an attacker can directly reach the technical asset, either via the internet or
+// physically (external devices and assets used by humans on physical machines)
+func (what TechnicalAsset) IsAttackPathEntryPoint() bool {
+	return what.Internet || (what.Machine == Physical && (what.Type == ExternalEntity || what.UsedAsClientByHuman))
+}
+
+// IsAttackPathTarget tells whether the technical asset is worth to be reached by an attacker (a crown jewel)
+func (what TechnicalAsset) IsAttackPathTarget() bool {
+	return what.Integrity >= Critical && !what.IsAttackPathEntryPoint()
+}
+
+// AttackPathsOfTarget lists the attack paths leading to the technical asset, the easiest to follow first
+func (model *Model) AttackPathsOfTarget(targetId string) []*AttackPath {
+	result := make([]*AttackPath, 0)
+	for _, attackPath := range model.AttackPaths {
+		if attackPath.TargetId == targetId {
+			result = append(result, attackPath)
+		}
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package types
+
+// AttackPotential rates the attack feasibility of a risk with the attack potential-based approach of ISO/SAE 21434
+type AttackPotential struct {
+	ElapsedTime         ElapsedTime         `json:"elapsed_time" yaml:"elapsed_time"`
+	Expertise           Expertise           `json:"expertise" yaml:"expertise"`
+	KnowledgeOfItem     KnowledgeOfItem     `json:"knowledge_of_item" yaml:"knowledge_of_item"`
+	WindowOfOpportunity WindowOfOpportunity `json:"window_of_opportunity" yaml:"window_of_opportunity"`
+	Equipment           Equipment           `json:"equipment" yaml:"equipment"`
+	Justification       string              `json:"justification,omitempty" yaml:"justification,omitempty"`
+}
+
+// Value is the sum of the attack potential values of all factors
+func (what AttackPotential) Value() int {
+	return what.ElapsedTime.Weight() + what.Expertise.Weight() + what.KnowledgeOfItem.Weight() + what.WindowOfOpportunity.Weight() + what.Equipment.Weight()
+}
+
+// Feasibility maps the attack potential value to the attack feasibility: the higher the potential required, the lower the feasibility
+func (what AttackPotential) Feasibility() AttackFeasibility {
+	value := what.Value()
+	if value < 10 {
+		return HighFeasibility
+	}
+	if value < 14 {
+		return MediumFeasibility
+	}
+	if value < 20 {
+		return LowFeasibility
+	}
+	return VeryLowFeasibility
+}
@@ -0,0 +1,128 @@
+package types
+
+import "sort"
+
+// Attractiveness weights the ratings of technical assets and their data in the relative attacker attractiveness (RAA)
+// calculation. The scales of the ratings are sections of the fibonacci sequence (1, 2, 3, 5, 8, ...), which are shifted
+// by the configured values: zero keeps the built-in scale, one starts it at the next fibonacci number and so on.
+type Attractiveness struct {
+	Quantity        int           `json:"quantity,omitempty" yaml:"quantity"`
+	Confidentiality AttackerFocus `json:"confidentiality" yaml:"confidentiality"`
+	Integrity       AttackerFocus `json:"integrity" yaml:"integrity"`
+	Availability    AttackerFocus `json:"availability" yaml:"availability"`
+	PivotingDepth   int           `json:"pivoting_depth,omitempty" yaml:"pivoting_depth"` // number of hops over communication links the pivoting neighbour effect reaches (default: 1)
+}
+
+type AttackerFocus struct {
+	Asset                 int // fibonacci sequence base index shift
+	ProcessedOrStoredData int // fibonacci sequence base index shift
+	TransferredData       int // fibonacci sequence base index shift
+}
+
+// indices of the first numbers of the built-in scales in the fibonacci sequence
+const (
+	confidentialityOfAssetBaseIndex                 = 4 // 8
+	confidentialityOfProcessedOrStoredDataBaseIndex = 3 // 5
+	confidentialityOfTransferredDataBaseIndex       = 1 // 2
+	criticalityOfAssetBaseIndex                     = 3 // 5
+	criticalityOfProcessedOrStoredDataBaseIndex     = 2 // 3
+	criticalityOfTransferredDataBaseIndex           = 1 // 2
+	quantityBaseIndex                               = 0 // 1
+)
+
+// AttackerAttractiveness is the breakdown of the relative attacker attractiveness (RAA) of a technical asset
+type AttackerAttractiveness struct {
+	TechnicalAssetId      string  `json:"technical_asset_id" yaml:"technical_asset_id"`
+	Asset                 float64 `json:"asset" yaml:"asset"`                                       // rated CIA of the technical asset itself
+	ProcessedOrStoredData float64 `json:"processed_or_stored_data" yaml:"processed_or_stored_data"` // rated CIA of the data assets processed or stored, each counted once
+	TransferredData       float64 `json:"transferred_data" yaml:"transferred_data"`                 // rated CIA of the data assets sent or received per communication link
+	TechnologyFactor      float64 `json:"technology_factor" yaml:"technology_factor"`
+	MultiTenantFactor     float64 `json:"multi_tenant_factor" yaml:"multi_tenant_factor"`
+	Score                 float64 `json:"score" yaml:"score"`       // sum of the ratings multiplied by the factors
+	Relative              float64 `json:"relative" yaml:"relative"` // score in percent of the range of the scores of all technical assets
+	PivotingAdjustment    float64 `json:"pivoting_adjustment" yaml:"pivoting_adjustment"`
+	PivotingTarget        string  `json:"pivoting_target,omitempty" yaml:"pivoting_target,omitempty"` // id of the technical asset causing the pivoting adjustment
+	RAA                   float64 `json:"raa" yaml:"raa"`                                             // relative score plus pivoting adjustment
+}
+
+func (what AttackerFocus) confidentialityOfAsset(confidentiality Confidentiality) float64 {
+	return fibonacci(confidentialityOfAssetBaseIndex + what.Asset + int(confidentiality))
+}
+
+func (what AttackerFocus) confidentialityOfProcessedOrStoredData(confidentiality Confidentiality) float64 {
+	return fibonacci(confidentialityOfProcessedOrStoredDataBaseIndex + what.ProcessedOrStoredData + int(confidentiality))
+}
+
+func (what AttackerFocus) confidentialityOfTransferredData(confidentiality Confidentiality) float64 {
+	return fibonacci(confidentialityOfTransferredDataBaseIndex + what.TransferredData + int(confidentiality))
+}
+
+func (what AttackerFocus) criticalityOfAsset(criticality Criticality) float64 {
+	return fibonacci(criticalityOfAssetBaseIndex + what.Asset + int(criticality))
+}
+
+func (what AttackerFocus) criticalityOfProcessedOrStoredData(criticality Criticality) float64 {
+	return fibonacci(criticalityOfProcessedOrStoredDataBaseIndex + what.ProcessedOrStoredData + int(criticality))
+}
+
+func (what AttackerFocus) criticalityOfTransferredData(criticality Criticality) float64 {
+	return fibonacci(criticalityOfTransferredDataBaseIndex + what.TransferredData + int(criticality))
+}
+
+// AssetScore rates the CIA of the technical asset itself
+func (what Attractiveness) AssetScore(techAsset *TechnicalAsset) float64 {
+	return what.Confidentiality.confidentialityOfAsset(techAsset.Confidentiality) +
+		what.Integrity.criticalityOfAsset(techAsset.Integrity) +
+		what.Availability.criticalityOfAsset(techAsset.Availability)
+}
+
+// ProcessedOrStoredDataScore rates the CIA of a data asset processed or stored, confidentiality and integrity
+// multiplied by its quantity
+func (what Attractiveness) ProcessedOrStoredDataScore(dataAsset *DataAsset) float64 {
+	return what.Confidentiality.confidentialityOfProcessedOrStoredData(dataAsset.Confidentiality)*what.QuantityFactor(dataAsset.Quantity) +
+		what.Integrity.criticalityOfProcessedOrStoredData(dataAsset.Integrity)*what.QuantityFactor(dataAsset.Quantity) +
+		what.Availability.criticalityOfProcessedOrStoredData(dataAsset.Availability)
+}
+
+// TransferredDataScore rates the CIA of a data asset sent or received, confidentiality and integrity multiplied by its
+// quantity
+func (what Attractiveness) TransferredDataScore(dataAsset *DataAsset) float64 {
+	return what.Confidentiality.confidentialityOfTransferredData(dataAsset.Confidentiality)*what.QuantityFactor(dataAsset.Quantity) +
+		what.Integrity.criticalityOfTransferredData(dataAsset.Integrity)*what.QuantityFactor(dataAsset.Quantity) +
+		what.Availability.criticalityOfTransferredData(dataAsset.Availability)
+}
+
+func (what Attractiveness) QuantityFactor(quantity Quantity) float64 {
+	return fibonacci(quantityBaseIndex + what.Quantity + int(quantity))
+}
+
+func (what Attractiveness) GetPivotingDepth() int {
+	if what.PivotingDepth < 1 {
+		return 1
+	}
+	return what.PivotingDepth
+}
+
+// SortedAttackerAttractiveness lists the RAA breakdowns of the technical assets from highest to lowest RAA
+func (model *Model) SortedAttackerAttractiveness() []*AttackerAttractiveness {
+	result := make([]*AttackerAttractiveness, 0, len(model.AttackerAttractiveness))
+	for _, breakdown := range model.AttackerAttractiveness {
+		result = append(result, breakdown)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].RAA == result[j].RAA {
+			return result[i].TechnicalAssetId < result[j].TechnicalAssetId
+		}
+		return result[i].RAA > result[j].RAA
+	})
+	return result
+}
+
+// fibonacci returns the number at the index of the fibonacci sequence 1, 2, 3, 5, 8, ...
+func fibonacci(index int) float64 {
+	previous, current := 1.0, 1.0
+	for i := 0; i < index; i++ {
+		previous, current = current, previous+current
+	}
+	return current
+}
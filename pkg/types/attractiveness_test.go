@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttractivenessDefaultScales(t *testing.T) {
+	attractiveness := Attractiveness{}
+	for confidentiality := Public; confidentiality <= StrictlyConfidential; confidentiality++ {
+		assert.Equal(t, confidentiality.AttackerAttractivenessForAsset(), attractiveness.Confidentiality.confidentialityOfAsset(confidentiality))
+		assert.Equal(t, confidentiality.AttackerAttractivenessForProcessedOrStoredData(), attractiveness.Confidentiality.confidentialityOfProcessedOrStoredData(confidentiality))
+		assert.Equal(t, confidentiality.AttackerAttractivenessForInOutTransferredData(), attractiveness.Confidentiality.confidentialityOfTransferredData(confidentiality))
+	}
+	for criticality := Archive; criticality <= MissionCritical; criticality++ {
+		assert.Equal(t, criticality.AttackerAttractivenessForAsset(), attractiveness.Integrity.criticalityOfAsset(criticality))
+		assert.Equal(t, criticality.AttackerAttractivenessForProcessedOrStoredData(), attractiveness.Integrity.criticalityOfProcessedOrStoredData(criticality))
+		assert.Equal(t, criticality.AttackerAttractivenessForInOutTransferredData(), attractiveness.Integrity.criticalityOfTransferredData(criticality))
+	}
+	for quantity := VeryFew; quantity <= VeryMany; quantity++ {
+		assert.Equal(t, quantity.QuantityFactor(), attractiveness.QuantityFactor(quantity))
+	}
+	assert.Equal(t, 1, attractiveness.GetPivotingDepth())
+}
+
+func TestAttractivenessShiftedScales(t *testing.T) {
+	attractiveness := Attractiveness{Quantity: 1, Confidentiality: AttackerFocus{Asset: 1, ProcessedOrStoredData: -3}}
+	assert.Equal(t, float64(13), attractiveness.Confidentiality.confidentialityOfAsset(Public))
+	assert.Equal(t, float64(1), attractiveness.Confidentiality.confidentialityOfProcessedOrStoredData(Public))
+	assert.Equal(t, float64(2), attractiveness.Confidentiality.confidentialityOfProcessedOrStoredData(Internal))
+	assert.Equal(t, float64(2), attractiveness.QuantityFactor(VeryFew))
+}
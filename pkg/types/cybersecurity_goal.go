@@ -0,0 +1,167 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CybersecurityGoal is a requirement derived from risks treated by reduction (ISO/SAE 21434), or a cybersecurity claim
+// stating the rationale for risks being shared or retained
+type CybersecurityGoal struct {
+	Id          string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Title       string   `json:"title,omitempty" yaml:"title,omitempty"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Claim       bool     `json:"claim,omitempty" yaml:"claim,omitempty"`
+	RiskIds     []string `json:"risks,omitempty" yaml:"risks,omitempty"` // synthetic risk ids, may contain wildcards
+}
+
+// CybersecurityGoalCoverage lists a cybersecurity goal or claim with the risks covered by it, used for the JSON export
+type CybersecurityGoalCoverage struct {
+	Id           string                  `json:"id" yaml:"id"`
+	Title        string                  `json:"title" yaml:"title"`
+	Description  string                  `json:"description,omitempty" yaml:"description,omitempty"`
+	Claim        bool                    `json:"claim" yaml:"claim"`
+	CoveredRisks []CybersecurityGoalRisk `json:"covered_risks" yaml:"covered_risks"`
+}
+
+type CybersecurityGoalRisk struct {
+	SyntheticId string        `json:"synthetic_id" yaml:"synthetic_id"`
+	Title       string        `json:"title" yaml:"title"`
+	Severity    RiskSeverity  `json:"severity" yaml:"severity"`
+	Status      RiskStatus    `json:"status" yaml:"status"`
+	Treatment   RiskTreatment `json:"treatment" yaml:"treatment"`
+}
+
+// Kind is the name of a cybersecurity goal or claim to be used in texts
+func (what CybersecurityGoal) Kind() string {
+	if what.Claim {
+		return "cybersecurity claim"
+	}
+	return "cybersecurity goal"
+}
+
+// Covers tells whether the risk is covered by the cybersecurity goal or claim
+func (what CybersecurityGoal) Covers(risk *Risk) bool {
+	for _, pattern := range what.RiskIds {
+		if MatchesSyntheticRiskId(pattern, risk.SyntheticId) {
+			return true
+		}
+	}
+	return false
+}
+
+// SortedCybersecurityGoals lists the cybersecurity goals (followed by the cybersecurity claims) by id
+func (model *Model) SortedCybersecurityGoals() []*CybersecurityGoal {
+	result := make([]*CybersecurityGoal, 0, len(model.CybersecurityGoals))
+	for _, goal := range model.CybersecurityGoals {
+		result = append(result, goal)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Claim != result[j].Claim {
+			return !result[i].Claim
+		}
+		return result[i].Id < result[j].Id
+	})
+	return result
+}
+
+// RisksOfCybersecurityGoal lists the risks covered by a cybersecurity goal or claim by descending severity
+func (model *Model) RisksOfCybersecurityGoal(goal *CybersecurityGoal) []*Risk {
+	result := make([]*Risk, 0)
+	for _, risk := range model.AllRisks() {
+		if goal.Covers(risk) {
+			result = append(result, risk)
+		}
+	}
+	SortByRiskSeverity(result)
+	return result
+}
+
+// CybersecurityGoalsOfRisk lists the cybersecurity goals and claims covering a risk
+func (model *Model) CybersecurityGoalsOfRisk(risk *Risk) []*CybersecurityGoal {
+	result := make([]*CybersecurityGoal, 0)
+	for _, goal := range model.SortedCybersecurityGoals() {
+		if goal.Covers(risk) {
+			result = append(result, goal)
+		}
+	}
+	return result
+}
+
+func (model *Model) CybersecurityGoalCoverages() []CybersecurityGoalCoverage {
+	result := make([]CybersecurityGoalCoverage, 0)
+	for _, goal := range model.SortedCybersecurityGoals() {
+		coveredRisks := make([]CybersecurityGoalRisk, 0)
+		for _, risk := range model.RisksOfCybersecurityGoal(goal) {
+			tracking := model.GetRiskTrackingWithDefault(risk)
+			coveredRisks = append(coveredRisks, CybersecurityGoalRisk{
+				SyntheticId: risk.SyntheticId,
+				Title:       risk.Title,
+				Severity:    risk.Severity,
+				Status:      tracking.Status,
+				Treatment:   tracking.Treatment,
+			})
+		}
+		result = append(result, CybersecurityGoalCoverage{
+			Id:           goal.Id,
+			Title:        goal.Title,
+			Description:  goal.Description,
+			Claim:        goal.Claim,
+			CoveredRisks: coveredRisks,
+		})
+	}
+	return result
+}
+
+// CheckCybersecurityGoals checks that the risks referenced by cybersecurity goals and claims exist and that risks
+// treated by reduction are covered by a cybersecurity goal and risks being shared or retained by a cybersecurity claim
+func (model *Model) CheckCybersecurityGoals(ignoreOrphanedRiskTracking bool, progressReporter ProgressReporter) error {
+	if len(model.CybersecurityGoals) == 0 {
+		return nil
+	}
+
+	progressReporter.Info("Checking cybersecurity goals")
+	for _, goal := range model.SortedCybersecurityGoals() {
+		for _, pattern := range goal.RiskIds {
+			foundSome := false
+			for syntheticRiskId := range model.GeneratedRisksBySyntheticId {
+				if MatchesSyntheticRiskId(pattern, syntheticRiskId) {
+					foundSome = true
+					break
+				}
+			}
+
+			if !foundSome {
+				if ignoreOrphanedRiskTracking {
+					progressReporter.Warnf("%v %q references unknown risk (risk id not found): %v", goal.Kind(), goal.Id, pattern)
+				} else {
+					return fmt.Errorf("%v %q references unknown risk (risk id not found): %v", goal.Kind(), goal.Id, pattern)
+				}
+			}
+		}
+	}
+
+	for _, risk := range model.AllRisks() {
+		tracking := model.GetRiskTracking(risk)
+		if tracking == nil || (!tracking.Treatment.RequiresCybersecurityGoal() && !tracking.Treatment.RequiresCybersecurityClaim()) {
+			continue
+		}
+
+		covered := false
+		for _, goal := range model.CybersecurityGoalsOfRisk(risk) {
+			if goal.Claim == tracking.Treatment.RequiresCybersecurityClaim() {
+				covered = true
+			}
+		}
+
+		if !covered {
+			if tracking.Treatment.RequiresCybersecurityClaim() {
+				progressReporter.Warnf("Risk treated by %v is not covered by any cybersecurity claim: %v", tracking.Treatment, risk.SyntheticId)
+			} else {
+				progressReporter.Warnf("Risk treated by %v is not covered by any cybersecurity goal: %v", tracking.Treatment, risk.SyntheticId)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createCybersecurityGoalTestModel() *Model {
+	risks := []*Risk{
+		{SyntheticId: "spoofing@ecu", Severity: HighSeverity},
+		{SyntheticId: "flooding@ecu", Severity: MediumSeverity},
+		{SyntheticId: "flooding@gateway", Severity: LowSeverity},
+	}
+	return &Model{
+		GeneratedRisksByCategory: map[string][]*Risk{
+			"spoofing": {risks[0]},
+			"flooding": {risks[1], risks[2]},
+		},
+		GeneratedRisksBySyntheticId: map[string]*Risk{
+			risks[0].SyntheticId: risks[0],
+			risks[1].SyntheticId: risks[1],
+			risks[2].SyntheticId: risks[2],
+		},
+		RiskTracking: map[string]*RiskTracking{
+			"spoofing@ecu":     {SyntheticRiskId: "spoofing@ecu", Status: InProgress, Treatment: ReduceTreatment},
+			"flooding@ecu":     {SyntheticRiskId: "flooding@ecu", Status: InProgress, Treatment: ReduceTreatment},
+			"flooding@gateway": {SyntheticRiskId: "flooding@gateway", Status: Accepted, Treatment: RetainTreatment},
+		},
+		CybersecurityGoals: map[string]*CybersecurityGoal{
+			"authentic-messages": {Id: "authentic-messages", RiskIds: []string{"spoofing@*"}},
+			"available-network":  {Id: "available-network", RiskIds: []string{"flooding@*"}},
+			"low-flooding-risk":  {Id: "low-flooding-risk", Claim: true, RiskIds: []string{"flooding@gateway"}},
+		},
+	}
+}
+
+func TestSortedCybersecurityGoals(t *testing.T) {
+	parsedModel := createCybersecurityGoalTestModel()
+
+	goals := parsedModel.SortedCybersecurityGoals()
+
+	assert.Len(t, goals, 3)
+	assert.Equal(t, "authentic-messages", goals[0].Id)
+	assert.Equal(t, "available-network", goals[1].Id)
+	assert.Equal(t, "low-flooding-risk", goals[2].Id)
+}
+
+func TestRisksOfCybersecurityGoal(t *testing.T) {
+	parsedModel := createCybersecurityGoalTestModel()
+
+	risks := parsedModel.RisksOfCybersecurityGoal(parsedModel.CybersecurityGoals["available-network"])
+
+	assert.Len(t, risks, 2)
+	assert.Equal(t, "flooding@ecu", risks[0].SyntheticId)
+	assert.Equal(t, "flooding@gateway", risks[1].SyntheticId)
+}
+
+func TestCybersecurityGoalCoverages(t *testing.T) {
+	parsedModel := createCybersecurityGoalTestModel()
+
+	coverages := parsedModel.CybersecurityGoalCoverages()
+
+	assert.Len(t, coverages, 3)
+	assert.True(t, coverages[2].Claim)
+	assert.Equal(t, []CybersecurityGoalRisk{
+		{SyntheticId: "flooding@gateway", Severity: LowSeverity, Status: Accepted, Treatment: RetainTreatment},
+	}, coverages[2].CoveredRisks)
+}
+
+func TestCheckCybersecurityGoals(t *testing.T) {
+	parsedModel := createCybersecurityGoalTestModel()
+	reporter := new(taraTestReporter)
+
+	err := parsedModel.CheckCybersecurityGoals(false, reporter)
+
+	assert.Nil(t, err)
+	assert.Empty(t, reporter.warnings)
+}
+
+func TestCheckCybersecurityGoalsUncoveredTreatment(t *testing.T) {
+	parsedModel := createCybersecurityGoalTestModel()
+	delete(parsedModel.CybersecurityGoals, "authentic-messages")
+	delete(parsedModel.CybersecurityGoals, "low-flooding-risk")
+	reporter := new(taraTestReporter)
+
+	err := parsedModel.CheckCybersecurityGoals(false, reporter)
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{
+		"Risk treated by reduce is not covered by any cybersecurity goal: spoofing@ecu",
+		"Risk treated by retain is not covered by any cybersecurity claim: flooding@gateway",
+	}, reporter.warnings)
+}
+
+func TestCheckCybersecurityGoalsUnknownRisk(t *testing.T) {
+	parsedModel := createCybersecurityGoalTestModel()
+	parsedModel.CybersecurityGoals["authentic-messages"].RiskIds = append(parsedModel.CybersecurityGoals["authentic-messages"].RiskIds, "eavesdropping@*")
+
+	err := parsedModel.CheckCybersecurityGoals(false, new(taraTestReporter))
+	assert.EqualError(t, err, `cybersecurity goal "authentic-messages" references unknown risk (risk id not found): eavesdropping@*`)
+
+	reporter := new(taraTestReporter)
+	err = parsedModel.CheckCybersecurityGoals(true, reporter)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`cybersecurity goal "authentic-messages" references unknown risk (risk id not found): eavesdropping@*`}, reporter.warnings)
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DamageImpact rates the impact of a damage scenario on road users per ISO/SAE 21434 (safety, financial, operational and privacy)
+type DamageImpact int
+
+const (
+	NegligibleDamage DamageImpact = iota
+	ModerateDamage
+	MajorDamage
+	SevereDamage
+)
+
+func DamageImpactValues() []TypeEnum {
+	return []TypeEnum{
+		NegligibleDamage,
+		ModerateDamage,
+		MajorDamage,
+		SevereDamage,
+	}
+}
+
+var DamageImpactTypeDescription = [...]TypeDescription{
+	{"negligible", "Negligible impact"},
+	{"moderate", "Moderate impact"},
+	{"major", "Major impact"},
+	{"severe", "Severe impact"},
+}
+
+func ParseDamageImpact(value string) (damageImpact DamageImpact, err error) {
+	return DamageImpact(0).Find(value)
+}
+
+func (what DamageImpact) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return DamageImpactTypeDescription[what].Name
+}
+
+func (what DamageImpact) Explain() string {
+	return DamageImpactTypeDescription[what].Description
+}
+
+func (what DamageImpact) Title() string {
+	return [...]string{"Negligible", "Moderate", "Major", "Severe"}[what]
+}
+
+func (what DamageImpact) Weight() int {
+	return [...]int{0, 1, 2, 3}[what]
+}
+
+func (what DamageImpact) Find(value string) (DamageImpact, error) {
+	if len(value) == 0 {
+		return NegligibleDamage, nil
+	}
+
+	for index, description := range DamageImpactTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return DamageImpact(index), nil
+		}
+	}
+
+	return DamageImpact(0), fmt.Errorf("unknown damage impact value %q", value)
+}
+
+func (what DamageImpact) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *DamageImpact) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what DamageImpact) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *DamageImpact) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseDamageImpactTest struct {
+	input         string
+	expected      DamageImpact
+	expectedError error
+}
+
+func TestParseDamageImpact(t *testing.T) {
+	testCases := map[string]ParseDamageImpactTest{
+		"negligible": {
+			input:    "negligible",
+			expected: NegligibleDamage,
+		},
+		"moderate": {
+			input:    "moderate",
+			expected: ModerateDamage,
+		},
+		"major": {
+			input:    "major",
+			expected: MajorDamage,
+		},
+		"severe": {
+			input:    "severe",
+			expected: SevereDamage,
+		},
+		"default": {
+			input:    "",
+			expected: NegligibleDamage,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown damage impact value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseDamageImpact(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+// DamageScenario is an adverse consequence for road users of the item (ISO/SAE 21434), caused by the risks linked to it
+type DamageScenario struct {
+	Id          string       `json:"id,omitempty" yaml:"id,omitempty"`
+	Title       string       `json:"title,omitempty" yaml:"title,omitempty"`
+	Description string       `json:"description,omitempty" yaml:"description,omitempty"`
+	Safety      DamageImpact `json:"safety" yaml:"safety"`
+	Financial   DamageImpact `json:"financial" yaml:"financial"`
+	Operational DamageImpact `json:"operational" yaml:"operational"`
+	Privacy     DamageImpact `json:"privacy" yaml:"privacy"`
+	RiskIds     []string     `json:"risks,omitempty" yaml:"risks,omitempty"` // synthetic risk ids, may contain wildcards
+}
+
+// Impact is the highest of the safety, financial, operational and privacy impact ratings
+func (what DamageScenario) Impact() DamageImpact {
+	result := what.Safety
+	for _, impact := range []DamageImpact{what.Financial, what.Operational, what.Privacy} {
+		if impact > result {
+			result = impact
+		}
+	}
+	return result
+}
+
+// IsCausedBy tells whether the risk is linked to the damage scenario
+func (what DamageScenario) IsCausedBy(risk *Risk) bool {
+	for _, pattern := range what.RiskIds {
+		if MatchesSyntheticRiskId(pattern, risk.SyntheticId) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// E2EProtection is the AUTOSAR end-to-end protection profile (CRC, counter and data id) used for a communication link,
+// which detects random faults, but not forged messages
+type E2EProtection int
+
+const (
+	NoE2EProtection E2EProtection = iota
+	E2EProfile1
+	E2EProfile2
+	E2EProfile4
+	E2EProfile5
+	E2EProfile6
+	E2EProfile7
+	E2EProfile11
+	E2EProfile22
+)
+
+func E2EProtectionValues() []TypeEnum {
+	return []TypeEnum{
+		NoE2EProtection,
+		E2EProfile1,
+		E2EProfile2,
+		E2EProfile4,
+		E2EProfile5,
+		E2EProfile6,
+		E2EProfile7,
+		E2EProfile11,
+		E2EProfile22,
+	}
+}
+
+var E2EProtectionTypeDescription = [...]TypeDescription{
+	{"none", "No end-to-end protection"},
+	{"profile-1", "AUTOSAR E2E profile 1 (CRC8, 4-bit counter) for CAN"},
+	{"profile-2", "AUTOSAR E2E profile 2 (CRC8 with data id list, 4-bit counter) for CAN"},
+	{"profile-4", "AUTOSAR E2E profile 4 (CRC32, 16-bit counter) for large messages"},
+	{"profile-5", "AUTOSAR E2E profile 5 (CRC16, 8-bit counter)"},
+	{"profile-6", "AUTOSAR E2E profile 6 (CRC16, 8-bit counter) for dynamic length messages"},
+	{"profile-7", "AUTOSAR E2E profile 7 (CRC64, 32-bit counter) for very large messages"},
+	{"profile-11", "AUTOSAR E2E profile 11 (CRC8, 4-bit counter) for CAN and FlexRay"},
+	{"profile-22", "AUTOSAR E2E profile 22 (CRC8, 4-bit counter) for CAN FD"},
+}
+
+func ParseE2EProtection(value string) (e2EProtection E2EProtection, err error) {
+	return E2EProtection(0).Find(value)
+}
+
+func (what E2EProtection) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return E2EProtectionTypeDescription[what].Name
+}
+
+func (what E2EProtection) Explain() string {
+	return E2EProtectionTypeDescription[what].Description
+}
+
+func (what E2EProtection) Title() string {
+	return [...]string{"None", "Profile 1", "Profile 2", "Profile 4", "Profile 5", "Profile 6", "Profile 7", "Profile 11", "Profile 22"}[what]
+}
+
+func (what E2EProtection) Weight() int {
+	return [...]int{0, 1, 1, 2, 2, 2, 3, 1, 1}[what]
+}
+
+func (what E2EProtection) Find(value string) (E2EProtection, error) {
+	if len(value) == 0 {
+		return NoE2EProtection, nil
+	}
+
+	for index, description := range E2EProtectionTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return E2EProtection(index), nil
+		}
+	}
+
+	return E2EProtection(0), fmt.Errorf("unknown e2e protection value %q", value)
+}
+
+func (what E2EProtection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *E2EProtection) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what E2EProtection) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *E2EProtection) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
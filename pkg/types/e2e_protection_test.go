@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseE2EProtectionTest struct {
+	input         string
+	expected      E2EProtection
+	expectedError error
+}
+
+func TestParseE2EProtection(t *testing.T) {
+	testCases := map[string]ParseE2EProtectionTest{
+		"none": {
+			input:    "none",
+			expected: NoE2EProtection,
+		},
+		"profile-1": {
+			input:    "profile-1",
+			expected: E2EProfile1,
+		},
+		"profile-2": {
+			input:    "profile-2",
+			expected: E2EProfile2,
+		},
+		"profile-4": {
+			input:    "profile-4",
+			expected: E2EProfile4,
+		},
+		"profile-5": {
+			input:    "profile-5",
+			expected: E2EProfile5,
+		},
+		"profile-6": {
+			input:    "profile-6",
+			expected: E2EProfile6,
+		},
+		"profile-7": {
+			input:    "profile-7",
+			expected: E2EProfile7,
+		},
+		"profile-11": {
+			input:    "profile-11",
+			expected: E2EProfile11,
+		},
+		"profile-22": {
+			input:    "profile-22",
+			expected: E2EProfile22,
+		},
+		"default": {
+			input:    "",
+			expected: NoE2EProtection,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown e2e protection value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseE2EProtection(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
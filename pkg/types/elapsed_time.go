@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ElapsedTime is the attack potential factor rating the time needed to identify and exploit a vulnerability
+type ElapsedTime int
+
+const (
+	UpToOneDay ElapsedTime = iota
+	UpToOneWeek
+	UpToOneMonth
+	UpToSixMonths
+	MoreThanSixMonths
+)
+
+func ElapsedTimeValues() []TypeEnum {
+	return []TypeEnum{
+		UpToOneDay,
+		UpToOneWeek,
+		UpToOneMonth,
+		UpToSixMonths,
+		MoreThanSixMonths,
+	}
+}
+
+var ElapsedTimeTypeDescription = [...]TypeDescription{
+	{"up-to-one-day", "Up to one day"},
+	{"up-to-one-week", "Up to one week"},
+	{"up-to-one-month", "Up to one month"},
+	{"up-to-six-months", "Up to six months"},
+	{"more-than-six-months", "More than six months"},
+}
+
+func ParseElapsedTime(value string) (elapsedTime ElapsedTime, err error) {
+	return ElapsedTime(0).Find(value)
+}
+
+func (what ElapsedTime) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return ElapsedTimeTypeDescription[what].Name
+}
+
+func (what ElapsedTime) Explain() string {
+	return ElapsedTimeTypeDescription[what].Description
+}
+
+func (what ElapsedTime) Title() string {
+	return [...]string{"Up to one Day", "Up to one Week", "Up to one Month", "Up to six Months", "More than six Months"}[what]
+}
+
+// Weight is the attack potential value of the factor
+func (what ElapsedTime) Weight() int {
+	return [...]int{0, 1, 4, 17, 19}[what]
+}
+
+func (what ElapsedTime) Find(value string) (ElapsedTime, error) {
+	if len(value) == 0 {
+		return UpToOneDay, nil
+	}
+
+	for index, description := range ElapsedTimeTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return ElapsedTime(index), nil
+		}
+	}
+
+	return ElapsedTime(0), fmt.Errorf("unknown elapsed time value %q", value)
+}
+
+func (what ElapsedTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *ElapsedTime) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what ElapsedTime) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *ElapsedTime) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
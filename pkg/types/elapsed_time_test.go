@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseElapsedTimeTest struct {
+	input         string
+	expected      ElapsedTime
+	expectedError error
+}
+
+func TestParseElapsedTime(t *testing.T) {
+	testCases := map[string]ParseElapsedTimeTest{
+		"up-to-one-day": {
+			input:    "up-to-one-day",
+			expected: UpToOneDay,
+		},
+		"up-to-one-week": {
+			input:    "up-to-one-week",
+			expected: UpToOneWeek,
+		},
+		"up-to-one-month": {
+			input:    "up-to-one-month",
+			expected: UpToOneMonth,
+		},
+		"up-to-six-months": {
+			input:    "up-to-six-months",
+			expected: UpToSixMonths,
+		},
+		"more-than-six-months": {
+			input:    "more-than-six-months",
+			expected: MoreThanSixMonths,
+		},
+		"default": {
+			input:    "",
+			expected: UpToOneDay,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown elapsed time value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseElapsedTime(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
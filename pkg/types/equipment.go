@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Equipment is the attack potential factor rating the tools an attacker needs
+type Equipment int
+
+const (
+	StandardEquipment Equipment = iota
+	SpecializedEquipment
+	BespokeEquipment
+	MultipleBespokeEquipment
+)
+
+func EquipmentValues() []TypeEnum {
+	return []TypeEnum{
+		StandardEquipment,
+		SpecializedEquipment,
+		BespokeEquipment,
+		MultipleBespokeEquipment,
+	}
+}
+
+var EquipmentTypeDescription = [...]TypeDescription{
+	{"standard", "Standard equipment readily available to the attacker"},
+	{"specialized", "Specialized equipment acquirable without undue effort"},
+	{"bespoke", "Bespoke equipment to be specially produced or hard to acquire"},
+	{"multiple-bespoke", "Different bespoke equipment is required"},
+}
+
+func ParseEquipment(value string) (equipment Equipment, err error) {
+	return Equipment(0).Find(value)
+}
+
+func (what Equipment) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return EquipmentTypeDescription[what].Name
+}
+
+func (what Equipment) Explain() string {
+	return EquipmentTypeDescription[what].Description
+}
+
+func (what Equipment) Title() string {
+	return [...]string{"Standard", "Specialized", "Bespoke", "Multiple Bespoke"}[what]
+}
+
+// Weight is the attack potential value of the factor
+func (what Equipment) Weight() int {
+	return [...]int{0, 4, 7, 9}[what]
+}
+
+func (what Equipment) Find(value string) (Equipment, error) {
+	if len(value) == 0 {
+		return StandardEquipment, nil
+	}
+
+	for index, description := range EquipmentTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return Equipment(index), nil
+		}
+	}
+
+	return Equipment(0), fmt.Errorf("unknown equipment value %q", value)
+}
+
+func (what Equipment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *Equipment) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what Equipment) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *Equipment) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
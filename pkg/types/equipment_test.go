@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseEquipmentTest struct {
+	input         string
+	expected      Equipment
+	expectedError error
+}
+
+func TestParseEquipment(t *testing.T) {
+	testCases := map[string]ParseEquipmentTest{
+		"standard": {
+			input:    "standard",
+			expected: StandardEquipment,
+		},
+		"specialized": {
+			input:    "specialized",
+			expected: SpecializedEquipment,
+		},
+		"bespoke": {
+			input:    "bespoke",
+			expected: BespokeEquipment,
+		},
+		"multiple-bespoke": {
+			input:    "multiple-bespoke",
+			expected: MultipleBespokeEquipment,
+		},
+		"default": {
+			input:    "",
+			expected: StandardEquipment,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown equipment value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseEquipment(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
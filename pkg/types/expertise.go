@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Expertise is the attack potential factor rating the skills an attacker needs
+type Expertise int
+
+const (
+	Layman Expertise = iota
+	Proficient
+	Expert
+	MultipleExperts
+)
+
+func ExpertiseValues() []TypeEnum {
+	return []TypeEnum{
+		Layman,
+		Proficient,
+		Expert,
+		MultipleExperts,
+	}
+}
+
+var ExpertiseTypeDescription = [...]TypeDescription{
+	{"layman", "Unknowledgeable compared to experts or proficient persons"},
+	{"proficient", "Knowledgeable in being familiar with the security behaviour of the product"},
+	{"expert", "Familiar with the underlying algorithms, protocols, hardware and security principles"},
+	{"multiple-experts", "Different fields of expertise are required at expert level"},
+}
+
+func ParseExpertise(value string) (expertise Expertise, err error) {
+	return Expertise(0).Find(value)
+}
+
+func (what Expertise) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return ExpertiseTypeDescription[what].Name
+}
+
+func (what Expertise) Explain() string {
+	return ExpertiseTypeDescription[what].Description
+}
+
+func (what Expertise) Title() string {
+	return [...]string{"Layman", "Proficient", "Expert", "Multiple Experts"}[what]
+}
+
+// Weight is the attack potential value of the factor
+func (what Expertise) Weight() int {
+	return [...]int{0, 3, 6, 8}[what]
+}
+
+func (what Expertise) Find(value string) (Expertise, error) {
+	if len(value) == 0 {
+		return Layman, nil
+	}
+
+	for index, description := range ExpertiseTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return Expertise(index), nil
+		}
+	}
+
+	return Expertise(0), fmt.Errorf("unknown expertise value %q", value)
+}
+
+func (what Expertise) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *Expertise) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what Expertise) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *Expertise) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseExpertiseTest struct {
+	input         string
+	expected      Expertise
+	expectedError error
+}
+
+func TestParseExpertise(t *testing.T) {
+	testCases := map[string]ParseExpertiseTest{
+		"layman": {
+			input:    "layman",
+			expected: Layman,
+		},
+		"proficient": {
+			input:    "proficient",
+			expected: Proficient,
+		},
+		"expert": {
+			input:    "expert",
+			expected: Expert,
+		},
+		"multiple-experts": {
+			input:    "multiple-experts",
+			expected: MultipleExperts,
+		},
+		"default": {
+			input:    "",
+			expected: Layman,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown expertise value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseExpertise(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
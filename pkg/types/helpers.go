@@ -9,6 +9,9 @@ import (
 	"strings"
 )
 
+// TodoMarker marks values still to be filled in, like the CIA rating justifications of generated model stubs
+const TodoMarker = "TODO"
+
 func MakeID(val string) string {
 	reg, _ := regexp.Compile("[^A-Za-z0-9]+")
 	return strings.Trim(reg.ReplaceAllString(strings.ToLower(val), "-"), "- ")
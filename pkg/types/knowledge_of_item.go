@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KnowledgeOfItem is the attack potential factor rating the information about the item an attacker needs
+type KnowledgeOfItem int
+
+const (
+	PublicKnowledge KnowledgeOfItem = iota
+	RestrictedKnowledge
+	ConfidentialKnowledge
+	StrictlyConfidentialKnowledge
+)
+
+func KnowledgeOfItemValues() []TypeEnum {
+	return []TypeEnum{
+		PublicKnowledge,
+		RestrictedKnowledge,
+		ConfidentialKnowledge,
+		StrictlyConfidentialKnowledge,
+	}
+}
+
+var KnowledgeOfItemTypeDescription = [...]TypeDescription{
+	{"public", "Public information (e.g. from the internet)"},
+	{"restricted", "Restricted information (e.g. shared with partners under NDA)"},
+	{"confidential", "Confidential information (e.g. shared between teams of the developing organization)"},
+	{"strictly-confidential", "Strictly confidential information (e.g. known to a few individuals only)"},
+}
+
+func ParseKnowledgeOfItem(value string) (knowledgeOfItem KnowledgeOfItem, err error) {
+	return KnowledgeOfItem(0).Find(value)
+}
+
+func (what KnowledgeOfItem) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return KnowledgeOfItemTypeDescription[what].Name
+}
+
+func (what KnowledgeOfItem) Explain() string {
+	return KnowledgeOfItemTypeDescription[what].Description
+}
+
+func (what KnowledgeOfItem) Title() string {
+	return [...]string{"Public", "Restricted", "Confidential", "Strictly Confidential"}[what]
+}
+
+// Weight is the attack potential value of the factor
+func (what KnowledgeOfItem) Weight() int {
+	return [...]int{0, 3, 7, 11}[what]
+}
+
+func (what KnowledgeOfItem) Find(value string) (KnowledgeOfItem, error) {
+	if len(value) == 0 {
+		return PublicKnowledge, nil
+	}
+
+	for index, description := range KnowledgeOfItemTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return KnowledgeOfItem(index), nil
+		}
+	}
+
+	return KnowledgeOfItem(0), fmt.Errorf("unknown knowledge of item value %q", value)
+}
+
+func (what KnowledgeOfItem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *KnowledgeOfItem) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what KnowledgeOfItem) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *KnowledgeOfItem) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
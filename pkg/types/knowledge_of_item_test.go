@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseKnowledgeOfItemTest struct {
+	input         string
+	expected      KnowledgeOfItem
+	expectedError error
+}
+
+func TestParseKnowledgeOfItem(t *testing.T) {
+	testCases := map[string]ParseKnowledgeOfItemTest{
+		"public": {
+			input:    "public",
+			expected: PublicKnowledge,
+		},
+		"restricted": {
+			input:    "restricted",
+			expected: RestrictedKnowledge,
+		},
+		"confidential": {
+			input:    "confidential",
+			expected: ConfidentialKnowledge,
+		},
+		"strictly-confidential": {
+			input:    "strictly-confidential",
+			expected: StrictlyConfidentialKnowledge,
+		},
+		"default": {
+			input:    "",
+			expected: PublicKnowledge,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown knowledge of item value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseKnowledgeOfItem(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MessageAuthentication is the protection of the messages sent over a communication link against forgery, like AUTOSAR
+// SecOC (secure onboard communication) does for vehicle buses
+type MessageAuthentication int
+
+const (
+	NoMessageAuthentication MessageAuthentication = iota
+	MacWithoutFreshness
+	SecOC
+)
+
+func MessageAuthenticationValues() []TypeEnum {
+	return []TypeEnum{
+		NoMessageAuthentication,
+		MacWithoutFreshness,
+		SecOC,
+	}
+}
+
+var MessageAuthenticationTypeDescription = [...]TypeDescription{
+	{"none", "No message authentication"},
+	{"mac", "Message authentication code without freshness value (replay is possible)"},
+	{"secoc", "AUTOSAR SecOC with message authentication code and freshness value"},
+}
+
+func ParseMessageAuthentication(value string) (messageAuthentication MessageAuthentication, err error) {
+	return MessageAuthentication(0).Find(value)
+}
+
+func (what MessageAuthentication) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return MessageAuthenticationTypeDescription[what].Name
+}
+
+func (what MessageAuthentication) Explain() string {
+	return MessageAuthenticationTypeDescription[what].Description
+}
+
+func (what MessageAuthentication) Title() string {
+	return [...]string{"None", "MAC without Freshness", "SecOC"}[what]
+}
+
+func (what MessageAuthentication) Weight() int {
+	return [...]int{0, 1, 2}[what]
+}
+
+func (what MessageAuthentication) Find(value string) (MessageAuthentication, error) {
+	if len(value) == 0 {
+		return NoMessageAuthentication, nil
+	}
+
+	for index, description := range MessageAuthenticationTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return MessageAuthentication(index), nil
+		}
+	}
+
+	return MessageAuthentication(0), fmt.Errorf("unknown message authentication value %q", value)
+}
+
+func (what MessageAuthentication) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *MessageAuthentication) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what MessageAuthentication) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *MessageAuthentication) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseMessageAuthenticationTest struct {
+	input         string
+	expected      MessageAuthentication
+	expectedError error
+}
+
+func TestParseMessageAuthentication(t *testing.T) {
+	testCases := map[string]ParseMessageAuthenticationTest{
+		"none": {
+			input:    "none",
+			expected: NoMessageAuthentication,
+		},
+		"mac": {
+			input:    "mac",
+			expected: MacWithoutFreshness,
+		},
+		"secoc": {
+			input:    "secoc",
+			expected: SecOC,
+		},
+		"default": {
+			input:    "",
+			expected: NoMessageAuthentication,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown message authentication value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseMessageAuthentication(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// ModelDiff is the difference between two versions of a model: the elements added, removed or modified, matched by
+// their id, and the risks identified additionally, not anymore or with a different severity
+type ModelDiff struct {
+	From               string        `json:"from" yaml:"from"`
+	To                 string        `json:"to" yaml:"to"`
+	TechnicalAssets    *ElementsDiff `json:"technical_assets" yaml:"technical_assets"`
+	CommunicationLinks *ElementsDiff `json:"communication_links" yaml:"communication_links"`
+	DataAssets         *ElementsDiff `json:"data_assets" yaml:"data_assets"`
+	TrustBoundaries    *ElementsDiff `json:"trust_boundaries" yaml:"trust_boundaries"`
+	Risks              *RiskDelta    `json:"risks" yaml:"risks"`
+}
+
+// ElementsDiff lists the ids of the elements of a kind added and removed, sorted, and the changes of the elements
+// modified
+type ElementsDiff struct {
+	Added    []string         `json:"added" yaml:"added"`
+	Removed  []string         `json:"removed" yaml:"removed"`
+	Modified []*ElementChange `json:"modified" yaml:"modified"`
+}
+
+type ElementChange struct {
+	Id         string            `json:"id" yaml:"id"`
+	Title      string            `json:"title" yaml:"title"`
+	Properties []*PropertyChange `json:"properties" yaml:"properties"`
+}
+
+// PropertyChange is a property of an element with a different value, given as JSON (empty if the property is unset)
+type PropertyChange struct {
+	Property string `json:"property" yaml:"property"`
+	From     string `json:"from" yaml:"from"`
+	To       string `json:"to" yaml:"to"`
+}
+
+// properties not compared as they are calculated or only tweak diagrams, the communication links of technical assets
+// are compared separately
+var (
+	technicalAssetPropertiesNotCompared    = []string{"communication_links", "raa", "diagram_tweak_order"}
+	communicationLinkPropertiesNotCompared = []string{"diagram_tweak_weight", "diagram_tweak_constraint"}
+)
+
+// DiffModels compares two analyzed versions of a model, named by from and to
+func DiffModels(from string, fromModel *Model, to string, toModel *Model) *ModelDiff {
+	return &ModelDiff{
+		From:               from,
+		To:                 to,
+		TechnicalAssets:    diffElements(technicalAssetsToCompare(fromModel), technicalAssetsToCompare(toModel)),
+		CommunicationLinks: diffElements(communicationLinksToCompare(fromModel), communicationLinksToCompare(toModel)),
+		DataAssets:         diffElements(dataAssetsToCompare(fromModel), dataAssetsToCompare(toModel)),
+		TrustBoundaries:    diffElements(trustBoundariesToCompare(fromModel), trustBoundariesToCompare(toModel)),
+		Risks:              CompareRisks(fromModel.AllRisks(), toModel.AllRisks()),
+	}
+}
+
+// IsEmpty tells whether neither the elements of the model nor its risks have changed
+func (what *ModelDiff) IsEmpty() bool {
+	return what.TechnicalAssets.IsEmpty() && what.CommunicationLinks.IsEmpty() && what.DataAssets.IsEmpty() &&
+		what.TrustBoundaries.IsEmpty() && what.Risks.IsEmpty()
+}
+
+func (what *ElementsDiff) IsEmpty() bool {
+	return len(what.Added) == 0 && len(what.Removed) == 0 && len(what.Modified) == 0
+}
+
+// elementToCompare is the title of an element and the JSON representations of its properties
+type elementToCompare struct {
+	title      string
+	properties map[string]string
+}
+
+func technicalAssetsToCompare(model *Model) map[string]*elementToCompare {
+	elements := make(map[string]*elementToCompare)
+	for id, element := range model.TechnicalAssets {
+		elements[id] = &elementToCompare{title: element.Title, properties: jsonProperties(element, technicalAssetPropertiesNotCompared)}
+	}
+	return elements
+}
+
+func communicationLinksToCompare(model *Model) map[string]*elementToCompare {
+	elements := make(map[string]*elementToCompare)
+	for id, element := range model.CommunicationLinks {
+		elements[id] = &elementToCompare{title: element.Title, properties: jsonProperties(element, communicationLinkPropertiesNotCompared)}
+	}
+	return elements
+}
+
+func dataAssetsToCompare(model *Model) map[string]*elementToCompare {
+	elements := make(map[string]*elementToCompare)
+	for id, element := range model.DataAssets {
+		elements[id] = &elementToCompare{title: element.Title, properties: jsonProperties(element, nil)}
+	}
+	return elements
+}
+
+func trustBoundariesToCompare(model *Model) map[string]*elementToCompare {
+	elements := make(map[string]*elementToCompare)
+	for id, element := range model.TrustBoundaries {
+		elements[id] = &elementToCompare{title: element.Title, properties: jsonProperties(element, nil)}
+	}
+	return elements
+}
+
+func diffElements(from map[string]*elementToCompare, to map[string]*elementToCompare) *ElementsDiff {
+	diff := &ElementsDiff{
+		Added:    make([]string, 0),
+		Removed:  make([]string, 0),
+		Modified: make([]*ElementChange, 0),
+	}
+
+	for _, id := range sortedIdsToCompare(to) {
+		fromElement, ok := from[id]
+		if !ok {
+			diff.Added = append(diff.Added, id)
+			continue
+		}
+
+		properties := diffProperties(fromElement.properties, to[id].properties)
+		if len(properties) > 0 {
+			diff.Modified = append(diff.Modified, &ElementChange{Id: id, Title: to[id].title, Properties: properties})
+		}
+	}
+
+	for _, id := range sortedIdsToCompare(from) {
+		if _, ok := to[id]; !ok {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+
+	return diff
+}
+
+func diffProperties(from map[string]string, to map[string]string) []*PropertyChange {
+	names := make([]string, 0)
+	for name := range from {
+		names = append(names, name)
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]*PropertyChange, 0)
+	for _, name := range names {
+		if from[name] != to[name] {
+			changes = append(changes, &PropertyChange{Property: name, From: from[name], To: to[name]})
+		}
+	}
+	return changes
+}
+
+func jsonProperties(element any, propertiesNotCompared []string) map[string]string {
+	properties := make(map[string]string)
+	data, marshalError := json.Marshal(element)
+	if marshalError != nil {
+		return properties
+	}
+
+	rawProperties := make(map[string]json.RawMessage)
+	if json.Unmarshal(data, &rawProperties) != nil {
+		return properties
+	}
+
+	for name, value := range rawProperties {
+		properties[name] = string(value)
+
+		// lists of ids and tags are sets, their order is not a change
+		var values []string
+		if json.Unmarshal(value, &values) == nil {
+			sort.Strings(values)
+			sortedValue, sortError := json.Marshal(values)
+			if sortError == nil {
+				properties[name] = string(sortedValue)
+			}
+		}
+	}
+	for _, name := range propertiesNotCompared {
+		delete(properties, name)
+	}
+	return properties
+}
+
+func sortedIdsToCompare(elements map[string]*elementToCompare) []string {
+	ids := make([]string, 0, len(elements))
+	for id := range elements {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffModels(t *testing.T) {
+	from := &Model{
+		TechnicalAssets: map[string]*TechnicalAsset{
+			"kept":    {Id: "kept", Title: "Kept", Confidentiality: Confidential, Tags: []string{"a", "b"}, RAA: 10},
+			"removed": {Id: "removed", Title: "Removed"},
+		},
+		DataAssets: map[string]*DataAsset{
+			"data": {Id: "data", Title: "Data", Origin: "Customer"},
+		},
+		TrustBoundaries: map[string]*TrustBoundary{
+			"boundary": {Id: "boundary", Title: "Boundary", TechnicalAssetsInside: []string{"kept", "removed"}},
+		},
+		GeneratedRisksByCategory: map[string][]*Risk{
+			"category": {
+				{SyntheticId: "category@removed", Severity: MediumSeverity},
+				{SyntheticId: "category@kept", Severity: LowSeverity},
+			},
+		},
+	}
+	to := &Model{
+		TechnicalAssets: map[string]*TechnicalAsset{
+			"kept":  {Id: "kept", Title: "Kept", Confidentiality: StrictlyConfidential, Tags: []string{"b", "a"}, RAA: 20},
+			"added": {Id: "added", Title: "Added"},
+		},
+		DataAssets: map[string]*DataAsset{
+			"data": {Id: "data", Title: "Data"},
+		},
+		TrustBoundaries: map[string]*TrustBoundary{
+			"boundary": {Id: "boundary", Title: "Boundary", TechnicalAssetsInside: []string{"added", "kept"}},
+		},
+		GeneratedRisksByCategory: map[string][]*Risk{
+			"category": {
+				{SyntheticId: "category@kept", Severity: HighSeverity},
+				{SyntheticId: "category@added", Severity: CriticalSeverity},
+			},
+		},
+	}
+
+	diff := DiffModels("v1.yaml", from, "v2.yaml", to)
+
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, "v1.yaml", diff.From)
+	assert.Equal(t, "v2.yaml", diff.To)
+
+	assert.Equal(t, []string{"added"}, diff.TechnicalAssets.Added)
+	assert.Equal(t, []string{"removed"}, diff.TechnicalAssets.Removed)
+	assert.Len(t, diff.TechnicalAssets.Modified, 1)
+	assert.Equal(t, "kept", diff.TechnicalAssets.Modified[0].Id)
+	assert.Equal(t, []*PropertyChange{{Property: "confidentiality", From: `"confidential"`, To: `"strictly-confidential"`}},
+		diff.TechnicalAssets.Modified[0].Properties)
+
+	assert.Len(t, diff.DataAssets.Modified, 1)
+	assert.Equal(t, []*PropertyChange{{Property: "origin", From: `"Customer"`, To: ""}}, diff.DataAssets.Modified[0].Properties)
+
+	assert.Len(t, diff.TrustBoundaries.Modified, 1)
+	assert.Equal(t, []*PropertyChange{{Property: "technical_assets_inside", From: `["kept","removed"]`, To: `["added","kept"]`}},
+		diff.TrustBoundaries.Modified[0].Properties)
+
+	assert.True(t, diff.CommunicationLinks.IsEmpty())
+
+	assert.Equal(t, []string{"category@added"}, syntheticIdsOf(diff.Risks.New))
+	assert.Equal(t, []string{"category@removed"}, syntheticIdsOf(diff.Risks.Resolved))
+	assert.Len(t, diff.Risks.Rerated, 1)
+	assert.Equal(t, "category@kept", diff.Risks.Rerated[0].SyntheticId)
+}
+
+func TestDiffModelsUnchanged(t *testing.T) {
+	parsedModel := &Model{
+		TechnicalAssets: map[string]*TechnicalAsset{
+			"asset": {Id: "asset", Title: "Asset", CommunicationLinks: []*CommunicationLink{{Id: "asset>link"}}},
+		},
+		CommunicationLinks: map[string]*CommunicationLink{
+			"asset>link": {Id: "asset>link", Title: "Link", SourceId: "asset", TargetId: "asset"},
+		},
+	}
+
+	assert.True(t, DiffModels("a", parsedModel, "b", parsedModel).IsEmpty())
+	assert.True(t, DiffModels("a", &Model{}, "b", &Model{}).IsEmpty())
+}
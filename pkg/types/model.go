@@ -39,6 +39,9 @@ type Model struct {
 	CustomRiskCategories                          RiskCategories                `json:"custom_risk_categories,omitempty" yaml:"custom_risk_categories,omitempty"`
 	BuiltInRiskCategories                         RiskCategories                `json:"built_in_risk_categories,omitempty" yaml:"built_in_risk_categories,omitempty"`
 	RiskTracking                                  map[string]*RiskTracking      `json:"risk_tracking,omitempty" yaml:"risk_tracking,omitempty"`
+	DamageScenarios                               map[string]*DamageScenario    `json:"damage_scenarios,omitempty" yaml:"damage_scenarios,omitempty"`
+	AttackPotentials                              map[string]*AttackPotential   `json:"attack_potentials,omitempty" yaml:"attack_potentials,omitempty"`
+	CybersecurityGoals                            map[string]*CybersecurityGoal `json:"cybersecurity_goals,omitempty" yaml:"cybersecurity_goals,omitempty"` // including the cybersecurity claims
 	CommunicationLinks                            map[string]*CommunicationLink `json:"communication_links,omitempty" yaml:"communication_links,omitempty"`
 	AllSupportedTags                              map[string]bool               `json:"all_supported_tags,omitempty" yaml:"all_supported_tags,omitempty"`
 	DiagramTweakNodesep                           int                           `json:"diagram_tweak_nodesep,omitempty" yaml:"diagram_tweak_nodesep,omitempty"`
@@ -50,10 +53,14 @@ type Model struct {
 	DiagramTweakSameRankAssets                    []string                      `json:"diagram_tweak_same_rank_assets,omitempty" yaml:"diagram_tweak_same_rank_assets,omitempty"`
 
 	// TODO: those are generated based on items above and needs to be private
-	IncomingTechnicalCommunicationLinksMappedByTargetId   map[string][]*CommunicationLink `json:"incoming_technical_communication_links_mapped_by_target_id,omitempty" yaml:"incoming_technical_communication_links_mapped_by_target_id,omitempty"`
-	DirectContainingTrustBoundaryMappedByTechnicalAssetId map[string]*TrustBoundary       `json:"direct_containing_trust_boundary_mapped_by_technical_asset_id,omitempty" yaml:"direct_containing_trust_boundary_mapped_by_technical_asset_id,omitempty"`
-	GeneratedRisksByCategory                              map[string][]*Risk              `json:"generated_risks_by_category,omitempty" yaml:"generated_risks_by_category,omitempty"`
-	GeneratedRisksBySyntheticId                           map[string]*Risk                `json:"generated_risks_by_synthetic_id,omitempty" yaml:"generated_risks_by_synthetic_id,omitempty"`
+	IncomingTechnicalCommunicationLinksMappedByTargetId   map[string][]*CommunicationLink    `json:"incoming_technical_communication_links_mapped_by_target_id,omitempty" yaml:"incoming_technical_communication_links_mapped_by_target_id,omitempty"`
+	DirectContainingTrustBoundaryMappedByTechnicalAssetId map[string]*TrustBoundary          `json:"direct_containing_trust_boundary_mapped_by_technical_asset_id,omitempty" yaml:"direct_containing_trust_boundary_mapped_by_technical_asset_id,omitempty"`
+	GeneratedRisksByCategory                              map[string][]*Risk                 `json:"generated_risks_by_category,omitempty" yaml:"generated_risks_by_category,omitempty"`
+	GeneratedRisksBySyntheticId                           map[string]*Risk                   `json:"generated_risks_by_synthetic_id,omitempty" yaml:"generated_risks_by_synthetic_id,omitempty"`
+	AttackPaths                                           []*AttackPath                      `json:"attack_paths,omitempty" yaml:"attack_paths,omitempty"`
+	AttackerAttractiveness                                map[string]*AttackerAttractiveness `json:"attacker_attractiveness,omitempty" yaml:"attacker_attractiveness,omitempty"` // breakdown of the RAA by technical asset id
+	RiskRuleEvaluations                                   []*RiskRuleEvaluation              `json:"-" yaml:"-"`                                                                 // sorted by risk rule id, timings differ between runs
+	ModelDiff                                             *ModelDiff                         `json:"-" yaml:"-"`                                                                 // changes compared to a previous version of the model, only set by the diff
 }
 
 type ProgressReporter interface {
@@ -107,7 +114,15 @@ func (model *Model) CheckTags(tags []string, where string) ([]string, error) {
 
 func (model *Model) ApplyWildcardRiskTrackingEvaluation(ignoreOrphanedRiskTracking bool, progressReporter ProgressReporter) error {
 	progressReporter.Info("Executing risk tracking evaluation")
-	for syntheticRiskIdPattern, riskTracking := range model.GetDeferredRiskTrackingDueToWildcardMatching() {
+	deferredRiskTracking := model.GetDeferredRiskTrackingDueToWildcardMatching()
+	// apply them in sorted order, so that the same wildcard pattern applies to a risk matched by several ones
+	syntheticRiskIdPatterns := make([]string, 0, len(deferredRiskTracking))
+	for syntheticRiskIdPattern := range deferredRiskTracking {
+		syntheticRiskIdPatterns = append(syntheticRiskIdPatterns, syntheticRiskIdPattern)
+	}
+	sort.Strings(syntheticRiskIdPatterns)
+	for _, syntheticRiskIdPattern := range syntheticRiskIdPatterns {
+		riskTracking := deferredRiskTracking[syntheticRiskIdPattern]
 		progressReporter.Infof("Applying wildcard risk tracking for risk id: %v", syntheticRiskIdPattern)
 
 		foundSome := false
@@ -122,6 +137,8 @@ func (model *Model) ApplyWildcardRiskTrackingEvaluation(ignoreOrphanedRiskTracki
 					Ticket:          riskTracking.Ticket,
 					Status:          riskTracking.Status,
 					Date:            riskTracking.Date,
+					Treatment:       riskTracking.Treatment,
+					WildcardPattern: syntheticRiskIdPattern,
 				}
 
 				progressReporter.Infof("  => %v", syntheticRiskId)
@@ -139,6 +156,13 @@ func (model *Model) ApplyWildcardRiskTrackingEvaluation(ignoreOrphanedRiskTracki
 	return nil
 }
 
+// MatchesWildcardRiskId tells whether the synthetic risk id matches the pattern of a risk tracking entry, each '*'
+// matching one part of the synthetic id between '@' signs
+func MatchesWildcardRiskId(pattern string, syntheticRiskId string) bool {
+	var matchingRiskIdExpression = regexp.MustCompile(strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, `[^@]+`))
+	return matchingRiskIdExpression.MatchString(syntheticRiskId)
+}
+
 func (model *Model) CheckRiskTracking(ignoreOrphanedRiskTracking bool, progressReporter ProgressReporter) error {
 	progressReporter.Info("Checking risk tracking")
 	for _, tracking := range model.RiskTracking {
@@ -683,6 +707,65 @@ func (model *Model) GetTechnicalAssetTrustBoundaryId(ta *TechnicalAsset) string
 	return ""
 }
 
+// SafetyIntegrityLevelOfTechnicalAsset is the level of the technical asset itself, but at least the one of the trust
+// boundary directly containing it (like a partition or VM developed to a certain ASIL)
+func (model *Model) SafetyIntegrityLevelOfTechnicalAsset(ta *TechnicalAsset) SafetyIntegrityLevel {
+	level := ta.SafetyIntegrityLevel
+	trustBoundary, ok := model.DirectContainingTrustBoundaryMappedByTechnicalAssetId[ta.Id]
+	if ok && trustBoundary.SafetyIntegrityLevel > level {
+		level = trustBoundary.SafetyIntegrityLevel
+	}
+	return level
+}
+
+// HasBootVerifications tells whether the model describes any chain of trust, i.e. technical assets being a hardware
+// root of trust or verifying other technical assets at boot
+func (model *Model) HasBootVerifications() bool {
+	for _, technicalAsset := range model.TechnicalAssets {
+		if technicalAsset.HardwareRootOfTrust || len(technicalAsset.VerifiesAtBoot) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// BootVerifiersOfTechnicalAsset returns the technical assets verifying the given one at boot, sorted by id
+func (model *Model) BootVerifiersOfTechnicalAsset(ta *TechnicalAsset) []*TechnicalAsset {
+	result := make([]*TechnicalAsset, 0)
+	for _, id := range model.SortedTechnicalAssetIDs() {
+		if contains(model.TechnicalAssets[id].VerifiesAtBoot, ta.Id) {
+			result = append(result, model.TechnicalAssets[id])
+		}
+	}
+	return result
+}
+
+// TechnicalAssetIDsCoveredBySecureBoot returns the ids of the hardware roots of trust and of all technical assets
+// (transitively) verified at boot by them
+func (model *Model) TechnicalAssetIDsCoveredBySecureBoot() map[string]bool {
+	covered := make(map[string]bool)
+	queue := make([]*TechnicalAsset, 0)
+	for _, id := range model.SortedTechnicalAssetIDs() {
+		if model.TechnicalAssets[id].HardwareRootOfTrust {
+			covered[id] = true
+			queue = append(queue, model.TechnicalAssets[id])
+		}
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, verifiedId := range current.VerifiesAtBoot {
+			verified, ok := model.TechnicalAssets[verifiedId]
+			if !ok || covered[verifiedId] {
+				continue
+			}
+			covered[verifiedId] = true
+			queue = append(queue, verified)
+		}
+	}
+	return covered
+}
+
 func (model *Model) HighestTechnicalAssetConfidentiality(what *TechnicalAsset) Confidentiality {
 	highest := what.Confidentiality
 	highestProcessed := model.HighestProcessedConfidentiality(what)
@@ -872,6 +955,7 @@ func (model *Model) GeneratedRisksByCategoryWithCurrentStatus() map[string][]*Ri
 			riskTracked, ok := model.RiskTracking[risk.SyntheticId]
 			if ok {
 				generatedRisksByCategoryWithCurrentStatus[catId][idx].RiskStatus = riskTracked.Status
+				generatedRisksByCategoryWithCurrentStatus[catId][idx].RiskTreatment = riskTracked.Treatment
 			}
 		}
 	}
@@ -70,6 +70,7 @@ const (
 	LIN
 	IPC
 	SomeIP
+	SomeIpTls
 	Local
 	TCP
 	UDP
@@ -131,6 +132,7 @@ func ProtocolValues() []TypeEnum {
 		LIN,
 		IPC,
 		SomeIP,
+		SomeIpTls,
 		Local,
 		TCP,
 		UDP,
@@ -192,6 +194,7 @@ var ProtocolTypeDescription = [...]TypeDescription{
 	{"lin", "Local Interconnect Network"},
 	{"ipc", "Inter-Process Communication (Shared Mem/RPMsg)"},
 	{"some-ip", "Scalable service-Oriented MiddlewarE over IP"},
+	{"some-ip-tls", "SOME/IP secured by TLS"},
 	{"local", "Local function call or internal logic"},
 	{"tcp", "Transmission Control Protocol"},
 	{"udp", "User Datagram Protocol"},
@@ -217,14 +220,45 @@ func (what Protocol) Explain() string {
 }
 
 func (what Protocol) IsProcessLocal() bool {
-	return what == InProcessLibraryCall || what == InterProcessCommunication || what == LocalFileAccess || what == ContainerSpawning
+	return what == InProcessLibraryCall || what == InterProcessCommunication || what == LocalFileAccess || what == ContainerSpawning ||
+		what == Local
+}
+
+// IsOnChip tells whether the communication never leaves the chip (like inter-core IPC via shared memory or RPMsg),
+// so it can't be eavesdropped or tampered with without first compromising one of the components on the chip
+func (what Protocol) IsOnChip() bool {
+	return what.IsProcessLocal() || what == IPC
+}
+
+// IsBroadcast tells whether the communication uses a bus every connected node receives (and can send) all frames on,
+// in contrast to point-to-point connections
+func (what Protocol) IsBroadcast() bool {
+	return what == CAN || what == CANFD || what == FlexRay || what == LIN
+}
+
+// AuthenticatedVariant names the authenticated (and possibly encrypted) variant of a protocol not protected itself,
+// or an empty string if there is none (or the protocol is already protected)
+func (what Protocol) AuthenticatedVariant() string {
+	switch what {
+	case CAN, CANFD, FlexRay:
+		return "SecOC"
+	case SomeIP:
+		return "SOME/IP-TLS"
+	case TCP:
+		return "TLS"
+	case UDP:
+		return "DTLS"
+	default:
+		return ""
+	}
 }
 
 func (what Protocol) IsEncrypted() bool {
 	return what == HTTPS || what == WSS || what == JdbcEncrypted || what == OdbcEncrypted ||
 		what == NosqlAccessProtocolEncrypted || what == SqlAccessProtocolEncrypted || what == BinaryEncrypted || what == TextEncrypted || what == SSH || what == SshTunnel ||
 		what == FTPS || what == SFTP || what == SCP || what == LDAPS || what == ReverseProxyWebProtocolEncrypted ||
-		what == IiopEncrypted || what == JrmpEncrypted || what == SmbEncrypted || what == SmtpEncrypted || what == Pop3Encrypted || what == ImapEncrypted
+		what == IiopEncrypted || what == JrmpEncrypted || what == SmbEncrypted || what == SmtpEncrypted || what == Pop3Encrypted || what == ImapEncrypted ||
+		what == SomeIpTls
 }
 
 func (what Protocol) IsPotentialDatabaseAccessProtocol() bool {
@@ -237,6 +271,9 @@ func (what Protocol) IsPotentialLaxDatabaseAccessProtocol() bool {
 	return what == HTTPS || what == HTTP || what == BINARY || what == BinaryEncrypted
 }
 
+// IsPotentialWebAccessProtocol tells whether the protocol is HTTP based and thereby exposed to web attacks (like CSRF or
+// SSRF) and protectable by a WAF. The automotive protocols are not, neither SOME/IP nor TCP and UDP carrying diagnostics
+// (like UDS over DoIP), as they don't transport browser requests or URLs.
 func (what Protocol) IsPotentialWebAccessProtocol() bool {
 	return what == HTTP || what == HTTPS || what == WS || what == WSS || what == ReverseProxyWebProtocol || what == ReverseProxyWebProtocolEncrypted
 }
@@ -211,6 +211,46 @@ func TestParseProtocol(t *testing.T) {
 			input:    "container-spawning",
 			expected: ContainerSpawning,
 		},
+		"can-bus": {
+			input:    "can-bus",
+			expected: CAN,
+		},
+		"can-fd": {
+			input:    "can-fd",
+			expected: CANFD,
+		},
+		"flexray": {
+			input:    "flexray",
+			expected: FlexRay,
+		},
+		"lin": {
+			input:    "lin",
+			expected: LIN,
+		},
+		"ipc": {
+			input:    "ipc",
+			expected: IPC,
+		},
+		"some-ip": {
+			input:    "some-ip",
+			expected: SomeIP,
+		},
+		"some-ip-tls": {
+			input:    "some-ip-tls",
+			expected: SomeIpTls,
+		},
+		"local": {
+			input:    "local",
+			expected: Local,
+		},
+		"tcp": {
+			input:    "tcp",
+			expected: TCP,
+		},
+		"udp": {
+			input:    "udp",
+			expected: UDP,
+		},
 		"unknown": {
 			input:         "unknown",
 			expectedError: fmt.Errorf("unable to parse into type: unknown"),
@@ -226,3 +266,48 @@ func TestParseProtocol(t *testing.T) {
 		})
 	}
 }
+
+type ProtocolTraitsTest struct {
+	encrypted            bool
+	processLocal         bool
+	onChip               bool
+	broadcast            bool
+	webAccess            bool
+	authenticatedVariant string
+}
+
+func TestProtocolTraits(t *testing.T) {
+	testCases := map[Protocol]ProtocolTraitsTest{
+		HTTP:                 {webAccess: true},
+		HTTPS:                {encrypted: true, webAccess: true},
+		InProcessLibraryCall: {processLocal: true, onChip: true},
+		CAN:                  {broadcast: true, authenticatedVariant: "SecOC"},
+		CANFD:                {broadcast: true, authenticatedVariant: "SecOC"},
+		FlexRay:              {broadcast: true, authenticatedVariant: "SecOC"},
+		LIN:                  {broadcast: true},
+		IPC:                  {onChip: true},
+		SomeIP:               {authenticatedVariant: "SOME/IP-TLS"},
+		SomeIpTls:            {encrypted: true},
+		Local:                {processLocal: true, onChip: true},
+		TCP:                  {authenticatedVariant: "TLS"},
+		UDP:                  {authenticatedVariant: "DTLS"},
+	}
+
+	for protocol, testCase := range testCases {
+		t.Run(protocol.String(), func(t *testing.T) {
+			assert.Equal(t, testCase.encrypted, protocol.IsEncrypted())
+			assert.Equal(t, testCase.processLocal, protocol.IsProcessLocal())
+			assert.Equal(t, testCase.onChip, protocol.IsOnChip())
+			assert.Equal(t, testCase.broadcast, protocol.IsBroadcast())
+			assert.Equal(t, testCase.webAccess, protocol.IsPotentialWebAccessProtocol())
+			assert.Equal(t, testCase.authenticatedVariant, protocol.AuthenticatedVariant())
+		})
+	}
+}
+
+// the automotive protocols (including TCP and UDP carrying diagnostics like UDS over DoIP) are not exposed to web attacks
+func TestAutomotiveProtocolsAreNoWebAccessProtocols(t *testing.T) {
+	for _, protocol := range []Protocol{CAN, CANFD, FlexRay, LIN, IPC, SomeIP, SomeIpTls, Local, TCP, UDP} {
+		assert.False(t, protocol.IsPotentialWebAccessProtocol(), protocol.String())
+	}
+}
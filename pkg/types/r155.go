@@ -0,0 +1,117 @@
+package types
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed r155_annex5.yaml
+var r155Annex5 []byte
+
+// R155TechnicalAssetTag marks technical assets of vehicles, for which the UN R155 Annex 5 compliance is reported
+// even when the model does not declare damage scenarios (TARA mode)
+const R155TechnicalAssetTag = "automotive"
+
+// R155Catalog is the catalog of threats and mitigations of UN Regulation No. 155 Annex 5
+type R155Catalog struct {
+	Mitigations map[string]string `json:"mitigations" yaml:"mitigations"`
+	Threats     []*R155Threat     `json:"threats" yaml:"threats"`
+}
+
+// R155Threat is a high-level threat of UN R155 Annex 5 part A, mapped to the risk categories identifying it
+type R155Threat struct {
+	Id             string   `json:"id" yaml:"id"`
+	Group          string   `json:"group" yaml:"group"`
+	Title          string   `json:"title" yaml:"title"`
+	Mitigations    []string `json:"mitigations" yaml:"mitigations"`
+	RiskCategories []string `json:"risk_categories" yaml:"risk_categories"` // a trailing "*" matches any category id starting with the text before it
+}
+
+// R155ThreatCoverage lists the evaluated risk categories mapped to an UN R155 Annex 5 threat and the risks identified
+// by them
+type R155ThreatCoverage struct {
+	Threat         *R155Threat
+	RiskCategories []*RiskCategory
+	Risks          []*Risk
+}
+
+// LoadR155Annex5 reads the embedded UN R155 Annex 5 catalog
+func LoadR155Annex5() (*R155Catalog, error) {
+	catalog := new(R155Catalog)
+	unmarshalError := yaml.Unmarshal(r155Annex5, catalog)
+	if unmarshalError != nil {
+		return nil, fmt.Errorf("unable to parse UN R155 Annex 5 catalog: %w", unmarshalError)
+	}
+
+	for _, threat := range catalog.Threats {
+		for _, mitigation := range threat.Mitigations {
+			if _, ok := catalog.Mitigations[mitigation]; !ok {
+				return nil, fmt.Errorf("UN R155 Annex 5 threat %q references unknown mitigation %q", threat.Id, mitigation)
+			}
+		}
+	}
+
+	return catalog, nil
+}
+
+// IsIdentifiedBy tells whether risks of the risk category identify the threat
+func (what R155Threat) IsIdentifiedBy(categoryId string) bool {
+	for _, pattern := range what.RiskCategories {
+		if prefix, isWildcard := strings.CutSuffix(pattern, "*"); isWildcard {
+			if strings.HasPrefix(categoryId, prefix) {
+				return true
+			}
+		} else if strings.EqualFold(pattern, categoryId) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCovered tells whether any risk rule identifying the threat has been evaluated
+func (what R155ThreatCoverage) IsCovered() bool {
+	return len(what.RiskCategories) > 0
+}
+
+// IsR155Mode tells whether the model describes a vehicle, either by declaring damage scenarios (TARA mode) or by
+// technical assets tagged with R155TechnicalAssetTag
+func (model *Model) IsR155Mode() bool {
+	if model.IsTaraMode() {
+		return true
+	}
+	for _, technicalAsset := range model.TechnicalAssets {
+		if technicalAsset.IsTaggedWithAny(R155TechnicalAssetTag) {
+			return true
+		}
+	}
+	return false
+}
+
+// R155Coverage maps the risk categories evaluated for the model and the risks identified by them to the threats of
+// the catalog
+func (model *Model) R155Coverage(catalog *R155Catalog) []R155ThreatCoverage {
+	categories := make(RiskCategories, 0, len(model.BuiltInRiskCategories)+len(model.CustomRiskCategories))
+	categories = append(categories, model.BuiltInRiskCategories...)
+	categories = append(categories, model.CustomRiskCategories...)
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].ID < categories[j].ID
+	})
+
+	result := make([]R155ThreatCoverage, 0, len(catalog.Threats))
+	for _, threat := range catalog.Threats {
+		coverage := R155ThreatCoverage{Threat: threat, RiskCategories: make([]*RiskCategory, 0), Risks: make([]*Risk, 0)}
+		for _, category := range categories {
+			if threat.IsIdentifiedBy(category.ID) {
+				coverage.RiskCategories = append(coverage.RiskCategories, category)
+				coverage.Risks = append(coverage.Risks, model.GeneratedRisksByCategory[category.ID]...)
+			}
+		}
+		SortByRiskSeverity(coverage.Risks)
+		result = append(result, coverage)
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadR155Annex5(t *testing.T) {
+	catalog, err := LoadR155Annex5()
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, catalog.Threats)
+
+	ids := make([]string, 0)
+	for _, threat := range catalog.Threats {
+		ids = append(ids, threat.Id)
+		assert.NotEmpty(t, threat.Mitigations, "threat %q without mitigations", threat.Id)
+		if threat.Id != "30" { // physical loss of data is not identified by any risk rule
+			assert.NotEmpty(t, threat.RiskCategories, "threat %q without risk categories", threat.Id)
+		}
+	}
+
+	// all threats of table A1, which has no threat 14
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "15", "16", "17",
+		"18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"}, ids)
+}
+
+type R155ThreatIsIdentifiedByTest struct {
+	categoryId string
+	expected   bool
+}
+
+func TestR155ThreatIsIdentifiedBy(t *testing.T) {
+	threat := R155Threat{RiskCategories: []string{"missing-message-authentication", "automotive-t-s-*"}}
+	testCases := map[string]R155ThreatIsIdentifiedByTest{
+		"exact": {
+			categoryId: "missing-message-authentication",
+			expected:   true,
+		},
+		"wildcard": {
+			categoryId: "automotive-t-s-004",
+			expected:   true,
+		},
+		"other": {
+			categoryId: "missing-authentication",
+			expected:   false,
+		},
+		"other wildcard": {
+			categoryId: "automotive-t-t-004",
+			expected:   false,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, threat.IsIdentifiedBy(test.categoryId))
+		})
+	}
+}
+
+func TestR155Coverage(t *testing.T) {
+	parsedModel := &Model{
+		BuiltInRiskCategories: RiskCategories{
+			{ID: "missing-message-authentication"},
+			{ID: "automotive-t-s-001"},
+			{ID: "automotive-t-s-004"},
+		},
+		GeneratedRisksByCategory: map[string][]*Risk{
+			"automotive-t-s-004": {
+				{CategoryId: "automotive-t-s-004", SyntheticId: "automotive-t-s-004@ecu", Severity: MediumSeverity},
+			},
+			"missing-message-authentication": {
+				{CategoryId: "missing-message-authentication", SyntheticId: "missing-message-authentication@ecu", Severity: HighSeverity},
+			},
+		},
+	}
+	catalog := &R155Catalog{
+		Threats: []*R155Threat{
+			{Id: "4", RiskCategories: []string{"missing-message-authentication", "automotive-t-s-*"}},
+			{Id: "13", RiskCategories: []string{"automotive-t-p-003"}},
+		},
+	}
+
+	coverage := parsedModel.R155Coverage(catalog)
+
+	assert.Len(t, coverage, 2)
+	assert.True(t, coverage[0].IsCovered())
+	assert.Len(t, coverage[0].RiskCategories, 3)
+	assert.Len(t, coverage[0].Risks, 2)
+	assert.Equal(t, "missing-message-authentication@ecu", coverage[0].Risks[0].SyntheticId)
+	assert.False(t, coverage[1].IsCovered())
+	assert.Empty(t, coverage[1].Risks)
+}
+
+func TestIsR155Mode(t *testing.T) {
+	assert.False(t, (&Model{}).IsR155Mode())
+	assert.True(t, (&Model{DamageScenarios: map[string]*DamageScenario{"brakes": {}}}).IsR155Mode())
+	assert.True(t, (&Model{TechnicalAssets: map[string]*TechnicalAsset{"ecu": {Tags: []string{"automotive"}}}}).IsR155Mode())
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"sort"
+	"strings"
+)
+
+// RiskDelta is the difference between two sets of risks, matched by their synthetic id
+type RiskDelta struct {
+	New      []*Risk               `json:"new" yaml:"new"`
+	Resolved []*Risk               `json:"resolved" yaml:"resolved"`
+	Rerated  []*RiskSeverityChange `json:"rerated" yaml:"rerated"`
+}
+
+// RiskSeverityChange is a risk found in both sets of risks with a different severity
+type RiskSeverityChange struct {
+	SyntheticId      string       `json:"synthetic_id" yaml:"synthetic_id"`
+	Title            string       `json:"title" yaml:"title"`
+	BaselineSeverity RiskSeverity `json:"baseline_severity" yaml:"baseline_severity"`
+	Severity         RiskSeverity `json:"severity" yaml:"severity"`
+	Risk             *Risk        `json:"-" yaml:"-"`
+}
+
+// CompareRisks lists the risks not found in the baseline as new, the risks of the baseline not found anymore as resolved
+// and the risks found in both with a different severity as rerated, each sorted by synthetic id
+func CompareRisks(baseline []*Risk, current []*Risk) *RiskDelta {
+	baselineRisks := risksBySyntheticId(baseline)
+	currentRisks := risksBySyntheticId(current)
+
+	delta := &RiskDelta{
+		New:      make([]*Risk, 0),
+		Resolved: make([]*Risk, 0),
+		Rerated:  make([]*RiskSeverityChange, 0),
+	}
+	for _, id := range sortedSyntheticIds(currentRisks) {
+		risk := currentRisks[id]
+		baselineRisk, ok := baselineRisks[id]
+		if !ok {
+			delta.New = append(delta.New, risk)
+			continue
+		}
+
+		if baselineRisk.Severity != risk.Severity {
+			delta.Rerated = append(delta.Rerated, &RiskSeverityChange{
+				SyntheticId:      risk.SyntheticId,
+				Title:            risk.Title,
+				BaselineSeverity: baselineRisk.Severity,
+				Severity:         risk.Severity,
+				Risk:             risk,
+			})
+		}
+	}
+
+	for _, id := range sortedSyntheticIds(baselineRisks) {
+		if _, ok := currentRisks[id]; !ok {
+			delta.Resolved = append(delta.Resolved, baselineRisks[id])
+		}
+	}
+
+	return delta
+}
+
+// IsEmpty tells whether both sets of risks contain the same risks with the same severities
+func (what *RiskDelta) IsEmpty() bool {
+	return len(what.New) == 0 && len(what.Resolved) == 0 && len(what.Rerated) == 0
+}
+
+func risksBySyntheticId(risks []*Risk) map[string]*Risk {
+	result := make(map[string]*Risk)
+	for _, risk := range risks {
+		result[strings.ToLower(strings.TrimSpace(risk.SyntheticId))] = risk
+	}
+	return result
+}
+
+func sortedSyntheticIds(risks map[string]*Risk) []string {
+	ids := make([]string, 0, len(risks))
+	for id := range risks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
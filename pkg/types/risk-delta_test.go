@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func syntheticIdsOf(risks []*Risk) []string {
+	ids := make([]string, 0)
+	for _, risk := range risks {
+		ids = append(ids, risk.SyntheticId)
+	}
+	return ids
+}
+
+func TestCompareRisks(t *testing.T) {
+	baseline := []*Risk{
+		{SyntheticId: "unchanged@a", Severity: HighSeverity},
+		{SyntheticId: "rerated@a", Severity: MediumSeverity},
+		{SyntheticId: "resolved@b", Severity: LowSeverity},
+		{SyntheticId: "resolved@a", Severity: LowSeverity},
+	}
+	current := []*Risk{
+		{SyntheticId: "new@b", Severity: CriticalSeverity},
+		{SyntheticId: "Rerated@A", Title: "Rerated", Severity: HighSeverity},
+		{SyntheticId: "unchanged@a", Severity: HighSeverity},
+		{SyntheticId: "new@a", Severity: LowSeverity},
+	}
+
+	delta := CompareRisks(baseline, current)
+
+	assert.False(t, delta.IsEmpty())
+	assert.Equal(t, []string{"new@a", "new@b"}, syntheticIdsOf(delta.New))
+	assert.Equal(t, []string{"resolved@a", "resolved@b"}, syntheticIdsOf(delta.Resolved))
+	assert.Len(t, delta.Rerated, 1)
+	assert.Equal(t, "Rerated@A", delta.Rerated[0].SyntheticId)
+	assert.Equal(t, MediumSeverity, delta.Rerated[0].BaselineSeverity)
+	assert.Equal(t, HighSeverity, delta.Rerated[0].Severity)
+}
+
+func TestCompareRisksUnchanged(t *testing.T) {
+	risks := []*Risk{{SyntheticId: "risk@a", Severity: HighSeverity}}
+
+	assert.True(t, CompareRisks(risks, risks).IsEmpty())
+	assert.True(t, CompareRisks(nil, nil).IsEmpty())
+}
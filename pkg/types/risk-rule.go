@@ -1,11 +1,19 @@
 package types
 
+import "time"
+
 type RiskRule interface {
 	Category() *RiskCategory
 	SupportedTags() []string
 	GenerateRisks(*Model) ([]*Risk, error)
 }
 
+// RiskExplainer is implemented by risk rules explaining in detail why they flagged the risks with a synthetic id
+// matching the given one (wildcards like in risk tracking are supported)
+type RiskExplainer interface {
+	ExplainRisk(parsedModel *Model, risk string) []string
+}
+
 type RiskRules map[string]RiskRule
 
 func (what RiskRules) Merge(rules RiskRules) RiskRules {
@@ -15,3 +23,12 @@ func (what RiskRules) Merge(rules RiskRules) RiskRules {
 
 	return what
 }
+
+// RiskRuleEvaluation records how long the evaluation of a risk rule against the model took and its outcome
+type RiskRuleEvaluation struct {
+	RiskRuleId string        `json:"risk_rule_id" yaml:"risk_rule_id"`
+	Duration   time.Duration `json:"duration" yaml:"duration"`
+	Risks      int           `json:"risks" yaml:"risks"`
+	TimedOut   bool          `json:"timed_out,omitempty" yaml:"timed_out,omitempty"`
+	Error      string        `json:"error,omitempty" yaml:"error,omitempty"`
+}
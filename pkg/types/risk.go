@@ -1,8 +1,9 @@
 package types
 
 type Risk struct {
-	CategoryId                      string                     `yaml:"category,omitempty" json:"category,omitempty"`       // used for better JSON marshalling, is assigned in risk evaluation phase automatically
-	RiskStatus                      RiskStatus                 `yaml:"risk_status,omitempty" json:"risk_status,omitempty"` // used for better JSON marshalling, is assigned in risk evaluation phase automatically
+	CategoryId                      string                     `yaml:"category,omitempty" json:"category,omitempty"`             // used for better JSON marshalling, is assigned in risk evaluation phase automatically
+	RiskStatus                      RiskStatus                 `yaml:"risk_status,omitempty" json:"risk_status,omitempty"`       // used for better JSON marshalling, is assigned in risk evaluation phase automatically
+	RiskTreatment                   RiskTreatment              `yaml:"risk_treatment,omitempty" json:"risk_treatment,omitempty"` // used for better JSON marshalling, is assigned in risk evaluation phase automatically
 	Severity                        RiskSeverity               `yaml:"severity,omitempty" json:"severity,omitempty"`
 	ExploitationLikelihood          RiskExploitationLikelihood `yaml:"exploitation_likelihood,omitempty" json:"exploitation_likelihood,omitempty"`
 	ExploitationImpact              RiskExploitationImpact     `yaml:"exploitation_impact,omitempty" json:"exploitation_impact,omitempty"`
@@ -17,5 +18,6 @@ type Risk struct {
 	DataBreachTechnicalAssetIDs     []string                   `yaml:"data_breach_technical_assets,omitempty" json:"data_breach_technical_assets,omitempty"`
 	RiskExplanation                 []string                   `yaml:"risk_explanation,omitempty" json:"risk_explanation,omitempty"`
 	RatingExplanation               []string                   `yaml:"rating_explanation,omitempty" json:"rating_explanation,omitempty"`
+	Tara                            *TaraRating                `yaml:"tara,omitempty" json:"tara,omitempty"`
 	// TODO: refactor all "ID" here to "ID"?
 }
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RiskTreatment is the risk treatment decision per ISO/SAE 21434 for a tracked risk
+type RiskTreatment int
+
+const (
+	UndecidedTreatment RiskTreatment = iota
+	AvoidTreatment
+	ReduceTreatment
+	ShareTreatment
+	RetainTreatment
+)
+
+func RiskTreatmentValues() []TypeEnum {
+	return []TypeEnum{
+		UndecidedTreatment,
+		AvoidTreatment,
+		ReduceTreatment,
+		ShareTreatment,
+		RetainTreatment,
+	}
+}
+
+var RiskTreatmentTypeDescription = [...]TypeDescription{
+	{"undecided", "No risk treatment decision has been made yet"},
+	{"avoid", "Risk is avoided by removing the risk source (like not implementing a feature)"},
+	{"reduce", "Risk is reduced by controls, as required by the cybersecurity goals covering it"},
+	{"share", "Risk is shared with other parties (like by contracts or insurances), as stated by the cybersecurity claims covering it"},
+	{"retain", "Risk is retained, as stated by the cybersecurity claims covering it"},
+}
+
+func ParseRiskTreatment(value string) (riskTreatment RiskTreatment, err error) {
+	return RiskTreatment(0).Find(value)
+}
+
+func (what RiskTreatment) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return RiskTreatmentTypeDescription[what].Name
+}
+
+func (what RiskTreatment) Explain() string {
+	return RiskTreatmentTypeDescription[what].Description
+}
+
+func (what RiskTreatment) Title() string {
+	return [...]string{"Undecided", "Avoid", "Reduce", "Share", "Retain"}[what]
+}
+
+// RequiresCybersecurityGoal tells whether the treatment decision has to be backed by a cybersecurity goal
+func (what RiskTreatment) RequiresCybersecurityGoal() bool {
+	return what == ReduceTreatment
+}
+
+// RequiresCybersecurityClaim tells whether the treatment decision has to be backed by a cybersecurity claim
+func (what RiskTreatment) RequiresCybersecurityClaim() bool {
+	return what == ShareTreatment || what == RetainTreatment
+}
+
+func (what RiskTreatment) Find(value string) (RiskTreatment, error) {
+	if len(value) == 0 {
+		return UndecidedTreatment, nil
+	}
+
+	for index, description := range RiskTreatmentTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return RiskTreatment(index), nil
+		}
+	}
+
+	return RiskTreatment(0), fmt.Errorf("unknown risk treatment value %q", value)
+}
+
+func (what RiskTreatment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *RiskTreatment) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what RiskTreatment) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *RiskTreatment) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
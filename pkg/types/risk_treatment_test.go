@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseRiskTreatmentTest struct {
+	input         string
+	expected      RiskTreatment
+	expectedError error
+}
+
+func TestParseRiskTreatment(t *testing.T) {
+	testCases := map[string]ParseRiskTreatmentTest{
+		"undecided": {
+			input:    "undecided",
+			expected: UndecidedTreatment,
+		},
+		"avoid": {
+			input:    "avoid",
+			expected: AvoidTreatment,
+		},
+		"reduce": {
+			input:    "reduce",
+			expected: ReduceTreatment,
+		},
+		"share": {
+			input:    "share",
+			expected: ShareTreatment,
+		},
+		"retain": {
+			input:    "retain",
+			expected: RetainTreatment,
+		},
+		"default": {
+			input:    "",
+			expected: UndecidedTreatment,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown risk treatment value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseRiskTreatment(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
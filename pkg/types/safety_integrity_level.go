@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SafetyIntegrityLevel is the automotive safety integrity level (ASIL) per ISO 26262, QM (quality managed) being the lowest
+type SafetyIntegrityLevel int
+
+const (
+	QM SafetyIntegrityLevel = iota
+	ASILA
+	ASILB
+	ASILC
+	ASILD
+)
+
+func SafetyIntegrityLevelValues() []TypeEnum {
+	return []TypeEnum{
+		QM,
+		ASILA,
+		ASILB,
+		ASILC,
+		ASILD,
+	}
+}
+
+var SafetyIntegrityLevelTypeDescription = [...]TypeDescription{
+	{"qm", "Quality managed (no safety requirements)"},
+	{"asil-a", "ASIL A (lowest safety integrity)"},
+	{"asil-b", "ASIL B"},
+	{"asil-c", "ASIL C"},
+	{"asil-d", "ASIL D (highest safety integrity)"},
+}
+
+func ParseSafetyIntegrityLevel(value string) (safetyIntegrityLevel SafetyIntegrityLevel, err error) {
+	return SafetyIntegrityLevel(0).Find(value)
+}
+
+func (what SafetyIntegrityLevel) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return SafetyIntegrityLevelTypeDescription[what].Name
+}
+
+func (what SafetyIntegrityLevel) Explain() string {
+	return SafetyIntegrityLevelTypeDescription[what].Description
+}
+
+func (what SafetyIntegrityLevel) Title() string {
+	return [...]string{"QM", "ASIL A", "ASIL B", "ASIL C", "ASIL D"}[what]
+}
+
+func (what SafetyIntegrityLevel) Weight() int {
+	return [...]int{0, 1, 2, 3, 4}[what]
+}
+
+func (what SafetyIntegrityLevel) Find(value string) (SafetyIntegrityLevel, error) {
+	if len(value) == 0 {
+		return QM, nil
+	}
+
+	for index, description := range SafetyIntegrityLevelTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return SafetyIntegrityLevel(index), nil
+		}
+	}
+
+	return SafetyIntegrityLevel(0), fmt.Errorf("unknown safety integrity level value %q", value)
+}
+
+func (what SafetyIntegrityLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *SafetyIntegrityLevel) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what SafetyIntegrityLevel) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *SafetyIntegrityLevel) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
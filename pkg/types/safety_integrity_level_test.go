@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseSafetyIntegrityLevelTest struct {
+	input         string
+	expected      SafetyIntegrityLevel
+	expectedError error
+}
+
+func TestParseSafetyIntegrityLevel(t *testing.T) {
+	testCases := map[string]ParseSafetyIntegrityLevelTest{
+		"qm": {
+			input:    "qm",
+			expected: QM,
+		},
+		"asil-a": {
+			input:    "asil-a",
+			expected: ASILA,
+		},
+		"asil-b": {
+			input:    "asil-b",
+			expected: ASILB,
+		},
+		"asil-c": {
+			input:    "asil-c",
+			expected: ASILC,
+		},
+		"asil-d": {
+			input:    "asil-d",
+			expected: ASILD,
+		},
+		"default": {
+			input:    "",
+			expected: QM,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown safety integrity level value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseSafetyIntegrityLevel(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
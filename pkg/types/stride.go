@@ -66,6 +66,11 @@ func (what STRIDE) Title() string {
 	return [...]string{"Spoofing", "Tampering", "Repudiation", "Information Disclosure", "Denial of Service", "Elevation of Privilege"}[what]
 }
 
+// CybersecurityProperty is the cybersecurity property (ISO/SAE 21434) compromised by threats of the STRIDE category
+func (what STRIDE) CybersecurityProperty() string {
+	return [...]string{"Authenticity", "Integrity", "Non-Repudiability", "Confidentiality", "Availability", "Authorization"}[what]
+}
+
 func (what STRIDE) MarshalJSON() ([]byte, error) {
 	return json.Marshal(what.String())
 }
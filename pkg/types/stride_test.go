@@ -58,3 +58,20 @@ func TestParseStride(t *testing.T) {
 		})
 	}
 }
+
+func TestStrideCybersecurityProperty(t *testing.T) {
+	testCases := map[STRIDE]string{
+		Spoofing:              "Authenticity",
+		Tampering:             "Integrity",
+		Repudiation:           "Non-Repudiability",
+		InformationDisclosure: "Confidentiality",
+		DenialOfService:       "Availability",
+		ElevationOfPrivilege:  "Authorization",
+	}
+
+	for stride, expected := range testCases {
+		t.Run(stride.String(), func(t *testing.T) {
+			assert.Equal(t, expected, stride.CybersecurityProperty())
+		})
+	}
+}
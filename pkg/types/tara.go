@@ -0,0 +1,204 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TaraRating is the ISO/SAE 21434 risk assessment of a risk, only set when the model declares damage scenarios (TARA mode)
+type TaraRating struct {
+	DamageScenarioIds []string          `json:"damage_scenarios,omitempty" yaml:"damage_scenarios,omitempty"`
+	Impact            DamageImpact      `json:"impact" yaml:"impact"`
+	AttackPotential   *AttackPotential  `json:"attack_potential,omitempty" yaml:"attack_potential,omitempty"`
+	AttackFeasibility AttackFeasibility `json:"attack_feasibility" yaml:"attack_feasibility"`
+	RiskValue         int               `json:"risk_value" yaml:"risk_value"`
+}
+
+const (
+	MinRiskValue = 1
+	MaxRiskValue = 5
+)
+
+// RiskMatrix maps the impact of a damage scenario and the attack feasibility of a risk to a risk value (1-5)
+type RiskMatrix [len(DamageImpactTypeDescription)][len(AttackFeasibilityTypeDescription)]int
+
+// DefaultRiskMatrix is the example risk matrix of ISO/SAE 21434 annex H
+func DefaultRiskMatrix() RiskMatrix {
+	return RiskMatrix{
+		NegligibleDamage: {VeryLowFeasibility: 1, LowFeasibility: 1, MediumFeasibility: 1, HighFeasibility: 1},
+		ModerateDamage:   {VeryLowFeasibility: 1, LowFeasibility: 2, MediumFeasibility: 2, HighFeasibility: 3},
+		MajorDamage:      {VeryLowFeasibility: 1, LowFeasibility: 2, MediumFeasibility: 3, HighFeasibility: 4},
+		SevereDamage:     {VeryLowFeasibility: 2, LowFeasibility: 3, MediumFeasibility: 4, HighFeasibility: 5},
+	}
+}
+
+// ParseRiskMatrix overrides the cells of the default risk matrix with values keyed by impact and attack feasibility
+// names, e.g. {"severe": {"very-low": 3}}
+func ParseRiskMatrix(values map[string]map[string]int) (RiskMatrix, error) {
+	result := DefaultRiskMatrix()
+	for impactName, row := range values {
+		impact, impactError := ParseDamageImpact(impactName)
+		if impactError != nil {
+			return result, fmt.Errorf("invalid risk matrix: %w", impactError)
+		}
+
+		for feasibilityName, value := range row {
+			feasibility, feasibilityError := ParseAttackFeasibility(feasibilityName)
+			if feasibilityError != nil {
+				return result, fmt.Errorf("invalid risk matrix: %w", feasibilityError)
+			}
+
+			if value < MinRiskValue || value > MaxRiskValue {
+				return result, fmt.Errorf("invalid risk matrix: risk value %v of impact %q and attack feasibility %q is not within %v-%v",
+					value, impactName, feasibilityName, MinRiskValue, MaxRiskValue)
+			}
+
+			result[impact][feasibility] = value
+		}
+	}
+
+	return result, nil
+}
+
+func (what RiskMatrix) RiskValue(impact DamageImpact, feasibility AttackFeasibility) int {
+	return what[impact][feasibility]
+}
+
+// DamageImpactOfExploitationImpact rates risks not linked to any damage scenario
+func DamageImpactOfExploitationImpact(impact RiskExploitationImpact) DamageImpact {
+	return [...]DamageImpact{NegligibleDamage, ModerateDamage, MajorDamage, SevereDamage}[impact]
+}
+
+// AttackFeasibilityOfExploitationLikelihood rates risks without attack potential rating
+func AttackFeasibilityOfExploitationLikelihood(likelihood RiskExploitationLikelihood) AttackFeasibility {
+	return [...]AttackFeasibility{LowFeasibility, MediumFeasibility, HighFeasibility, HighFeasibility}[likelihood]
+}
+
+// MatchesSyntheticRiskId matches a synthetic risk id against a pattern where "*" stands for any part between "@"
+func MatchesSyntheticRiskId(pattern string, syntheticRiskId string) bool {
+	expression := strings.ReplaceAll(regexp.QuoteMeta(strings.TrimSpace(pattern)), `\*`, `[^@]+`)
+	matcher, compileError := regexp.Compile("(?i)^" + expression + "$")
+	return compileError == nil && matcher.MatchString(strings.TrimSpace(syntheticRiskId))
+}
+
+func (model *Model) IsTaraMode() bool {
+	return len(model.DamageScenarios) > 0
+}
+
+// ApplyTaraRating rates all risks in TARA mode: the impact is taken from the damage scenarios linked to a risk (or derived
+// from its exploitation impact), the attack feasibility from the attack potential rated for it (or derived from its
+// exploitation likelihood), and the risk value is the highest the risk matrix yields for any of its damage scenarios
+func (model *Model) ApplyTaraRating(riskMatrix RiskMatrix, progressReporter ProgressReporter) {
+	if !model.IsTaraMode() {
+		return
+	}
+
+	progressReporter.Info("Applying TARA rating")
+	linkedDamageScenarios := make(map[string]bool)
+	usedAttackPotentials := make(map[string]bool)
+	for _, risk := range model.AllRisks() {
+		rating := new(TaraRating)
+
+		pattern, attackPotential := model.findAttackPotential(risk)
+		if attackPotential != nil {
+			usedAttackPotentials[pattern] = true
+			rating.AttackPotential = attackPotential
+			rating.AttackFeasibility = attackPotential.Feasibility()
+		} else {
+			rating.AttackFeasibility = AttackFeasibilityOfExploitationLikelihood(risk.ExploitationLikelihood)
+		}
+
+		rating.Impact = DamageImpactOfExploitationImpact(risk.ExploitationImpact)
+		rating.RiskValue = riskMatrix.RiskValue(rating.Impact, rating.AttackFeasibility)
+		for _, damageScenario := range model.SortedDamageScenarios() {
+			if !damageScenario.IsCausedBy(risk) {
+				continue
+			}
+
+			linkedDamageScenarios[damageScenario.Id] = true
+			riskValue := riskMatrix.RiskValue(damageScenario.Impact(), rating.AttackFeasibility)
+			if len(rating.DamageScenarioIds) == 0 || riskValue > rating.RiskValue {
+				rating.Impact = damageScenario.Impact()
+				rating.RiskValue = riskValue
+			}
+			rating.DamageScenarioIds = append(rating.DamageScenarioIds, damageScenario.Id)
+		}
+
+		risk.Tara = rating
+	}
+
+	for _, damageScenario := range model.SortedDamageScenarios() {
+		if !linkedDamageScenarios[damageScenario.Id] {
+			progressReporter.Warnf("Damage scenario is not linked to any risk: %v", damageScenario.Id)
+		}
+	}
+
+	for pattern := range model.AttackPotentials {
+		if !usedAttackPotentials[pattern] {
+			progressReporter.Warnf("Attack potential does not match any risk id: %v", pattern)
+		}
+	}
+}
+
+// findAttackPotential prefers an attack potential rated for the exact synthetic risk id, otherwise the most feasible
+// one (worst case) of all wildcard patterns matching it
+func (model *Model) findAttackPotential(risk *Risk) (string, *AttackPotential) {
+	var resultPattern string
+	var result *AttackPotential
+	for _, pattern := range model.sortedAttackPotentialPatterns() {
+		if !MatchesSyntheticRiskId(pattern, risk.SyntheticId) {
+			continue
+		}
+
+		attackPotential := model.AttackPotentials[pattern]
+		if !strings.Contains(pattern, "*") {
+			return pattern, attackPotential
+		}
+
+		if result == nil || attackPotential.Value() < result.Value() {
+			resultPattern = pattern
+			result = attackPotential
+		}
+	}
+
+	return resultPattern, result
+}
+
+func (model *Model) sortedAttackPotentialPatterns() []string {
+	patterns := make([]string, 0, len(model.AttackPotentials))
+	for pattern := range model.AttackPotentials {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+func (model *Model) SortedDamageScenarios() []*DamageScenario {
+	result := make([]*DamageScenario, 0, len(model.DamageScenarios))
+	for _, damageScenario := range model.DamageScenarios {
+		result = append(result, damageScenario)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	return result
+}
+
+// RisksOfDamageScenario lists the risks linked to a damage scenario by descending risk value
+func (model *Model) RisksOfDamageScenario(damageScenario *DamageScenario) []*Risk {
+	result := make([]*Risk, 0)
+	for _, risk := range model.AllRisks() {
+		if risk.Tara != nil && damageScenario.IsCausedBy(risk) {
+			result = append(result, risk)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Tara.RiskValue != result[j].Tara.RiskValue {
+			return result[i].Tara.RiskValue > result[j].Tara.RiskValue
+		}
+		return result[i].SyntheticId < result[j].SyntheticId
+	})
+	return result
+}
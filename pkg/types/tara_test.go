@@ -0,0 +1,197 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type taraTestReporter struct {
+	warnings []string
+}
+
+func (what *taraTestReporter) Info(a ...any)                 {}
+func (what *taraTestReporter) Warn(a ...any)                 { what.warnings = append(what.warnings, fmt.Sprint(a...)) }
+func (what *taraTestReporter) Error(a ...any)                {}
+func (what *taraTestReporter) Infof(format string, a ...any) {}
+func (what *taraTestReporter) Warnf(format string, a ...any) {
+	what.warnings = append(what.warnings, fmt.Sprintf(format, a...))
+}
+func (what *taraTestReporter) Errorf(format string, a ...any) {}
+
+type MatchesSyntheticRiskIdTest struct {
+	pattern  string
+	id       string
+	expected bool
+}
+
+func TestMatchesSyntheticRiskId(t *testing.T) {
+	testCases := map[string]MatchesSyntheticRiskIdTest{
+		"exact":                     {pattern: "sql-injection@db", id: "sql-injection@db", expected: true},
+		"case-insensitive":          {pattern: "SQL-Injection@DB", id: "sql-injection@db", expected: true},
+		"wildcard":                  {pattern: "sql-injection@*", id: "sql-injection@db", expected: true},
+		"wildcard within one part":  {pattern: "sql-injection@*", id: "sql-injection@db@app", expected: false},
+		"wildcard in several parts": {pattern: "*@*@app", id: "sql-injection@db@app", expected: true},
+		"prefix only":               {pattern: "sql-injection", id: "sql-injection@db", expected: false},
+		"regexp characters":         {pattern: "sql.injection@db", id: "sql-injection@db", expected: false},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, MatchesSyntheticRiskId(test.pattern, test.id))
+		})
+	}
+}
+
+func TestParseRiskMatrix(t *testing.T) {
+	riskMatrix, err := ParseRiskMatrix(map[string]map[string]int{"Severe": {"very-low": 3}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, riskMatrix.RiskValue(SevereDamage, VeryLowFeasibility))
+	assert.Equal(t, 5, riskMatrix.RiskValue(SevereDamage, HighFeasibility))
+	assert.Equal(t, 1, riskMatrix.RiskValue(NegligibleDamage, HighFeasibility))
+}
+
+func TestParseRiskMatrixInvalid(t *testing.T) {
+	testCases := map[string]map[string]map[string]int{
+		"unknown impact":      {"catastrophic": {"high": 5}},
+		"unknown feasibility": {"severe": {"certain": 5}},
+		"value too low":       {"severe": {"high": 0}},
+		"value too high":      {"severe": {"high": 6}},
+	}
+
+	for name, values := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseRiskMatrix(values)
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestAttackPotentialFeasibility(t *testing.T) {
+	testCases := map[string]struct {
+		attackPotential AttackPotential
+		expected        AttackFeasibility
+	}{
+		"basic": {
+			attackPotential: AttackPotential{},
+			expected:        HighFeasibility,
+		},
+		"enhanced-basic": {
+			attackPotential: AttackPotential{Expertise: Proficient, WindowOfOpportunity: ModerateWindow, Equipment: StandardEquipment, ElapsedTime: UpToOneWeek, KnowledgeOfItem: PublicKnowledge},
+			expected:        HighFeasibility,
+		},
+		"moderate": {
+			attackPotential: AttackPotential{Expertise: Proficient, KnowledgeOfItem: RestrictedKnowledge, ElapsedTime: UpToOneMonth},
+			expected:        MediumFeasibility,
+		},
+		"high": {
+			attackPotential: AttackPotential{Expertise: Expert, KnowledgeOfItem: ConfidentialKnowledge, ElapsedTime: UpToOneMonth},
+			expected:        LowFeasibility,
+		},
+		"beyond high": {
+			attackPotential: AttackPotential{Expertise: MultipleExperts, Equipment: BespokeEquipment, ElapsedTime: UpToSixMonths},
+			expected:        VeryLowFeasibility,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.attackPotential.Feasibility())
+		})
+	}
+}
+
+func createTaraTestModel() *Model {
+	return &Model{
+		GeneratedRisksByCategory: map[string][]*Risk{
+			"flooding": {
+				{SyntheticId: "flooding@gateway", ExploitationLikelihood: Likely, ExploitationImpact: MediumImpact},
+				{SyntheticId: "flooding@ecu", ExploitationLikelihood: Likely, ExploitationImpact: MediumImpact},
+			},
+			"spoofing": {
+				{SyntheticId: "spoofing@ecu", ExploitationLikelihood: VeryLikely, ExploitationImpact: HighImpact},
+			},
+		},
+		DamageScenarios: map[string]*DamageScenario{
+			"brakes":  {Id: "brakes", Safety: SevereDamage, Financial: ModerateDamage, RiskIds: []string{"spoofing@ecu", "flooding@*"}},
+			"comfort": {Id: "comfort", Operational: ModerateDamage, RiskIds: []string{"flooding@gateway"}},
+			"unused":  {Id: "unused", Privacy: MajorDamage, RiskIds: []string{"eavesdropping@*"}},
+		},
+		AttackPotentials: map[string]*AttackPotential{
+			"flooding@*":   {Expertise: Expert, KnowledgeOfItem: ConfidentialKnowledge, ElapsedTime: UpToOneMonth},
+			"flooding@ecu": {Expertise: MultipleExperts, Equipment: BespokeEquipment, ElapsedTime: UpToSixMonths},
+			"unused@*":     {},
+		},
+	}
+}
+
+func TestApplyTaraRating(t *testing.T) {
+	parsedModel := createTaraTestModel()
+	reporter := new(taraTestReporter)
+
+	parsedModel.ApplyTaraRating(DefaultRiskMatrix(), reporter)
+
+	risks := map[string]*Risk{}
+	for _, risk := range parsedModel.AllRisks() {
+		risks[risk.SyntheticId] = risk
+	}
+
+	gateway := risks["flooding@gateway"].Tara
+	assert.Equal(t, []string{"brakes", "comfort"}, gateway.DamageScenarioIds)
+	assert.Equal(t, SevereDamage, gateway.Impact)
+	assert.Equal(t, LowFeasibility, gateway.AttackFeasibility)
+	assert.Equal(t, 3, gateway.RiskValue)
+
+	ecuFlooding := risks["flooding@ecu"].Tara
+	assert.Equal(t, VeryLowFeasibility, ecuFlooding.AttackFeasibility)
+	assert.Equal(t, parsedModel.AttackPotentials["flooding@ecu"], ecuFlooding.AttackPotential)
+	assert.Equal(t, 2, ecuFlooding.RiskValue)
+
+	ecuSpoofing := risks["spoofing@ecu"].Tara
+	assert.Nil(t, ecuSpoofing.AttackPotential)
+	assert.Equal(t, HighFeasibility, ecuSpoofing.AttackFeasibility)
+	assert.Equal(t, 5, ecuSpoofing.RiskValue)
+
+	assert.ElementsMatch(t, []string{
+		"Damage scenario is not linked to any risk: unused",
+		"Attack potential does not match any risk id: unused@*",
+	}, reporter.warnings)
+
+	brakesRisks := parsedModel.RisksOfDamageScenario(parsedModel.DamageScenarios["brakes"])
+	assert.Len(t, brakesRisks, 3)
+	assert.Equal(t, "spoofing@ecu", brakesRisks[0].SyntheticId)
+}
+
+func TestApplyTaraRatingUnlinkedRisk(t *testing.T) {
+	parsedModel := &Model{
+		GeneratedRisksByCategory: map[string][]*Risk{
+			"flooding": {{SyntheticId: "flooding@gateway", ExploitationLikelihood: Unlikely, ExploitationImpact: VeryHighImpact}},
+		},
+		DamageScenarios: map[string]*DamageScenario{
+			"brakes": {Id: "brakes", Safety: SevereDamage, RiskIds: []string{"spoofing@*"}},
+		},
+	}
+
+	parsedModel.ApplyTaraRating(DefaultRiskMatrix(), new(taraTestReporter))
+
+	rating := parsedModel.GeneratedRisksByCategory["flooding"][0].Tara
+	assert.Empty(t, rating.DamageScenarioIds)
+	assert.Equal(t, SevereDamage, rating.Impact)
+	assert.Equal(t, LowFeasibility, rating.AttackFeasibility)
+	assert.Equal(t, 3, rating.RiskValue)
+}
+
+func TestApplyTaraRatingWithoutDamageScenarios(t *testing.T) {
+	parsedModel := &Model{
+		GeneratedRisksByCategory: map[string][]*Risk{
+			"flooding": {{SyntheticId: "flooding@gateway"}},
+		},
+	}
+
+	parsedModel.ApplyTaraRating(DefaultRiskMatrix(), new(taraTestReporter))
+
+	assert.Nil(t, parsedModel.GeneratedRisksByCategory["flooding"][0].Tara)
+}
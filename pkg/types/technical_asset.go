@@ -21,6 +21,7 @@ type TechnicalAsset struct {
 	MultiTenant             bool                  `json:"multi_tenant,omitempty" yaml:"multi_tenant,omitempty"`
 	Redundant               bool                  `json:"redundant,omitempty" yaml:"redundant,omitempty"`
 	CustomDevelopedParts    bool                  `json:"custom_developed_parts,omitempty" yaml:"custom_developed_parts,omitempty"`
+	HardwareRootOfTrust     bool                  `json:"hardware_root_of_trust,omitempty" yaml:"hardware_root_of_trust,omitempty"`
 	OutOfScope              bool                  `json:"out_of_scope,omitempty" yaml:"out_of_scope,omitempty"`
 	UsedAsClientByHuman     bool                  `json:"used_as_client_by_human,omitempty" yaml:"used_as_client_by_human,omitempty"`
 	Encryption              EncryptionStyle       `json:"encryption,omitempty" yaml:"encryption,omitempty"`
@@ -29,11 +30,13 @@ type TechnicalAsset struct {
 	Confidentiality         Confidentiality       `json:"confidentiality,omitempty" yaml:"confidentiality,omitempty"`
 	Integrity               Criticality           `json:"integrity,omitempty" yaml:"integrity,omitempty"`
 	Availability            Criticality           `json:"availability,omitempty" yaml:"availability,omitempty"`
+	SafetyIntegrityLevel    SafetyIntegrityLevel  `json:"safety_integrity_level,omitempty" yaml:"safety_integrity_level,omitempty"`
 	JustificationCiaRating  string                `json:"justification_cia_rating,omitempty" yaml:"justification_cia_rating,omitempty"`
 	Tags                    []string              `json:"tags,omitempty" yaml:"tags,omitempty"`
 	DataAssetsProcessed     []string              `json:"data_assets_processed,omitempty" yaml:"data_assets_processed,omitempty"`
 	DataAssetsStored        []string              `json:"data_assets_stored,omitempty" yaml:"data_assets_stored,omitempty"`
 	DataFormatsAccepted     []DataFormat          `json:"data_formats_accepted,omitempty" yaml:"data_formats_accepted,omitempty"`
+	VerifiesAtBoot          []string              `json:"verifies_at_boot,omitempty" yaml:"verifies_at_boot,omitempty"`
 	CommunicationLinks      []*CommunicationLink  `json:"communication_links,omitempty" yaml:"communication_links,omitempty"`
 	DiagramTweakOrder       int                   `json:"diagram_tweak_order,omitempty" yaml:"diagram_tweak_order,omitempty"`
 	RAA                     float64               `json:"raa,omitempty" yaml:"raa,omitempty"` // will be set by separate calculation step
@@ -30,8 +30,48 @@ const (
 	IsDevelopmentRelevant                             = "development_relevant"
 	IsTrafficForwarding                               = "traffic_forwarding"
 	IsEmbeddedComponent                               = "embedded_component"
+	IsDiagnosticOBD                                   = "diagnostic_obd"
+	IsDiagnosticUDS                                   = "diagnostic_uds"
+	IsDiagnosticSOVD                                  = "diagnostic_sovd"
+	IsDiagnosticDoIP                                  = "diagnostic_doip"
 )
 
+// TechnologyAttributes are the attributes of technologies evaluated by the built-in risk rules (besides the names of
+// technologies, which are attributes of themselves)
+var TechnologyAttributes = []string{
+	MayContainSecrets,
+	NoAuthenticationRequired,
+	IsHighValueTarget,
+	IsWebService,
+	IsIdentityStore,
+	IsNoNetworkSegmentationRequired,
+	IsIdentityRelated,
+	IsFileStorage,
+	IsSearchRelated,
+	IsVulnerableToQueryInjection,
+	IsNoStorageAtRest,
+	IsHTTPInternetAccessOK,
+	IsFTPInternetAccessOK,
+	IsSecurityControlRelated,
+	IsUnprotectedCommunicationsTolerated,
+	IsUnnecessaryDataTolerated,
+	IsCloseToHighValueTargetsTolerated,
+	IsClient,
+	IsUsuallyAbleToPropagateIdentityToOutgoingTargets,
+	IsLessProtectedType,
+	IsUsuallyProcessingEndUserRequests,
+	IsUsuallyStoringEndUserData,
+	IsExclusivelyFrontendRelated,
+	IsExclusivelyBackendRelated,
+	IsDevelopmentRelevant,
+	IsTrafficForwarding,
+	IsEmbeddedComponent,
+	IsDiagnosticOBD,
+	IsDiagnosticUDS,
+	IsDiagnosticSOVD,
+	IsDiagnosticDoIP,
+}
+
 type TechnologyList []*Technology
 
 func (what TechnologyList) String() string {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 )
@@ -16,9 +18,11 @@ type TechnologyMap map[string]Technology
 
 type technologyMapConfigReader interface {
 	GetAppFolder() string
-	GetTechnologyFilename() string
+	GetTechnologyFiles() []string
 }
 
+// LoadWithConfig loads the technologies of the app folder (or the built-in ones) and layers the technology files of the
+// config in order over them
 func (what TechnologyMap) LoadWithConfig(config technologyMapConfigReader, defaultFilename string) error {
 	technologiesFilename := filepath.Join(config.GetAppFolder(), defaultFilename)
 	_, statError := os.Stat(technologiesFilename)
@@ -34,21 +38,130 @@ func (what TechnologyMap) LoadWithConfig(config technologyMapConfigReader, defau
 		}
 	}
 
-	if len(config.GetTechnologyFilename()) > 0 {
+	for _, technologyFilename := range config.GetTechnologyFiles() {
 		additionalTechnologies := make(TechnologyMap)
-		loadError := additionalTechnologies.LoadFromFile(config.GetTechnologyFilename())
+		loadError := additionalTechnologies.LoadFromFile(technologyFilename)
 		if loadError != nil {
-			return fmt.Errorf("error loading additional technologies from %q: %v", config.GetTechnologyFilename(), loadError)
+			return fmt.Errorf("error loading additional technologies from %q: %v", technologyFilename, loadError)
 		}
 
-		for name, technology := range additionalTechnologies {
+		what.Merge(additionalTechnologies)
+	}
+
+	return what.CheckParents()
+}
+
+// Merge layers the technologies over the map: new technologies are added, and existing ones are extended by the
+// aliases, examples and attributes (overriding the values of attributes already set) and get the parent and
+// description if given
+func (what TechnologyMap) Merge(technologies TechnologyMap) {
+	for name, technology := range technologies {
+		existing, exists := what[name]
+		if !exists {
 			what[name] = technology
+			continue
+		}
+
+		if len(technology.Parent) > 0 {
+			existing.Parent = technology.Parent
+		}
+
+		if len(technology.Description) > 0 {
+			existing.Description = technology.Description
+		}
+
+		existing.Aliases = mergeUniqueStrings(existing.Aliases, technology.Aliases)
+		existing.Examples = mergeUniqueStrings(existing.Examples, technology.Examples)
+
+		attributes := make(map[string]bool)
+		for key, value := range existing.Attributes {
+			attributes[key] = value
+		}
+		for key, value := range technology.Attributes {
+			attributes[key] = value
+		}
+		existing.Attributes = attributes
+
+		what[name] = existing
+	}
+}
+
+// CheckParents makes sure all parents of technologies are known and no technology is its own ancestor
+func (what TechnologyMap) CheckParents() error {
+	for _, name := range what.sortedNames() {
+		visited := map[string]bool{name: true}
+		for parent := what[name].Parent; len(parent) > 0; parent = what[parent].Parent {
+			if _, exists := what[parent]; !exists {
+				return fmt.Errorf("unknown parent %q of technology %q", parent, name)
+			}
+
+			if visited[parent] {
+				return fmt.Errorf("technology %q is its own ancestor", parent)
+			}
+			visited[parent] = true
 		}
 	}
 
 	return nil
 }
 
+// UnknownAttributes lists per technology the attributes neither evaluated by the built-in risk rules, nor set by the
+// built-in technologies, nor naming a technology - most likely typos in custom technology files
+func (what TechnologyMap) UnknownAttributes() (map[string][]string, error) {
+	builtInTechnologies := make(TechnologyMap)
+	loadError := builtInTechnologies.LoadDefault()
+	if loadError != nil {
+		return nil, loadError
+	}
+
+	knownAttributes := make(map[string]bool)
+	for _, attribute := range TechnologyAttributes {
+		knownAttributes[attribute] = true
+	}
+
+	for name, technology := range builtInTechnologies {
+		knownAttributes[name] = true
+		for attribute := range technology.Attributes {
+			knownAttributes[attribute] = true
+		}
+	}
+
+	for name := range what {
+		knownAttributes[name] = true
+	}
+
+	unknownAttributes := make(map[string][]string)
+	for name, technology := range what {
+		for attribute := range technology.Attributes {
+			if !knownAttributes[attribute] {
+				unknownAttributes[name] = append(unknownAttributes[name], attribute)
+			}
+		}
+
+		sort.Strings(unknownAttributes[name])
+	}
+
+	return unknownAttributes, nil
+}
+
+func (what TechnologyMap) sortedNames() []string {
+	names := make([]string, 0, len(what))
+	for name := range what {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func mergeUniqueStrings(first []string, second []string) []string {
+	for _, item := range second {
+		if !slices.Contains(first, item) {
+			first = append(first, item)
+		}
+	}
+	return first
+}
+
 func (what TechnologyMap) LoadDefault() error {
 	defaultTechnologyFile, readError := technologiesLocation.ReadFile("technologies.yaml")
 	if readError != nil {
@@ -136,7 +249,7 @@ func (what TechnologyMap) PropagateAttributes() {
 		*technology = value
 		technology.Attributes = make(map[string]bool)
 
-		what.propagateAttributes(name, technology.Attributes)
+		what.propagateAttributes(name, technology.Attributes, make(map[string]bool))
 		technology.Attributes[name] = true
 		technology.Name = name
 
@@ -152,12 +265,18 @@ func (what TechnologyMap) PropagateAttributes() {
 	}
 }
 
-func (what TechnologyMap) propagateAttributes(name string, attributes map[string]bool) {
+// propagateAttributes collects the attributes of the technology and its ancestors (which it is, too), the ones of the
+// technology overriding the inherited ones
+func (what TechnologyMap) propagateAttributes(name string, attributes map[string]bool, visited map[string]bool) {
 	tech, ok := what[name]
-	if ok {
-		what.propagateAttributes(tech.Parent, attributes)
+	if !ok || visited[name] {
+		return
 	}
 
+	visited[name] = true
+	what.propagateAttributes(tech.Parent, attributes, visited)
+
+	attributes[name] = true
 	for key, value := range tech.Attributes {
 		attributes[key] = value
 	}
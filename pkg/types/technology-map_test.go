@@ -0,0 +1,112 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type technologyMapTestConfig struct {
+	technologyFiles []string
+}
+
+func (what *technologyMapTestConfig) GetAppFolder() string {
+	return ""
+}
+
+func (what *technologyMapTestConfig) GetTechnologyFiles() []string {
+	return what.technologyFiles
+}
+
+func writeTechnologyFile(t *testing.T, dir string, name string, content string) string {
+	filename := filepath.Join(dir, name)
+	assert.NoError(t, os.WriteFile(filename, []byte(content), 0600))
+	return filename
+}
+
+func TestTechnologyMapLoadLayered(t *testing.T) {
+	dir := t.TempDir()
+	team := writeTechnologyFile(t, dir, "team.yaml", `
+embedded-linux:
+    parent: iot-device
+    description: An embedded Linux system
+    attributes:
+        embedded_component: true
+web-server:
+    aliases:
+        - httpd
+    attributes:
+        high_value_target: true
+`)
+	project := writeTechnologyFile(t, dir, "project.yaml", `
+head-unit:
+    parent: embedded-linux
+    description: The head unit
+    attributes:
+        frontend_related: true
+        embedded_component: false
+`)
+
+	technologies := make(TechnologyMap)
+	assert.NoError(t, technologies.LoadWithConfig(&technologyMapTestConfig{technologyFiles: []string{team, project}}, "technologies.yaml"))
+	technologies.PropagateAttributes()
+
+	webServer := technologies.Get(WebServer)
+	assert.NotNil(t, webServer)
+	assert.Equal(t, "A web server", webServer.Description)
+	assert.Contains(t, webServer.Aliases, "httpd")
+	assert.True(t, webServer.GetAttribute(IsHighValueTarget))
+	assert.True(t, webServer.GetAttribute(WebServer))
+
+	headUnit := technologies.Get("head-unit")
+	assert.NotNil(t, headUnit)
+	assert.True(t, headUnit.GetAttribute("head-unit"))
+	assert.True(t, headUnit.GetAttribute("embedded-linux"))
+	assert.True(t, headUnit.GetAttribute(IoTDevice))
+	assert.True(t, headUnit.GetAttribute(IsExclusivelyFrontendRelated))
+	assert.False(t, headUnit.GetAttribute(IsEmbeddedComponent))
+}
+
+func TestTechnologyMapLoadUnknownParent(t *testing.T) {
+	custom := writeTechnologyFile(t, t.TempDir(), "custom.yaml", `
+head-unit:
+    parent: embedded-linux
+`)
+
+	technologies := make(TechnologyMap)
+	err := technologies.LoadWithConfig(&technologyMapTestConfig{technologyFiles: []string{custom}}, "technologies.yaml")
+	assert.EqualError(t, err, `unknown parent "embedded-linux" of technology "head-unit"`)
+}
+
+func TestTechnologyMapLoadParentCycle(t *testing.T) {
+	custom := writeTechnologyFile(t, t.TempDir(), "custom.yaml", `
+a:
+    parent: b
+b:
+    parent: a
+`)
+
+	technologies := make(TechnologyMap)
+	err := technologies.LoadWithConfig(&technologyMapTestConfig{technologyFiles: []string{custom}}, "technologies.yaml")
+	assert.EqualError(t, err, `technology "a" is its own ancestor`)
+}
+
+func TestTechnologyMapUnknownAttributes(t *testing.T) {
+	custom := writeTechnologyFile(t, t.TempDir(), "custom.yaml", `
+head-unit:
+    attributes:
+        frontend_related: true
+        web-server: true
+        less_protected: true
+        head_unit_specific: true
+`)
+
+	technologies := make(TechnologyMap)
+	assert.NoError(t, technologies.LoadWithConfig(&technologyMapTestConfig{technologyFiles: []string{custom}}, "technologies.yaml"))
+
+	unknownAttributes, err := technologies.UnknownAttributes()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"head-unit": {"head_unit_specific", "less_protected"}}, unknownAttributes)
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WindowOfOpportunity is the attack potential factor rating the access to the item an attacker needs
+type WindowOfOpportunity int
+
+const (
+	UnlimitedWindow WindowOfOpportunity = iota
+	EasyWindow
+	ModerateWindow
+	DifficultWindow
+)
+
+func WindowOfOpportunityValues() []TypeEnum {
+	return []TypeEnum{
+		UnlimitedWindow,
+		EasyWindow,
+		ModerateWindow,
+		DifficultWindow,
+	}
+}
+
+var WindowOfOpportunityTypeDescription = [...]TypeDescription{
+	{"unlimited", "Unlimited access (e.g. remote access without preconditions)"},
+	{"easy", "Easy access (e.g. remote access or easy physical access in limited time)"},
+	{"moderate", "Moderate access (e.g. physical access to the vehicle interior or with tools)"},
+	{"difficult", "Difficult access (e.g. disassembly of the vehicle or the item)"},
+}
+
+func ParseWindowOfOpportunity(value string) (windowOfOpportunity WindowOfOpportunity, err error) {
+	return WindowOfOpportunity(0).Find(value)
+}
+
+func (what WindowOfOpportunity) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return WindowOfOpportunityTypeDescription[what].Name
+}
+
+func (what WindowOfOpportunity) Explain() string {
+	return WindowOfOpportunityTypeDescription[what].Description
+}
+
+func (what WindowOfOpportunity) Title() string {
+	return [...]string{"Unlimited", "Easy", "Moderate", "Difficult"}[what]
+}
+
+// Weight is the attack potential value of the factor
+func (what WindowOfOpportunity) Weight() int {
+	return [...]int{0, 1, 4, 10}[what]
+}
+
+func (what WindowOfOpportunity) Find(value string) (WindowOfOpportunity, error) {
+	if len(value) == 0 {
+		return UnlimitedWindow, nil
+	}
+
+	for index, description := range WindowOfOpportunityTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return WindowOfOpportunity(index), nil
+		}
+	}
+
+	return WindowOfOpportunity(0), fmt.Errorf("unknown window of opportunity value %q", value)
+}
+
+func (what WindowOfOpportunity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *WindowOfOpportunity) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what WindowOfOpportunity) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *WindowOfOpportunity) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseWindowOfOpportunityTest struct {
+	input         string
+	expected      WindowOfOpportunity
+	expectedError error
+}
+
+func TestParseWindowOfOpportunity(t *testing.T) {
+	testCases := map[string]ParseWindowOfOpportunityTest{
+		"unlimited": {
+			input:    "unlimited",
+			expected: UnlimitedWindow,
+		},
+		"easy": {
+			input:    "easy",
+			expected: EasyWindow,
+		},
+		"moderate": {
+			input:    "moderate",
+			expected: ModerateWindow,
+		},
+		"difficult": {
+			input:    "difficult",
+			expected: DifficultWindow,
+		},
+		"default": {
+			input:    "",
+			expected: UnlimitedWindow,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown window of opportunity value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseWindowOfOpportunity(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}